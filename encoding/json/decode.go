@@ -112,6 +112,7 @@ const (
 	labelKey        = "label"
 	parametersKey   = "parameters"
 	returnKey       = "return"
+	typesKey        = "types"
 )
 
 var ErrInvalidJSONCadence = errors.New("invalid JSON Cadence structure")
@@ -182,10 +183,16 @@ func decodeJSON(v interface{}) cadence.Value {
 		return decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return decodeUFix64(valueJSON)
+	case fix128TypeStr:
+		return decodeFix128(valueJSON)
+	case ufix128TypeStr:
+		return decodeUFix128(valueJSON)
 	case arrayTypeStr:
 		return decodeArray(valueJSON)
 	case dictionaryTypeStr:
 		return decodeDictionary(valueJSON)
+	case tupleTypeStr:
+		return decodeTuple(valueJSON)
 	case resourceTypeStr:
 		return decodeResource(valueJSON)
 	case structTypeStr:
@@ -486,6 +493,24 @@ func decodeUFix64(valueJSON interface{}) cadence.UFix64 {
 	return v
 }
 
+func decodeFix128(valueJSON interface{}) cadence.Fix128 {
+	v, err := cadence.NewFix128(toString(valueJSON))
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
+func decodeUFix128(valueJSON interface{}) cadence.UFix128 {
+	v, err := cadence.NewUFix128(toString(valueJSON))
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
 func decodeValues(valueJSON interface{}) []cadence.Value {
 	v := toSlice(valueJSON)
 
@@ -502,6 +527,10 @@ func decodeArray(valueJSON interface{}) cadence.Array {
 	return cadence.NewArray(decodeValues(valueJSON))
 }
 
+func decodeTuple(valueJSON interface{}) cadence.Tuple {
+	return cadence.NewTuple(decodeValues(valueJSON))
+}
+
 func decodeDictionary(valueJSON interface{}) cadence.Dictionary {
 	v := toSlice(valueJSON)
 
@@ -829,6 +858,15 @@ func decodeType(valueJSON interface{}) cadence.Type {
 			KeyType:     decodeType(obj.Get(keyKey)),
 			ElementType: decodeType(obj.Get(valueKey)),
 		}
+	case "Tuple":
+		typesValue := toSlice(obj.Get(typesKey))
+		elementTypes := make([]cadence.Type, len(typesValue))
+		for i, typeValue := range typesValue {
+			elementTypes[i] = decodeType(typeValue)
+		}
+		return cadence.TupleType{
+			ElementTypes: elementTypes,
+		}
 	case "ConstantSizedArray":
 		size := toUInt(obj.Get(sizeKey))
 		return cadence.ConstantSizedArrayType{
@@ -915,6 +953,10 @@ func decodeType(valueJSON interface{}) cadence.Type {
 		return cadence.Fix64Type{}
 	case "UFix64":
 		return cadence.UFix64Type{}
+	case "Fix128":
+		return cadence.Fix128Type{}
+	case "UFix128":
+		return cadence.UFix128Type{}
 	case "Path":
 		return cadence.PathType{}
 	case "CapabilityPath":
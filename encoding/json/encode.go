@@ -182,6 +182,11 @@ type jsonRestrictedType struct {
 	Restrictions []jsonValue `json:"restrictions"`
 }
 
+type jsonTupleType struct {
+	Kind  string      `json:"kind"`
+	Types []jsonValue `json:"types"`
+}
+
 type jsonParameterType struct {
 	Label string    `json:"label"`
 	Id    string    `json:"id"`
@@ -231,8 +236,11 @@ const (
 	word64TypeStr     = "Word64"
 	fix64TypeStr      = "Fix64"
 	ufix64TypeStr     = "UFix64"
+	fix128TypeStr     = "Fix128"
+	ufix128TypeStr    = "UFix128"
 	arrayTypeStr      = "Array"
 	dictionaryTypeStr = "Dictionary"
+	tupleTypeStr      = "Tuple"
 	structTypeStr     = "Struct"
 	resourceTypeStr   = "Resource"
 	eventTypeStr      = "Event"
@@ -298,10 +306,16 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareFix64(x)
 	case cadence.UFix64:
 		return prepareUFix64(x)
+	case cadence.Fix128:
+		return prepareFix128(x)
+	case cadence.UFix128:
+		return prepareUFix128(x)
 	case cadence.Array:
 		return prepareArray(x)
 	case cadence.Dictionary:
 		return prepareDictionary(x)
+	case cadence.Tuple:
+		return prepareTuple(x)
 	case cadence.Struct:
 		return prepareStruct(x)
 	case cadence.Resource:
@@ -503,6 +517,20 @@ func prepareUFix64(v cadence.UFix64) jsonValue {
 	}
 }
 
+func prepareFix128(v cadence.Fix128) jsonValue {
+	return jsonValueObject{
+		Type:  fix128TypeStr,
+		Value: v.String(),
+	}
+}
+
+func prepareUFix128(v cadence.UFix128) jsonValue {
+	return jsonValueObject{
+		Type:  ufix128TypeStr,
+		Value: v.String(),
+	}
+}
+
 func prepareArray(v cadence.Array) jsonValue {
 	values := make([]jsonValue, len(v.Values))
 
@@ -516,6 +544,19 @@ func prepareArray(v cadence.Array) jsonValue {
 	}
 }
 
+func prepareTuple(v cadence.Tuple) jsonValue {
+	elements := make([]jsonValue, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elements[i] = Prepare(element)
+	}
+
+	return jsonValueObject{
+		Type:  tupleTypeStr,
+		Value: elements,
+	}
+}
+
 func prepareDictionary(v cadence.Dictionary) jsonValue {
 	items := make([]jsonDictionaryItem, len(v.Pairs))
 
@@ -687,6 +728,8 @@ func prepareType(typ cadence.Type) jsonValue {
 		cadence.Word64Type,
 		cadence.Fix64Type,
 		cadence.UFix64Type,
+		cadence.Fix128Type,
+		cadence.UFix128Type,
 		cadence.BlockType,
 		cadence.PathType,
 		cadence.CapabilityPathType,
@@ -726,6 +769,15 @@ func prepareType(typ cadence.Type) jsonValue {
 			KeyType:   prepareType(typ.KeyType),
 			ValueType: prepareType(typ.ElementType),
 		}
+	case cadence.TupleType:
+		elementTypes := make([]jsonValue, len(typ.ElementTypes))
+		for i, elementType := range typ.ElementTypes {
+			elementTypes[i] = prepareType(elementType)
+		}
+		return jsonTupleType{
+			Kind:  "Tuple",
+			Types: elementTypes,
+		}
 	case *cadence.StructType:
 		return jsonNominalType{
 			Kind:         "Struct",
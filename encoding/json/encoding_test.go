@@ -541,6 +541,69 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	newFix128 := func(s string) cadence.Fix128 {
+		v, err := cadence.NewFix128(s)
+		require.NoError(t, err)
+		return v
+	}
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			newFix128("0.0"),
+			`{"type":"Fix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"789.000000000000000123010",
+			newFix128("789.000000000000000123010"),
+			`{"type":"Fix128","value":"789.000000000000000123010000"}`,
+		},
+		{
+			"-12345.006789",
+			newFix128("-12345.006789"),
+			`{"type":"Fix128","value":"-12345.006789000000000000000000"}`,
+		},
+		{
+			"Max",
+			cadence.Fix128{Value: sema.Fix128TypeMaxBig},
+			`{"type":"Fix128","value":"170141183460469.231731687303715884105727"}`,
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	newUFix128 := func(s string) cadence.UFix128 {
+		v, err := cadence.NewUFix128(s)
+		require.NoError(t, err)
+		return v
+	}
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			newUFix128("0.0"),
+			`{"type":"UFix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"1234.000000000000000000000056",
+			newUFix128("1234.000000000000000000000056"),
+			`{"type":"UFix128","value":"1234.000000000000000000000056"}`,
+		},
+		{
+			"Max",
+			cadence.UFix128{Value: sema.UFix128TypeMaxBig},
+			`{"type":"UFix128","value":"340282366920938.463463374607431768211455"}`,
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -584,6 +647,37 @@ func TestEncodeArray(t *testing.T) {
 	)
 }
 
+func TestEncodeTuple(t *testing.T) {
+
+	t.Parallel()
+
+	simpleTuple := encodeTest{
+		"Simple",
+		cadence.NewTuple([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.String("a"),
+		}),
+		`{"type":"Tuple","value":[{"type":"Int","value":"1"},{"type":"String","value":"a"}]}`,
+	}
+
+	nestedTuple := encodeTest{
+		"Nested",
+		cadence.NewTuple([]cadence.Value{
+			cadence.NewTuple([]cadence.Value{
+				cadence.NewBool(true),
+				cadence.NewOptional(nil),
+			}),
+			cadence.NewInt(2),
+		}),
+		`{"type":"Tuple","value":[{"type":"Tuple","value":[{"type":"Bool","value":true},{"type":"Optional","value":null}]},{"type":"Int","value":"2"}]}`,
+	}
+
+	testAllEncodeAndDecode(t,
+		simpleTuple,
+		nestedTuple,
+	)
+}
+
 func TestEncodeDictionary(t *testing.T) {
 
 	t.Parallel()
@@ -1041,6 +1135,8 @@ func TestEncodeSimpleTypes(t *testing.T) {
 		cadence.Word64Type{},
 		cadence.Fix64Type{},
 		cadence.UFix64Type{},
+		cadence.Fix128Type{},
+		cadence.UFix128Type{},
 		cadence.BlockType{},
 		cadence.PathType{},
 		cadence.CapabilityPathType{},
@@ -1118,6 +1214,24 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static (int, string)", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: cadence.TupleType{
+					ElementTypes: []cadence.Type{
+						cadence.IntType{},
+						cadence.StringType{},
+					},
+				},
+			},
+			`{"type":"Type","value":{"staticType":{"kind":"Tuple", 
+			"types" : [{"kind" : "Int"}, {"kind" : "String"}]}}}`,
+		)
+
+	})
+
 	t.Run("with static {int:string}", func(t *testing.T) {
 
 		testEncodeAndDecode(
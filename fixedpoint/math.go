@@ -0,0 +1,365 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fixedpoint
+
+import (
+	"errors"
+	"math/big"
+)
+
+// The functions in this file operate on fixed-point values with scale Fix64Scale,
+// represented as big integers, i.e. the value 1.5 is represented as 150_000_000.
+//
+// All computations are performed using integer arithmetic only,
+// so the results are deterministic.
+//
+// The functions do not check if the results are in the range of a particular type,
+// this is the responsibility of the caller.
+
+var ErrDivisionByZero = errors.New("division by zero")
+var ErrOverflow = errors.New("overflow")
+var ErrOutOfDomain = errors.New("argument is out of domain")
+
+// RoundingMode specifies how a result which is not representable is rounded
+//
+type RoundingMode uint8
+
+const (
+	// RoundingModeTowardZero rounds toward zero, i.e. truncates
+	RoundingModeTowardZero RoundingMode = iota
+	// RoundingModeAwayFromZero rounds away from zero
+	RoundingModeAwayFromZero
+	// RoundingModeNearestHalfAway rounds to the nearest value, and ties away from zero
+	RoundingModeNearestHalfAway
+	// RoundingModeNearestHalfEven rounds to the nearest value, and ties to the even value
+	RoundingModeNearestHalfEven
+)
+
+// mathGuardDigits is the number of additional decimal digits
+// used for intermediate results of inexact computations
+//
+const mathGuardDigits = 20
+
+var bigOne = big.NewInt(1)
+
+var bigFix64Factor = big.NewInt(Fix64Factor)
+
+var mathGuardFactor = new(big.Int).Exp(big.NewInt(10), big.NewInt(mathGuardDigits), nil)
+
+// mathWorkingFactor is the factor of intermediate results of inexact computations
+//
+var mathWorkingFactor = new(big.Int).Mul(bigFix64Factor, mathGuardFactor)
+
+// mathLn2 is ln(2), scaled by mathWorkingFactor
+//
+var mathLn2 = func() *big.Int {
+	// ln(2) = 2 * atanh(1/3)
+	z := new(big.Int).Quo(mathWorkingFactor, big.NewInt(3))
+	return atanhTimesTwo(z)
+}()
+
+// DivRound returns numerator / denominator,
+// rounded using the given rounding mode.
+//
+func DivRound(numerator, denominator *big.Int, mode RoundingMode) (*big.Int, error) {
+	if denominator.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient, nil
+	}
+
+	var roundAway bool
+
+	switch mode {
+	case RoundingModeTowardZero:
+		roundAway = false
+
+	case RoundingModeAwayFromZero:
+		roundAway = true
+
+	case RoundingModeNearestHalfAway, RoundingModeNearestHalfEven:
+		twiceRemainder := new(big.Int).Lsh(new(big.Int).Abs(remainder), 1)
+		switch twiceRemainder.CmpAbs(denominator) {
+		case -1:
+			roundAway = false
+		case 0:
+			roundAway = mode == RoundingModeNearestHalfAway ||
+				quotient.Bit(0) == 1
+		case 1:
+			roundAway = true
+		}
+
+	default:
+		return nil, errors.New("invalid rounding mode")
+	}
+
+	if roundAway {
+		negative := (numerator.Sign() < 0) != (denominator.Sign() < 0)
+		if negative {
+			quotient.Sub(quotient, bigOne)
+		} else {
+			quotient.Add(quotient, bigOne)
+		}
+	}
+
+	return quotient, nil
+}
+
+// MulDiv returns x * y / z, rounded using the given rounding mode.
+//
+// The intermediate product is not rounded, so the result is exact before rounding.
+//
+func MulDiv(x, y, z *big.Int, mode RoundingMode) (*big.Int, error) {
+	product := new(big.Int).Mul(x, y)
+	return DivRound(product, z, mode)
+}
+
+// Sqrt returns the square root of x, rounded using the given rounding mode.
+//
+// The result is exact before rounding.
+// Returns ErrOutOfDomain if x is negative.
+//
+func Sqrt(x *big.Int, mode RoundingMode) (*big.Int, error) {
+	if x.Sign() < 0 {
+		return nil, ErrOutOfDomain
+	}
+
+	// sqrt(x / f) * f = sqrt(x * f)
+
+	n := new(big.Int).Mul(x, bigFix64Factor)
+	root := new(big.Int).Sqrt(n)
+
+	remainder := new(big.Int).Mul(root, root)
+	remainder.Sub(n, remainder)
+
+	if remainder.Sign() == 0 {
+		return root, nil
+	}
+
+	var roundUp bool
+
+	switch mode {
+	case RoundingModeTowardZero:
+		roundUp = false
+
+	case RoundingModeAwayFromZero:
+		roundUp = true
+
+	case RoundingModeNearestHalfAway, RoundingModeNearestHalfEven:
+		// The exact root is nearer to root + 1 if n > (root + 0.5)^2 = root^2 + root + 0.25,
+		// i.e. if n - root^2 > root, as the values are integers.
+		// A tie is not possible
+		roundUp = remainder.Cmp(root) > 0
+
+	default:
+		return nil, errors.New("invalid rounding mode")
+	}
+
+	if roundUp {
+		root.Add(root, bigOne)
+	}
+
+	return root, nil
+}
+
+// Pow returns x raised to the power of the given integer exponent,
+// rounded using the given rounding mode.
+//
+// Returns ErrOutOfDomain if x is negative.
+// Returns ErrOverflow if the result is larger than max.
+//
+func Pow(x *big.Int, exponent uint64, max *big.Int, mode RoundingMode) (*big.Int, error) {
+	if x.Sign() < 0 {
+		return nil, ErrOutOfDomain
+	}
+
+	// Exponentiation by squaring, using the working precision.
+	// Intermediate results are truncated.
+	//
+	// If x > 1, the intermediate results are increasing,
+	// so the computation can be stopped as soon as one exceeds the maximum
+
+	workingMax := new(big.Int).Mul(max, mathGuardFactor)
+	growing := x.Cmp(bigFix64Factor) > 0
+
+	result := new(big.Int).Set(mathWorkingFactor)
+	base := new(big.Int).Mul(x, mathGuardFactor)
+
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result.Mul(result, base)
+			result.Quo(result, mathWorkingFactor)
+
+			if growing && result.Cmp(workingMax) > 0 {
+				return nil, ErrOverflow
+			}
+		}
+
+		exponent >>= 1
+
+		if exponent > 0 {
+			if growing && base.Cmp(workingMax) > 0 {
+				return nil, ErrOverflow
+			}
+
+			base.Mul(base, base)
+			base.Quo(base, mathWorkingFactor)
+		}
+
+		if result.Sign() == 0 {
+			break
+		}
+	}
+
+	return DivRound(result, mathGuardFactor, mode)
+}
+
+// Ln returns the natural logarithm of x, rounded using the given rounding mode.
+//
+// Returns ErrOutOfDomain if x is not positive.
+//
+func Ln(x *big.Int, mode RoundingMode) (*big.Int, error) {
+	if x.Sign() <= 0 {
+		return nil, ErrOutOfDomain
+	}
+
+	// Reduce the argument: x = m * 2^k, where 1 <= m < 2,
+	// so ln(x) = ln(m) + k * ln(2)
+
+	workingX := new(big.Int).Mul(x, mathGuardFactor)
+
+	k := workingX.BitLen() - mathWorkingFactor.BitLen()
+
+	numerator := new(big.Int).Set(workingX)
+	denominator := new(big.Int).Set(mathWorkingFactor)
+	if k >= 0 {
+		denominator.Lsh(denominator, uint(k))
+	} else {
+		numerator.Lsh(numerator, uint(-k))
+	}
+
+	// ln(m) = 2 * atanh((m - 1) / (m + 1))
+
+	z := new(big.Int).Sub(numerator, denominator)
+	z.Mul(z, mathWorkingFactor)
+	z.Quo(z, new(big.Int).Add(numerator, denominator))
+
+	result := atanhTimesTwo(z)
+	result.Add(
+		result,
+		new(big.Int).Mul(big.NewInt(int64(k)), mathLn2),
+	)
+
+	return DivRound(result, mathGuardFactor, mode)
+}
+
+// mathExpMaxArgument is the largest argument for which Exp computes a result.
+// Results for larger arguments are far outside the range of any fixed-point type
+//
+var mathExpMaxArgument = new(big.Int).Mul(big.NewInt(128), bigFix64Factor)
+
+// mathExpMinArgument is the smallest argument for which Exp computes a result.
+// Results for smaller arguments are positive, but smaller than half of the smallest representable value
+//
+var mathExpMinArgument = new(big.Int).Mul(big.NewInt(-40), bigFix64Factor)
+
+// Exp returns e raised to the power of x, rounded using the given rounding mode.
+//
+// Returns ErrOverflow if x is larger than 128.
+//
+func Exp(x *big.Int, mode RoundingMode) (*big.Int, error) {
+	if x.Cmp(mathExpMaxArgument) > 0 {
+		return nil, ErrOverflow
+	}
+
+	if x.Cmp(mathExpMinArgument) < 0 {
+		// The exact result is positive, but smaller than half of the smallest representable value
+		if mode == RoundingModeAwayFromZero {
+			return big.NewInt(1), nil
+		}
+		return big.NewInt(0), nil
+	}
+
+	// Reduce the argument: x = r + k * ln(2), where |r| <= ln(2) / 2,
+	// so exp(x) = exp(r) * 2^k
+
+	workingX := new(big.Int).Mul(x, mathGuardFactor)
+
+	k, err := DivRound(workingX, mathLn2, RoundingModeNearestHalfEven)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(big.Int).Mul(k, mathLn2)
+	r.Sub(workingX, r)
+
+	// exp(r) = sum r^n / n!
+
+	result := new(big.Int).Set(mathWorkingFactor)
+	term := new(big.Int).Set(mathWorkingFactor)
+
+	for n := int64(1); ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, mathWorkingFactor)
+		term.Quo(term, big.NewInt(n))
+
+		if term.Sign() == 0 {
+			break
+		}
+
+		result.Add(result, term)
+	}
+
+	shift := k.Int64()
+	denominator := new(big.Int).Set(mathGuardFactor)
+	if shift >= 0 {
+		result.Lsh(result, uint(shift))
+	} else {
+		denominator.Lsh(denominator, uint(-shift))
+	}
+
+	return DivRound(result, denominator, mode)
+}
+
+// atanhTimesTwo returns 2 * atanh(z), where z is scaled by mathWorkingFactor, and |z| <= 1/3
+//
+func atanhTimesTwo(z *big.Int) *big.Int {
+
+	// atanh(z) = sum z^(2n + 1) / (2n + 1)
+
+	result := new(big.Int)
+
+	zSquared := new(big.Int).Mul(z, z)
+	zSquared.Quo(zSquared, mathWorkingFactor)
+
+	power := new(big.Int).Set(z)
+
+	for n := int64(0); power.Sign() != 0; n++ {
+		term := new(big.Int).Quo(power, big.NewInt(2*n+1))
+		result.Add(result, term)
+
+		power.Mul(power, zSquared)
+		power.Quo(power, mathWorkingFactor)
+	}
+
+	return result.Lsh(result, 1)
+}
@@ -0,0 +1,288 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fixedpoint
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var maxUFix64 = new(big.Int).SetUint64(math.MaxUint64)
+
+func TestDivRound(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		numerator, denominator int64
+		expected               map[RoundingMode]int64
+	}
+
+	for _, testCase := range []testCase{
+		{
+			numerator:   7,
+			denominator: 2,
+			expected: map[RoundingMode]int64{
+				RoundingModeTowardZero:      3,
+				RoundingModeAwayFromZero:    4,
+				RoundingModeNearestHalfAway: 4,
+				RoundingModeNearestHalfEven: 4,
+			},
+		},
+		{
+			numerator:   5,
+			denominator: 2,
+			expected: map[RoundingMode]int64{
+				RoundingModeTowardZero:      2,
+				RoundingModeAwayFromZero:    3,
+				RoundingModeNearestHalfAway: 3,
+				RoundingModeNearestHalfEven: 2,
+			},
+		},
+		{
+			numerator:   -5,
+			denominator: 2,
+			expected: map[RoundingMode]int64{
+				RoundingModeTowardZero:      -2,
+				RoundingModeAwayFromZero:    -3,
+				RoundingModeNearestHalfAway: -3,
+				RoundingModeNearestHalfEven: -2,
+			},
+		},
+		{
+			numerator:   10,
+			denominator: 3,
+			expected: map[RoundingMode]int64{
+				RoundingModeTowardZero:      3,
+				RoundingModeAwayFromZero:    4,
+				RoundingModeNearestHalfAway: 3,
+				RoundingModeNearestHalfEven: 3,
+			},
+		},
+		{
+			numerator:   9,
+			denominator: 3,
+			expected: map[RoundingMode]int64{
+				RoundingModeTowardZero:      3,
+				RoundingModeAwayFromZero:    3,
+				RoundingModeNearestHalfAway: 3,
+				RoundingModeNearestHalfEven: 3,
+			},
+		},
+	} {
+		for mode, expected := range testCase.expected {
+			result, err := DivRound(
+				big.NewInt(testCase.numerator),
+				big.NewInt(testCase.denominator),
+				mode,
+			)
+			require.NoError(t, err)
+			assert.Equal(t,
+				big.NewInt(expected),
+				result,
+				"%d / %d, mode %d",
+				testCase.numerator,
+				testCase.denominator,
+				mode,
+			)
+		}
+	}
+
+	_, err := DivRound(big.NewInt(1), big.NewInt(0), RoundingModeTowardZero)
+	require.Equal(t, ErrDivisionByZero, err)
+}
+
+func TestMulDiv(t *testing.T) {
+
+	t.Parallel()
+
+	// 1.0 * 2.0 / 3.0
+
+	result, err := MulDiv(
+		big.NewInt(100_000_000),
+		big.NewInt(2_00_000_000),
+		big.NewInt(3_00_000_000),
+		RoundingModeNearestHalfEven,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(66_666_667), result)
+
+	// the intermediate product does not overflow
+
+	result, err = MulDiv(
+		maxUFix64,
+		maxUFix64,
+		maxUFix64,
+		RoundingModeTowardZero,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, maxUFix64, result)
+
+	_, err = MulDiv(
+		big.NewInt(1),
+		big.NewInt(1),
+		big.NewInt(0),
+		RoundingModeTowardZero,
+	)
+	require.Equal(t, ErrDivisionByZero, err)
+}
+
+func TestSqrt(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(x int64, mode RoundingMode, expected int64) {
+		result, err := Sqrt(big.NewInt(x), mode)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(expected), result, "sqrt(%d), mode %d", x, mode)
+	}
+
+	// sqrt(4.0) = 2.0
+
+	test(4_00_000_000, RoundingModeTowardZero, 2_00_000_000)
+	test(4_00_000_000, RoundingModeAwayFromZero, 2_00_000_000)
+
+	// sqrt(2.0) = 1.41421356|237...
+
+	test(2_00_000_000, RoundingModeTowardZero, 1_41_421_356)
+	test(2_00_000_000, RoundingModeAwayFromZero, 1_41_421_357)
+	test(2_00_000_000, RoundingModeNearestHalfEven, 1_41_421_356)
+
+	// sqrt(3.0) = 1.73205080|756...
+
+	test(3_00_000_000, RoundingModeNearestHalfAway, 1_73_205_081)
+
+	_, err := Sqrt(big.NewInt(-1), RoundingModeTowardZero)
+	require.Equal(t, ErrOutOfDomain, err)
+}
+
+func TestPow(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(x int64, exponent uint64, mode RoundingMode, expected int64) {
+		result, err := Pow(big.NewInt(x), exponent, maxUFix64, mode)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(expected), result, "pow(%d, %d), mode %d", x, exponent, mode)
+	}
+
+	test(2_00_000_000, 0, RoundingModeTowardZero, 1_00_000_000)
+	test(2_00_000_000, 10, RoundingModeTowardZero, 1024_00_000_000)
+	test(0, 10, RoundingModeTowardZero, 0)
+
+	// 1.1^2 = 1.21
+
+	test(1_10_000_000, 2, RoundingModeAwayFromZero, 1_21_000_000)
+
+	// 0.5^10 = 0.0009765625
+
+	test(50_000_000, 10, RoundingModeTowardZero, 97_656)
+	test(50_000_000, 10, RoundingModeNearestHalfEven, 97_656)
+	test(50_000_000, 10, RoundingModeNearestHalfAway, 97_656)
+	test(50_000_000, 10, RoundingModeAwayFromZero, 97_657)
+
+	// 1.00000001^100_000_000 = 2.71828181...
+
+	test(1_00_000_001, 100_000_000, RoundingModeNearestHalfEven, 2_71_828_181)
+
+	_, err := Pow(big.NewInt(2_00_000_000), 64, maxUFix64, RoundingModeTowardZero)
+	require.Equal(t, ErrOverflow, err)
+
+	_, err = Pow(big.NewInt(1_00_000_001), math.MaxUint64, maxUFix64, RoundingModeTowardZero)
+	require.Equal(t, ErrOverflow, err)
+}
+
+func TestLn(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(x int64, mode RoundingMode, expected int64) {
+		result, err := Ln(big.NewInt(x), mode)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(expected), result, "ln(%d), mode %d", x, mode)
+	}
+
+	test(1_00_000_000, RoundingModeAwayFromZero, 0)
+
+	// ln(2) = 0.69314718|056...
+
+	test(2_00_000_000, RoundingModeTowardZero, 69_314_718)
+	test(2_00_000_000, RoundingModeAwayFromZero, 69_314_719)
+
+	// ln(0.5) = -0.69314718|056...
+
+	test(50_000_000, RoundingModeTowardZero, -69_314_718)
+	test(50_000_000, RoundingModeAwayFromZero, -69_314_719)
+
+	// ln(10) = 2.30258509|299...
+
+	test(10_00_000_000, RoundingModeNearestHalfEven, 2_30_258_509)
+
+	// ln(0.00000001) = -18.42068074|395...
+
+	test(1, RoundingModeNearestHalfEven, -18_42_068_074)
+
+	// ln(184467440737.09551615) = 25.94073881|188...
+
+	result, err := Ln(maxUFix64, RoundingModeNearestHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(25_94_073_881), result)
+
+	_, err = Ln(big.NewInt(0), RoundingModeTowardZero)
+	require.Equal(t, ErrOutOfDomain, err)
+}
+
+func TestExp(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(x int64, mode RoundingMode, expected int64) {
+		result, err := Exp(big.NewInt(x), mode)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(expected), result, "exp(%d), mode %d", x, mode)
+	}
+
+	test(0, RoundingModeAwayFromZero, 1_00_000_000)
+
+	// exp(1) = 2.71828182|845...
+
+	test(1_00_000_000, RoundingModeTowardZero, 2_71_828_182)
+	test(1_00_000_000, RoundingModeAwayFromZero, 2_71_828_183)
+	test(1_00_000_000, RoundingModeNearestHalfEven, 2_71_828_183)
+
+	// exp(-1) = 0.36787944|117...
+
+	test(-1_00_000_000, RoundingModeNearestHalfEven, 36_787_944)
+
+	// exp(20) = 485165195.40979027|796...
+
+	test(20_00_000_000, RoundingModeNearestHalfEven, 485165195_40_979_028)
+
+	// exp(-100) is positive, but tiny
+
+	test(-100_00_000_000, RoundingModeTowardZero, 0)
+	test(-100_00_000_000, RoundingModeAwayFromZero, 1)
+
+	_, err := Exp(big.NewInt(129_00_000_000), RoundingModeTowardZero)
+	require.Equal(t, ErrOverflow, err)
+}
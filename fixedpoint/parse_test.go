@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseFixedPoint(t *testing.T) {
@@ -57,3 +58,52 @@ func TestParseFixedPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFix128(t *testing.T) {
+
+	t.Parallel()
+
+	for input, expected := range map[string]string{
+		"0.000000000000000000000001": "1",
+		"-1.5":                       "-1500000000000000000000000",
+		"170141183460469.231731687303715884105727":  "170141183460469231731687303715884105727",
+		"-170141183460469.231731687303715884105728": "-170141183460469231731687303715884105728",
+	} {
+		result, err := ParseFix128(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, result.String(), input)
+	}
+
+	for _, input := range []string{
+		"170141183460469.231731687303715884105728",
+		"-170141183460469.231731687303715884105729",
+		"0.0000000000000000000000001",
+	} {
+		_, err := ParseFix128(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestParseUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	for input, expected := range map[string]string{
+		"0.000000000000000000000001": "1",
+		"1.5":                        "1500000000000000000000000",
+		"340282366920938.463463374607431768211455": "340282366920938463463374607431768211455",
+	} {
+		result, err := ParseUFix128(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, result.String(), input)
+	}
+
+	for _, input := range []string{
+		"340282366920938.463463374607431768211456",
+		"-1.0",
+		"0.0000000000000000000000001",
+	} {
+		_, err := ParseUFix128(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -86,6 +86,12 @@ func DeclarationKindToSymbolKind(kind common.DeclarationKind) protocol.SymbolKin
 		common.DeclarationKindContractInterface:
 		return protocol.Interface
 
+	case common.DeclarationKindEnum:
+		return protocol.Enum
+
+	case common.DeclarationKindEnumCase:
+		return protocol.EnumMember
+
 	case common.DeclarationKindTransaction:
 		return protocol.Namespace
 	}
@@ -27,6 +27,10 @@ import (
 	"github.com/onflow/flow-go-sdk"
 )
 
+// resolveFileImport returns the code of the imported file.
+// The location is the path of the file, which the server already resolved
+// relative to the importing file or the import search roots
+//
 func resolveFileImport(location common.StringLocation) (string, error) {
 	filename := string(location)
 
@@ -0,0 +1,190 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package protocol
+
+// NOTE: call hierarchies were added in version 3.16 of the protocol,
+// and type hierarchies were added in version 3.17 of the protocol,
+// so they are not part of the generated types
+
+/*CallHierarchyPrepareParams defined:
+ * The parameter of a `textDocument/prepareCallHierarchy` request.
+ */
+type CallHierarchyPrepareParams struct {
+	TextDocumentPositionParams
+}
+
+/*CallHierarchyItem defined:
+ * Represents programming constructs like functions or constructors in the context
+ * of call hierarchy.
+ */
+type CallHierarchyItem struct {
+
+	/*Name defined:
+	 * The name of this item.
+	 */
+	Name string `json:"name"`
+
+	/*Kind defined:
+	 * The kind of this item.
+	 */
+	Kind SymbolKind `json:"kind"`
+
+	/*Detail defined:
+	 * More detail for this item, e.g. the signature of a function.
+	 */
+	Detail string `json:"detail,omitempty"`
+
+	/*URI defined:
+	 * The resource identifier of this item.
+	 */
+	URI DocumentUri `json:"uri"`
+
+	/*Range defined:
+	 * The range enclosing this symbol not including leading/trailing whitespace
+	 * but everything else, e.g. comments and code.
+	 */
+	Range Range `json:"range"`
+
+	/*SelectionRange defined:
+	 * The range that should be selected and revealed when this symbol is being
+	 * picked, e.g. the name of a function. Must be contained by the
+	 * [`range`](#CallHierarchyItem.range).
+	 */
+	SelectionRange Range `json:"selectionRange"`
+
+	/*Data defined:
+	 * A data entry field that is preserved between a call hierarchy prepare and
+	 * incoming calls or outgoing calls requests.
+	 */
+	Data interface{} `json:"data,omitempty"`
+}
+
+/*CallHierarchyIncomingCallsParams defined:
+ * The parameter of a `callHierarchy/incomingCalls` request.
+ */
+type CallHierarchyIncomingCallsParams struct {
+	Item CallHierarchyItem `json:"item"`
+}
+
+/*CallHierarchyIncomingCall defined:
+ * Represents an incoming call, e.g. a caller of a method or constructor.
+ */
+type CallHierarchyIncomingCall struct {
+
+	/*From defined:
+	 * The item that makes the call.
+	 */
+	From *CallHierarchyItem `json:"from"`
+
+	/*FromRanges defined:
+	 * The ranges at which the calls appear. This is relative to the caller
+	 * denoted by [`this.from`](#CallHierarchyIncomingCall.from).
+	 */
+	FromRanges []Range `json:"fromRanges"`
+}
+
+/*CallHierarchyOutgoingCallsParams defined:
+ * The parameter of a `callHierarchy/outgoingCalls` request.
+ */
+type CallHierarchyOutgoingCallsParams struct {
+	Item CallHierarchyItem `json:"item"`
+}
+
+/*CallHierarchyOutgoingCall defined:
+ * Represents an outgoing call, e.g. calling a getter from a method or a method from a constructor etc.
+ */
+type CallHierarchyOutgoingCall struct {
+
+	/*To defined:
+	 * The item that is called.
+	 */
+	To *CallHierarchyItem `json:"to"`
+
+	/*FromRanges defined:
+	 * The range at which this item is called. This is the range relative to
+	 * the caller, e.g the item passed to `callHierarchy/outgoingCalls` request.
+	 */
+	FromRanges []Range `json:"fromRanges"`
+}
+
+/*TypeHierarchyPrepareParams defined:
+ * The parameter of a `textDocument/prepareTypeHierarchy` request.
+ */
+type TypeHierarchyPrepareParams struct {
+	TextDocumentPositionParams
+}
+
+/*TypeHierarchyItem defined:
+ * Represents a type in the context of type hierarchy.
+ */
+type TypeHierarchyItem struct {
+
+	/*Name defined:
+	 * The name of this item.
+	 */
+	Name string `json:"name"`
+
+	/*Kind defined:
+	 * The kind of this item.
+	 */
+	Kind SymbolKind `json:"kind"`
+
+	/*Detail defined:
+	 * More detail for this item, e.g. the signature of a function.
+	 */
+	Detail string `json:"detail,omitempty"`
+
+	/*URI defined:
+	 * The resource identifier of this item.
+	 */
+	URI DocumentUri `json:"uri"`
+
+	/*Range defined:
+	 * The range enclosing this symbol not including leading/trailing whitespace
+	 * but everything else, e.g. comments and code.
+	 */
+	Range Range `json:"range"`
+
+	/*SelectionRange defined:
+	 * The range that should be selected and revealed when this symbol is being
+	 * picked, e.g. the name of a function. Must be contained by the
+	 * [`range`](#TypeHierarchyItem.range).
+	 */
+	SelectionRange Range `json:"selectionRange"`
+
+	/*Data defined:
+	 * A data entry field that is preserved between a type hierarchy prepare and
+	 * supertypes or subtypes requests.
+	 */
+	Data interface{} `json:"data,omitempty"`
+}
+
+/*TypeHierarchySupertypesParams defined:
+ * The parameter of a `typeHierarchy/supertypes` request.
+ */
+type TypeHierarchySupertypesParams struct {
+	Item TypeHierarchyItem `json:"item"`
+}
+
+/*TypeHierarchySubtypesParams defined:
+ * The parameter of a `typeHierarchy/subtypes` request.
+ */
+type TypeHierarchySubtypesParams struct {
+	Item TypeHierarchyItem `json:"item"`
+}
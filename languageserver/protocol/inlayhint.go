@@ -0,0 +1,93 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package protocol
+
+// NOTE: inlay hints were added in version 3.17 of the protocol,
+// so they are not part of the generated types
+
+/*InlayHintParams defined:
+ * A parameter literal used in inlay hint requests.
+ */
+type InlayHintParams struct {
+
+	/*TextDocument defined:
+	 * The text document.
+	 */
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	/*Range defined:
+	 * The visible document range for which inlay hints should be computed.
+	 */
+	Range Range `json:"range"`
+}
+
+/*InlayHintKind defined:
+ * Inlay hint kinds.
+ */
+type InlayHintKind float64
+
+const (
+
+	/*TypeInlayHint defined:
+	 * An inlay hint that is for a type annotation.
+	 */
+	TypeInlayHint InlayHintKind = 1
+
+	/*ParameterInlayHint defined:
+	 * An inlay hint that is for a parameter.
+	 */
+	ParameterInlayHint InlayHintKind = 2
+)
+
+/*InlayHint defined:
+ * Inlay hint information.
+ */
+type InlayHint struct {
+
+	/*Position defined:
+	 * The position of this hint.
+	 */
+	Position Position `json:"position"`
+
+	/*Label defined:
+	 * The label of this hint.
+	 */
+	Label string `json:"label"`
+
+	/*Kind defined:
+	 * The kind of this hint. Can be omitted in which case the client
+	 * should fall back to a reasonable default.
+	 */
+	Kind InlayHintKind `json:"kind,omitempty"`
+
+	/*Tooltip defined:
+	 * The tooltip text when you hover over this item.
+	 */
+	Tooltip string `json:"tooltip,omitempty"`
+
+	/*PaddingLeft defined:
+	 * Render padding before the hint.
+	 */
+	PaddingLeft bool `json:"paddingLeft,omitempty"`
+
+	/*PaddingRight defined:
+	 * Render padding after the hint.
+	 */
+	PaddingRight bool `json:"paddingRight,omitempty"`
+}
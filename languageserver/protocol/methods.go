@@ -94,6 +94,15 @@ func (s *Server) handleRename(req *json.RawMessage) (interface{}, error) {
 	return s.Handler.Rename(s.conn, &params)
 }
 
+func (s *Server) handleReferences(req *json.RawMessage) (interface{}, error) {
+	var params ReferenceParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	return s.Handler.References(s.conn, &params)
+}
+
 func (s *Server) handleCodeAction(req *json.RawMessage) (interface{}, error) {
 	var params CodeActionParams
 	if err := json.Unmarshal(*req, &params); err != nil {
@@ -147,6 +156,128 @@ func (s *Server) handleDocumentSymbol(req *json.RawMessage) (interface{}, error)
 	return s.Handler.DocumentSymbol(s.conn, &params)
 }
 
+func (s *Server) handleSemanticTokensFull(req *json.RawMessage) (interface{}, error) {
+	var params SemanticTokensParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.SemanticTokensFull(s.conn, &params)
+}
+
+func (s *Server) handleSemanticTokensRange(req *json.RawMessage) (interface{}, error) {
+	var params SemanticTokensRangeParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.SemanticTokensRange(s.conn, &params)
+}
+
+func (s *Server) handleInlayHint(req *json.RawMessage) (interface{}, error) {
+	var params InlayHintParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.InlayHint(s.conn, &params)
+}
+
+func (s *Server) handleFoldingRange(req *json.RawMessage) (interface{}, error) {
+	var params FoldingRangeParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.FoldingRange(s.conn, &params)
+}
+
+func (s *Server) handleSelectionRange(req *json.RawMessage) (interface{}, error) {
+	var params SelectionRangeParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.SelectionRange(s.conn, &params)
+}
+
+func (s *Server) handlePrepareCallHierarchy(req *json.RawMessage) (interface{}, error) {
+	var params CallHierarchyPrepareParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.PrepareCallHierarchy(s.conn, &params)
+}
+
+func (s *Server) handleCallHierarchyIncomingCalls(req *json.RawMessage) (interface{}, error) {
+	var params CallHierarchyIncomingCallsParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.CallHierarchyIncomingCalls(s.conn, &params)
+}
+
+func (s *Server) handleCallHierarchyOutgoingCalls(req *json.RawMessage) (interface{}, error) {
+	var params CallHierarchyOutgoingCallsParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.CallHierarchyOutgoingCalls(s.conn, &params)
+}
+
+func (s *Server) handlePrepareTypeHierarchy(req *json.RawMessage) (interface{}, error) {
+	var params TypeHierarchyPrepareParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.PrepareTypeHierarchy(s.conn, &params)
+}
+
+func (s *Server) handleTypeHierarchySupertypes(req *json.RawMessage) (interface{}, error) {
+	var params TypeHierarchySupertypesParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.TypeHierarchySupertypes(s.conn, &params)
+}
+
+func (s *Server) handleTypeHierarchySubtypes(req *json.RawMessage) (interface{}, error) {
+	var params TypeHierarchySubtypesParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.TypeHierarchySubtypes(s.conn, &params)
+}
+
+func (s *Server) handleWorkspaceSymbol(req *json.RawMessage) (interface{}, error) {
+	var params WorkspaceSymbolParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.WorkspaceSymbol(s.conn, &params)
+}
+
+func (s *Server) handleDidChangeWatchedFiles(req *json.RawMessage) (interface{}, error) {
+	var params DidChangeWatchedFilesParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+
+	err := s.Handler.DidChangeWatchedFiles(s.conn, &params)
+	return nil, err
+}
+
+func (s *Server) handleDocumentFormatting(req *json.RawMessage) (interface{}, error) {
+	var params DocumentFormattingParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.DocumentFormatting(s.conn, &params)
+}
+
+func (s *Server) handleDocumentRangeFormatting(req *json.RawMessage) (interface{}, error) {
+	var params DocumentRangeFormattingParams
+	if err := json.Unmarshal(*req, &params); err != nil {
+		return nil, err
+	}
+	return s.Handler.DocumentRangeFormatting(s.conn, &params)
+}
+
 func (s *Server) handleShutdown(_ *json.RawMessage) (interface{}, error) {
 	err := s.Handler.Shutdown(s.conn)
 	return nil, err
@@ -0,0 +1,107 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package protocol
+
+// NOTE: semantic tokens were added in version 3.16 of the protocol,
+// so they are not part of the generated types
+
+/*SemanticTokensLegend defined:
+ * The legend of the semantic tokens provided by the server:
+ * the token types and modifiers, which are referred to by index in the encoded tokens.
+ */
+type SemanticTokensLegend struct {
+
+	/*TokenTypes defined:
+	 * The token types a server uses.
+	 */
+	TokenTypes []string `json:"tokenTypes"`
+
+	/*TokenModifiers defined:
+	 * The token modifiers a server uses.
+	 */
+	TokenModifiers []string `json:"tokenModifiers"`
+}
+
+/*SemanticTokensOptions defined:
+ * Semantic tokens options of the server.
+ */
+type SemanticTokensOptions struct {
+
+	/*Legend defined:
+	 * The legend used by the server
+	 */
+	Legend SemanticTokensLegend `json:"legend"`
+
+	/*Range defined:
+	 * Server supports providing semantic tokens for a specific range
+	 * of a document.
+	 */
+	Range bool `json:"range,omitempty"`
+
+	/*Full defined:
+	 * Server supports providing semantic tokens for a full document.
+	 */
+	Full bool `json:"full,omitempty"`
+}
+
+/*SemanticTokensParams defined:
+ * Parameters for a semantic tokens request of a full document.
+ */
+type SemanticTokensParams struct {
+
+	/*TextDocument defined:
+	 * The text document.
+	 */
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+/*SemanticTokensRangeParams defined:
+ * Parameters for a semantic tokens request of a range of a document.
+ */
+type SemanticTokensRangeParams struct {
+
+	/*TextDocument defined:
+	 * The text document.
+	 */
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	/*Range defined:
+	 * The range the semantic tokens are requested for.
+	 */
+	Range Range `json:"range"`
+}
+
+/*SemanticTokens defined:
+ * The semantic tokens of a document.
+ */
+type SemanticTokens struct {
+
+	/*ResultID defined:
+	 * An optional result id.
+	 */
+	ResultID string `json:"resultId,omitempty"`
+
+	/*Data defined:
+	 * The encoded tokens: each token is encoded as five integers:
+	 * the line delta, the start character delta, the length,
+	 * the index of the token type, and the bit set of the token modifier indices.
+	 * Deltas are relative to the previous token.
+	 */
+	Data []uint32 `json:"data"`
+}
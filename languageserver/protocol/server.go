@@ -19,6 +19,9 @@
 package protocol
 
 import (
+	"encoding/json"
+	"sync"
+
 	"github.com/onflow/cadence/languageserver/jsonrpc2"
 )
 
@@ -86,12 +89,28 @@ type Handler interface {
 	SignatureHelp(conn Conn, params *TextDocumentPositionParams) (*SignatureHelp, error)
 	DocumentHighlight(conn Conn, params *TextDocumentPositionParams) ([]*DocumentHighlight, error)
 	Rename(conn Conn, params *RenameParams) (*WorkspaceEdit, error)
+	References(conn Conn, params *ReferenceParams) ([]*Location, error)
 	CodeAction(conn Conn, params *CodeActionParams) ([]*CodeAction, error)
 	CodeLens(conn Conn, params *CodeLensParams) ([]*CodeLens, error)
 	Completion(conn Conn, params *CompletionParams) ([]*CompletionItem, error)
 	ResolveCompletionItem(conn Conn, item *CompletionItem) (*CompletionItem, error)
 	ExecuteCommand(conn Conn, params *ExecuteCommandParams) (interface{}, error)
 	DocumentSymbol(conn Conn, params *DocumentSymbolParams) ([]*DocumentSymbol, error)
+	SemanticTokensFull(conn Conn, params *SemanticTokensParams) (*SemanticTokens, error)
+	SemanticTokensRange(conn Conn, params *SemanticTokensRangeParams) (*SemanticTokens, error)
+	InlayHint(conn Conn, params *InlayHintParams) ([]*InlayHint, error)
+	FoldingRange(conn Conn, params *FoldingRangeParams) ([]*FoldingRange, error)
+	SelectionRange(conn Conn, params *SelectionRangeParams) ([]*SelectionRange, error)
+	PrepareCallHierarchy(conn Conn, params *CallHierarchyPrepareParams) ([]*CallHierarchyItem, error)
+	CallHierarchyIncomingCalls(conn Conn, params *CallHierarchyIncomingCallsParams) ([]*CallHierarchyIncomingCall, error)
+	CallHierarchyOutgoingCalls(conn Conn, params *CallHierarchyOutgoingCallsParams) ([]*CallHierarchyOutgoingCall, error)
+	PrepareTypeHierarchy(conn Conn, params *TypeHierarchyPrepareParams) ([]*TypeHierarchyItem, error)
+	TypeHierarchySupertypes(conn Conn, params *TypeHierarchySupertypesParams) ([]*TypeHierarchyItem, error)
+	TypeHierarchySubtypes(conn Conn, params *TypeHierarchySubtypesParams) ([]*TypeHierarchyItem, error)
+	WorkspaceSymbol(conn Conn, params *WorkspaceSymbolParams) ([]*SymbolInformation, error)
+	DidChangeWatchedFiles(conn Conn, params *DidChangeWatchedFilesParams) error
+	DocumentFormatting(conn Conn, params *DocumentFormattingParams) ([]*TextEdit, error)
+	DocumentRangeFormatting(conn Conn, params *DocumentRangeFormattingParams) ([]*TextEdit, error)
 	Shutdown(conn Conn) error
 	Exit(conn Conn) error
 }
@@ -136,6 +155,9 @@ func NewServer(handler Handler) *Server {
 	jsonrpc2Server.Methods["textDocument/rename"] =
 		server.handleRename
 
+	jsonrpc2Server.Methods["textDocument/references"] =
+		server.handleReferences
+
 	jsonrpc2Server.Methods["textDocument/codeAction"] =
 		server.handleCodeAction
 
@@ -151,15 +173,78 @@ func NewServer(handler Handler) *Server {
 	jsonrpc2Server.Methods["textDocument/documentSymbol"] =
 		server.handleDocumentSymbol
 
+	jsonrpc2Server.Methods["textDocument/semanticTokens/full"] =
+		server.handleSemanticTokensFull
+
+	jsonrpc2Server.Methods["textDocument/semanticTokens/range"] =
+		server.handleSemanticTokensRange
+
+	jsonrpc2Server.Methods["textDocument/inlayHint"] =
+		server.handleInlayHint
+
+	jsonrpc2Server.Methods["textDocument/foldingRange"] =
+		server.handleFoldingRange
+
+	jsonrpc2Server.Methods["textDocument/selectionRange"] =
+		server.handleSelectionRange
+
+	jsonrpc2Server.Methods["textDocument/prepareCallHierarchy"] =
+		server.handlePrepareCallHierarchy
+
+	jsonrpc2Server.Methods["callHierarchy/incomingCalls"] =
+		server.handleCallHierarchyIncomingCalls
+
+	jsonrpc2Server.Methods["callHierarchy/outgoingCalls"] =
+		server.handleCallHierarchyOutgoingCalls
+
+	jsonrpc2Server.Methods["textDocument/prepareTypeHierarchy"] =
+		server.handlePrepareTypeHierarchy
+
+	jsonrpc2Server.Methods["typeHierarchy/supertypes"] =
+		server.handleTypeHierarchySupertypes
+
+	jsonrpc2Server.Methods["typeHierarchy/subtypes"] =
+		server.handleTypeHierarchySubtypes
+
+	jsonrpc2Server.Methods["workspace/symbol"] =
+		server.handleWorkspaceSymbol
+
+	jsonrpc2Server.Methods["workspace/didChangeWatchedFiles"] =
+		server.handleDidChangeWatchedFiles
+
+	jsonrpc2Server.Methods["textDocument/formatting"] =
+		server.handleDocumentFormatting
+
+	jsonrpc2Server.Methods["textDocument/rangeFormatting"] =
+		server.handleDocumentRangeFormatting
+
 	jsonrpc2Server.Methods["shutdown"] =
 		server.handleShutdown
 
 	jsonrpc2Server.Methods["exit"] =
 		server.handleExit
 
+	// If the handler performs work concurrently, e.g. in the background,
+	// it synchronizes the work with the handling of requests and notifications using its lock
+
+	if locker, ok := handler.(sync.Locker); ok {
+		for name, method := range jsonrpc2Server.Methods {
+			jsonrpc2Server.Methods[name] = synchronizedMethod(locker, method)
+		}
+	}
+
 	return server
 }
 
+func synchronizedMethod(locker sync.Locker, method jsonrpc2.Method) jsonrpc2.Method {
+	return func(params *json.RawMessage) (interface{}, error) {
+		locker.Lock()
+		defer locker.Unlock()
+
+		return method(params)
+	}
+}
+
 func (s *Server) Start(stream jsonrpc2.ObjectStream) <-chan struct{} {
 	return s.jsonrpc2Server.Start(stream)
 }
@@ -1646,6 +1646,26 @@ type ServerCapabilities struct {
 	 * The server provides selection range support.
 	 */
 	SelectionRangeProvider bool `json:"selectionRangeProvider,omitempty"` // boolean | (TextDocumentRegistrationOptions & StaticRegistrationOptions & SelectionRangeProviderOptions)
+
+	/*SemanticTokensProvider defined:
+	 * The server provides semantic tokens support.
+	 */
+	SemanticTokensProvider *SemanticTokensOptions `json:"semanticTokensProvider,omitempty"`
+
+	/*InlayHintProvider defined:
+	 * The server provides inlay hints.
+	 */
+	InlayHintProvider bool `json:"inlayHintProvider,omitempty"`
+
+	/*CallHierarchyProvider defined:
+	 * The server provides call hierarchy support.
+	 */
+	CallHierarchyProvider bool `json:"callHierarchyProvider,omitempty"`
+
+	/*TypeHierarchyProvider defined:
+	 * The server provides type hierarchy support.
+	 */
+	TypeHierarchyProvider bool `json:"typeHierarchyProvider,omitempty"`
 }
 
 // InitializeParams is
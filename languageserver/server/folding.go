@@ -0,0 +1,399 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sort"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/parser2/lexer"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const (
+	preConditionsKeyword  = "pre"
+	postConditionsKeyword = "post"
+)
+
+// walkElements calls the given function for the given element and all its descendants, in depth-first order.
+// The children of an element are only walked if the function returns true.
+//
+// NOTE: ast.Inspect cannot be used, as some elements have nil children, e.g. the default case of a switch statement
+//
+func walkElements(element ast.Element, f func(ast.Element) bool) {
+	if element == nil || !f(element) {
+		return
+	}
+
+	element.Walk(func(child ast.Element) {
+		walkElements(child, f)
+	})
+
+	// NOTE: the conditions of functions are not walked by their elements
+
+	if functionBlock, ok := element.(*ast.FunctionBlock); ok {
+		walkConditions(functionBlock.PreConditions, f)
+		walkConditions(functionBlock.PostConditions, f)
+	}
+}
+
+func walkConditions(conditions *ast.Conditions, f func(ast.Element) bool) {
+	if conditions == nil {
+		return
+	}
+
+	for _, condition := range *conditions {
+		walkElements(condition.Test, f)
+		walkElements(condition.Message, f)
+	}
+}
+
+// codeTokens are the tokens of code, excluding whitespace
+//
+type codeTokens struct {
+	tokens []lexer.Token
+	// comments are the ranges of comments. Block comments have a single range,
+	// even if they contain nested comments
+	comments []ast.Range
+}
+
+func lexCodeTokens(code string) codeTokens {
+	tokens := lexer.Lex(code)
+	defer tokens.Close()
+
+	var result codeTokens
+
+	commentDepth := 0
+	var commentStart ast.Position
+
+	for {
+		token := tokens.Next()
+
+		switch token.Type {
+		case lexer.TokenEOF, lexer.TokenError:
+			return result
+
+		case lexer.TokenSpace, lexer.TokenBlockCommentContent:
+			continue
+
+		case lexer.TokenLineComment:
+			result.comments = append(result.comments, token.Range)
+
+		case lexer.TokenBlockCommentStart:
+			if commentDepth == 0 {
+				commentStart = token.StartPos
+			}
+			commentDepth++
+
+		case lexer.TokenBlockCommentEnd:
+			commentDepth--
+			if commentDepth == 0 {
+				result.comments = append(result.comments, ast.Range{
+					StartPos: commentStart,
+					EndPos:   token.EndPos,
+				})
+			}
+
+		default:
+			result.tokens = append(result.tokens, token)
+		}
+	}
+}
+
+// conditionsRange returns the range of the conditions with the given keyword, i.e. `pre` or `post`,
+// which are declared in the block that starts at the first opening brace after the given offset.
+// Returns false if the conditions are not found
+//
+func (t codeTokens) conditionsRange(offset int, keyword string) (ast.Range, bool) {
+	depth := 0
+	var keywordToken *lexer.Token
+
+	start := sort.Search(len(t.tokens), func(i int) bool {
+		return t.tokens[i].StartPos.Offset >= offset
+	})
+
+	for i := start; i < len(t.tokens); i++ {
+		token := t.tokens[i]
+
+		switch token.Type {
+		case lexer.TokenBraceOpen:
+			depth++
+
+			if depth == 2 && keywordToken == nil && i > 0 &&
+				t.tokens[i-1].IsString(lexer.TokenIdentifier, keyword) {
+
+				keywordToken = &t.tokens[i-1]
+			}
+
+		case lexer.TokenBraceClose:
+			depth--
+
+			if depth == 1 && keywordToken != nil {
+				return ast.Range{
+					StartPos: keywordToken.StartPos,
+					EndPos:   token.EndPos,
+				}, true
+			}
+
+			if depth == 0 {
+				return ast.Range{}, false
+			}
+		}
+	}
+
+	return ast.Range{}, false
+}
+
+// conditionsRanges returns the ranges of the pre-conditions and post-conditions
+// of the given function block or transaction declaration
+//
+func (t codeTokens) conditionsRanges(element ast.Element) (ranges []ast.Range) {
+	var offset int
+	var preConditions, postConditions *ast.Conditions
+
+	switch element := element.(type) {
+	case *ast.FunctionBlock:
+		offset = element.Block.StartPos.Offset
+		preConditions = element.PreConditions
+		postConditions = element.PostConditions
+
+	case *ast.TransactionDeclaration:
+		// The parameter list might contain braces, e.g. in dictionary types
+		offset = element.StartPos.Offset
+		if element.ParameterList != nil {
+			offset = element.ParameterList.EndPos.Offset
+		}
+		preConditions = element.PreConditions
+		postConditions = element.PostConditions
+
+	default:
+		return nil
+	}
+
+	if preConditions != nil && len(*preConditions) > 0 {
+		if r, ok := t.conditionsRange(offset, preConditionsKeyword); ok {
+			ranges = append(ranges, r)
+		}
+	}
+
+	if postConditions != nil && len(*postConditions) > 0 {
+		if r, ok := t.conditionsRange(offset, postConditionsKeyword); ok {
+			ranges = append(ranges, r)
+		}
+	}
+
+	return ranges
+}
+
+// foldingRangeCollector collects the folding ranges of a document
+//
+type foldingRangeCollector struct {
+	ranges []*protocol.FoldingRange
+	// startLines are the lines at which folding ranges start.
+	// Only one folding range is added per line, the outermost
+	startLines map[int]struct{}
+}
+
+// add adds a folding range for the given lines, if they span multiple lines
+//
+func (c *foldingRangeCollector) add(startLine, endLine int, kind protocol.FoldingRangeKind) {
+	if endLine <= startLine {
+		return
+	}
+
+	if _, ok := c.startLines[startLine]; ok {
+		return
+	}
+	c.startLines[startLine] = struct{}{}
+
+	c.ranges = append(c.ranges, &protocol.FoldingRange{
+		// AST lines are 1-based, protocol lines are 0-based
+		StartLine: float64(startLine - 1),
+		EndLine:   float64(endLine - 1),
+		Kind:      string(kind),
+	})
+}
+
+// addBraced adds a folding range for the given range, which ends with a closing brace.
+// The line of the closing brace is not folded, so it stays visible
+//
+func (c *foldingRangeCollector) addBraced(r ast.HasPosition) {
+	c.add(r.StartPosition().Line, r.EndPosition().Line-1, "")
+}
+
+func (c *foldingRangeCollector) addImports(program *ast.Program) {
+	var first, last *ast.ImportDeclaration
+
+	addGroup := func() {
+		if first != nil {
+			c.add(first.StartPos.Line, last.EndPos.Line, protocol.Imports)
+		}
+		first = nil
+		last = nil
+	}
+
+	for _, declaration := range program.Declarations() {
+		importDeclaration, ok := declaration.(*ast.ImportDeclaration)
+		if !ok {
+			addGroup()
+			continue
+		}
+
+		if first == nil {
+			first = importDeclaration
+		}
+		last = importDeclaration
+	}
+
+	addGroup()
+}
+
+// addComments adds folding ranges for multi-line block comments
+// and for groups of line comments on consecutive lines
+//
+func (c *foldingRangeCollector) addComments(tokens codeTokens) {
+	// codeLines are the lines that contain code, i.e. comments that are on the same line are not grouped
+	codeLines := map[int]struct{}{}
+	for _, token := range tokens.tokens {
+		codeLines[token.StartPos.Line] = struct{}{}
+	}
+
+	var groupStart, groupEnd int
+	inGroup := false
+
+	endGroup := func() {
+		if inGroup {
+			c.add(groupStart, groupEnd, protocol.Comment)
+		}
+		inGroup = false
+	}
+
+	for _, comment := range tokens.comments {
+		startLine := comment.StartPos.Line
+		endLine := comment.EndPos.Line
+
+		if startLine != endLine {
+			endGroup()
+			c.add(startLine, endLine, protocol.Comment)
+			continue
+		}
+
+		_, hasCode := codeLines[startLine]
+
+		if hasCode || !inGroup || startLine != groupEnd+1 {
+			endGroup()
+		}
+
+		if hasCode {
+			continue
+		}
+
+		if !inGroup {
+			groupStart = startLine
+			inGroup = true
+		}
+		groupEnd = endLine
+	}
+
+	endGroup()
+}
+
+func (c *foldingRangeCollector) addElements(program *ast.Program, tokens codeTokens) {
+	for _, declaration := range program.Declarations() {
+		walkElements(declaration, func(element ast.Element) bool {
+			switch element := element.(type) {
+			case *ast.CompositeDeclaration,
+				*ast.InterfaceDeclaration,
+				*ast.FunctionDeclaration,
+				*ast.SpecialFunctionDeclaration,
+				*ast.Block,
+				*ast.DictionaryExpression,
+				*ast.ArrayExpression:
+
+				c.addBraced(element)
+
+			case *ast.TransactionDeclaration:
+				c.addBraced(element)
+
+				for _, r := range tokens.conditionsRanges(element) {
+					c.addBraced(r)
+				}
+
+			case *ast.FunctionBlock:
+				for _, r := range tokens.conditionsRanges(element) {
+					c.addBraced(r)
+				}
+
+			case *ast.SwitchStatement:
+				c.addBraced(element)
+
+				for _, switchCase := range element.Cases {
+					c.add(switchCase.StartPos.Line, switchCase.EndPos.Line, "")
+				}
+			}
+
+			return true
+		})
+	}
+}
+
+// foldingRanges returns the folding ranges of the given code:
+// declarations, blocks, conditions, comments, and groups of imports
+//
+func foldingRanges(code string) []*protocol.FoldingRange {
+	collector := &foldingRangeCollector{
+		startLines: map[int]struct{}{},
+	}
+
+	tokens := lexCodeTokens(code)
+
+	// NOTE: the program might be partial if there are syntax errors
+	program, _ := parser2.ParseProgram(code)
+	if program != nil {
+		collector.addImports(program)
+		collector.addElements(program, tokens)
+	}
+
+	collector.addComments(tokens)
+
+	return collector.ranges
+}
+
+// FoldingRange returns the folding ranges of the document
+//
+func (s *Server) FoldingRange(
+	_ protocol.Conn,
+	params *protocol.FoldingRangeParams,
+) (
+	[]*protocol.FoldingRange,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	ranges := []*protocol.FoldingRange{}
+
+	document, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return ranges, nil
+	}
+
+	return append(ranges, foldingRanges(document.Text)...), nil
+}
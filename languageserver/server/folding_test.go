@@ -0,0 +1,161 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const testFoldingCode = `
+import Foo from 0x1
+import Bar from 0x2
+
+// A test contract.
+// It has a resource
+pub contract Test {
+
+    /* The resource
+       of the contract */
+    pub resource R {
+
+        pub fun test(x: Int): Int {
+            pre {
+                x > 0:
+                    "x must be positive"
+            }
+            if x > 1 {
+                return 1
+            }
+            return 0 // zero
+        }
+    }
+}
+`
+
+// testFoldingRanges returns the given folding ranges as strings
+// of the form `startLine-endLine kind`
+//
+func testFoldingRanges(ranges []*protocol.FoldingRange) []string {
+	result := make([]string, len(ranges))
+	for i, r := range ranges {
+		result[i] = fmt.Sprintf("%d-%d %s", int(r.StartLine), int(r.EndLine), r.Kind)
+	}
+	return result
+}
+
+func TestFoldingRanges(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t,
+		[]string{
+			"1-2 imports",
+			"6-22 ",
+			"10-21 ",
+			"12-20 ",
+			"13-15 ",
+			"17-18 ",
+			"4-5 comment",
+			"8-9 comment",
+		},
+		testFoldingRanges(foldingRanges(testFoldingCode)),
+	)
+}
+
+// testSelectionRanges returns the given selection range and its parents as strings
+// of the form `startLine:startCharacter-endLine:endCharacter`
+//
+func testSelectionRanges(selectionRange *protocol.SelectionRange) []string {
+	var result []string
+	for ; selectionRange != nil; selectionRange = selectionRange.Parent {
+		r := selectionRange.Range
+		result = append(result, fmt.Sprintf(
+			"%d:%d-%d:%d",
+			int(r.Start.Line),
+			int(r.Start.Character),
+			int(r.End.Line),
+			int(r.End.Character),
+		))
+	}
+	return result
+}
+
+func TestSelectionRanges(t *testing.T) {
+
+	t.Parallel()
+
+	server, err := NewServer()
+	require.NoError(t, err)
+
+	const uri = "file:///test.cdc"
+
+	server.documents[uri] = Document{Text: testFoldingCode}
+
+	ranges, err := server.SelectionRange(
+		testConn{},
+		&protocol.SelectionRangeParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+			Positions: []protocol.Position{
+				// `x` in the pre-condition
+				{Line: 14, Character: 16},
+				// the comment
+				{Line: 20, Character: 24},
+				// outside of any declaration
+				{Line: 0, Character: 0},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	var result [][]string
+	for _, r := range ranges {
+		result = append(result, testSelectionRanges(r))
+	}
+
+	assert.Equal(t,
+		[][]string{
+			{
+				"14:16-14:17",
+				"14:16-14:21",
+				"13:12-16:13",
+				"12:34-21:9",
+				"12:8-21:9",
+				"10:4-22:5",
+				"6:0-23:1",
+			},
+			{
+				"20:21-20:28",
+				"12:34-21:9",
+				"12:8-21:9",
+				"10:4-22:5",
+				"6:0-23:1",
+			},
+			{
+				"0:0-0:0",
+			},
+		},
+		result,
+	)
+}
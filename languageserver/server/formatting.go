@@ -0,0 +1,847 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/parser2/lexer"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// The formatter works on the token level:
+// it re-indents lines based on the nesting of brackets,
+// normalizes the whitespace between tokens, limits consecutive blank lines,
+// and breaks lines which are longer than the line width at the commas of a bracketed list.
+// Comments are preserved.
+//
+// The result is only used if it parses to the same program as the original code.
+//
+// NOTE: the formatter does not pretty-print the AST using the `Doc` functions of the AST nodes:
+// the Cadence version the language server depends on does not provide them,
+// and pretty-printing the AST would drop comments, which are not part of the AST.
+
+const lineWidthOption = "lineWidth"
+
+const defaultLineWidth = 100
+
+// maxDiffCells is the maximum size of the table computed to find the minimal edits
+// between the original and the formatted code. For larger inputs, a single edit is returned
+//
+const maxDiffCells = 4_000_000
+
+type formattingOptions struct {
+	// indentation is the string used for one level of indentation
+	indentation string
+	// tabSize is the width of a tab, used to determine the width of a line
+	tabSize int
+	// lineWidth is the maximum width of a line. Zero means no limit
+	lineWidth int
+}
+
+func newFormattingOptions(options protocol.FormattingOptions, lineWidth int) formattingOptions {
+	tabSize := int(options.TabSize)
+	if tabSize <= 0 {
+		tabSize = 4
+	}
+
+	indentation := "\t"
+	if options.InsertSpaces {
+		indentation = strings.Repeat(" ", tabSize)
+	}
+
+	return formattingOptions{
+		indentation: indentation,
+		tabSize:     tabSize,
+		lineWidth:   lineWidth,
+	}
+}
+
+// formattingPiece is a token, or a whole block comment, of a line
+//
+type formattingPiece struct {
+	tokenType lexer.TokenType
+	text      string
+	// column is the original column of the piece, used to re-indent multi-line block comments
+	column      int
+	spaceBefore bool
+}
+
+func (p formattingPiece) is(tokenType lexer.TokenType) bool {
+	return p.tokenType == tokenType
+}
+
+func (p formattingPiece) isOpening() bool {
+	switch p.tokenType {
+	case lexer.TokenParenOpen, lexer.TokenBracketOpen, lexer.TokenBraceOpen:
+		return true
+	}
+	return false
+}
+
+func (p formattingPiece) isClosing() bool {
+	switch p.tokenType {
+	case lexer.TokenParenClose, lexer.TokenBracketClose, lexer.TokenBraceClose:
+		return true
+	}
+	return false
+}
+
+func (p formattingPiece) isIdentifier(identifier string) bool {
+	return p.tokenType == lexer.TokenIdentifier && p.text == identifier
+}
+
+// formattingLine is a line of pieces. An empty line is a blank line
+//
+type formattingLine []formattingPiece
+
+// formatCode returns the formatted code.
+// It returns an error if the code is not syntactically valid,
+// or if the formatted code does not parse to the same program
+//
+func formatCode(code string, options formattingOptions) (string, error) {
+	program, err := parser2.ParseProgram(code)
+	if err != nil {
+		return "", err
+	}
+
+	lines, err := formattingLines(code)
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range lines {
+		normalizeSpacing(line)
+	}
+
+	formatted := renderFormattingLines(breakLongLines(lines, options), options)
+
+	if !sameProgram(program, formatted) {
+		// Breaking lines might have been invalid, e.g. in a whitespace-sensitive position.
+		// Retry without breaking lines
+
+		formatted = renderFormattingLines(lines, options)
+
+		if !sameProgram(program, formatted) {
+			return "", fmt.Errorf("formatting changes the program")
+		}
+	}
+
+	return formatted, nil
+}
+
+// formattingLines splits the given code into lines of pieces.
+// Consecutive blank lines are collapsed into one, leading blank lines are removed
+//
+func formattingLines(code string) (lines []formattingLine, err error) {
+	tokens := lexer.Lex(code)
+	defer tokens.Close()
+
+	var line formattingLine
+	space := false
+
+	endLine := func(newlines int) {
+		if len(lines) == 0 && len(line) == 0 {
+			return
+		}
+		lines = append(lines, line)
+		line = nil
+		if newlines > 1 && len(lines[len(lines)-1]) > 0 {
+			lines = append(lines, nil)
+		}
+	}
+
+	commentDepth := 0
+	var commentStart lexer.Token
+
+	for {
+		token := tokens.Next()
+
+		var piece formattingPiece
+
+		switch token.Type {
+		case lexer.TokenEOF:
+			if len(line) > 0 {
+				lines = append(lines, line)
+			}
+			for len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+				lines = lines[:len(lines)-1]
+			}
+			return lines, nil
+
+		case lexer.TokenError:
+			if tokenErr, ok := token.Value.(error); ok {
+				return nil, tokenErr
+			}
+			return nil, fmt.Errorf("invalid token")
+
+		case lexer.TokenSpace:
+			newlines := strings.Count(token.Value.(lexer.Space).String, "\n")
+			if newlines > 0 {
+				endLine(newlines)
+			} else {
+				space = true
+			}
+			continue
+
+		case lexer.TokenBlockCommentStart:
+			if commentDepth == 0 {
+				commentStart = token
+			}
+			commentDepth++
+			continue
+
+		case lexer.TokenBlockCommentContent:
+			continue
+
+		case lexer.TokenBlockCommentEnd:
+			commentDepth--
+			if commentDepth > 0 {
+				continue
+			}
+
+			piece = formattingPiece{
+				tokenType: lexer.TokenBlockCommentStart,
+				text:      code[commentStart.StartPos.Offset : token.EndPos.Offset+1],
+				column:    commentStart.StartPos.Column,
+			}
+
+		default:
+			piece = formattingPiece{
+				tokenType: token.Type,
+				text:      code[token.StartPos.Offset : token.EndPos.Offset+1],
+				column:    token.StartPos.Column,
+			}
+		}
+
+		piece.spaceBefore = space && len(line) > 0
+		space = false
+
+		line = append(line, piece)
+	}
+}
+
+// normalizeSpacing normalizes the spaces between the pieces of the given line:
+// there is no space after opening parentheses and brackets,
+// and no space before closing parentheses and brackets and commas;
+// there is a space after commas and colons, and around assignment and comparison operators.
+// The spacing before comments is kept
+//
+func normalizeSpacing(line formattingLine) {
+	for i := range line {
+		piece := &line[i]
+
+		if i == 0 {
+			piece.spaceBefore = false
+			continue
+		}
+
+		previous := line[i-1]
+
+		switch piece.tokenType {
+		case lexer.TokenLineComment,
+			lexer.TokenBlockCommentStart:
+
+			// Keep the spacing before comments
+			continue
+
+		case lexer.TokenComma,
+			lexer.TokenParenClose,
+			lexer.TokenBracketClose:
+
+			piece.spaceBefore = false
+			continue
+
+		case lexer.TokenEqual,
+			lexer.TokenLeftArrow,
+			lexer.TokenLeftArrowExclamation,
+			lexer.TokenSwap,
+			lexer.TokenEqualEqual,
+			lexer.TokenNotEqual,
+			lexer.TokenLessEqual,
+			lexer.TokenGreaterEqual,
+			lexer.TokenAmpersandAmpersand,
+			lexer.TokenVerticalBarVerticalBar,
+			lexer.TokenDoubleQuestionMark:
+
+			piece.spaceBefore = true
+			continue
+		}
+
+		switch previous.tokenType {
+		case lexer.TokenParenOpen,
+			lexer.TokenBracketOpen:
+
+			piece.spaceBefore = false
+
+		case lexer.TokenComma,
+			lexer.TokenColon,
+			lexer.TokenEqual,
+			lexer.TokenLeftArrow,
+			lexer.TokenLeftArrowExclamation,
+			lexer.TokenSwap,
+			lexer.TokenEqualEqual,
+			lexer.TokenNotEqual,
+			lexer.TokenLessEqual,
+			lexer.TokenGreaterEqual,
+			lexer.TokenAmpersandAmpersand,
+			lexer.TokenVerticalBarVerticalBar,
+			lexer.TokenDoubleQuestionMark:
+
+			piece.spaceBefore = true
+		}
+	}
+}
+
+type indentationEntry struct {
+	// level is the indentation level of the lines enclosed by the opening piece
+	level int
+	// isSwitch is true if the opening piece is the brace of a switch statement
+	isSwitch bool
+}
+
+// indentationLevels returns the indentation level of each of the given lines.
+//
+// Each opening bracket indents the following lines by one level relative to the line it occurs in,
+// even if a line contains multiple opening brackets.
+// A line starting with closing brackets is indented like the line of the corresponding opening bracket.
+//
+// Additionally, the cases of switch statements are indented like the switch statement,
+// and lines continuing an expression of the previous line are indented by one level
+//
+func indentationLevels(lines []formattingLine) []int {
+	levels := make([]int, len(lines))
+
+	var stack []indentationEntry
+	sawSwitch := false
+	var previousLine formattingLine
+
+	for lineIndex, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		level := 0
+		if len(stack) > 0 {
+			top := stack[len(stack)-1]
+			level = top.level
+
+			first := line[0]
+			if top.isSwitch &&
+				(first.isIdentifier("case") || first.isIdentifier("default")) {
+
+				level--
+			}
+		}
+
+		pieceIndex := 0
+		for ; pieceIndex < len(line) && line[pieceIndex].isClosing(); pieceIndex++ {
+			if len(stack) == 0 {
+				continue
+			}
+			level = stack[len(stack)-1].level - 1
+			stack = stack[:len(stack)-1]
+		}
+
+		if pieceIndex == 0 && isContinuationLine(previousLine, line) {
+			level++
+		}
+
+		levels[lineIndex] = level
+
+		for _, piece := range line[pieceIndex:] {
+			switch {
+			case piece.isIdentifier("switch"):
+				sawSwitch = true
+
+			case piece.isOpening():
+				isSwitch := sawSwitch && piece.is(lexer.TokenBraceOpen)
+				if isSwitch {
+					sawSwitch = false
+				}
+				stack = append(stack, indentationEntry{
+					level:    level + 1,
+					isSwitch: isSwitch,
+				})
+
+			case piece.isClosing():
+				if len(stack) > 0 {
+					stack = stack[:len(stack)-1]
+				}
+			}
+		}
+
+		previousLine = line
+	}
+
+	return levels
+}
+
+// isContinuationLine returns true if the given line continues the expression of the previous line
+//
+func isContinuationLine(previousLine, line formattingLine) bool {
+	switch line[0].tokenType {
+	case lexer.TokenDot,
+		lexer.TokenQuestionMarkDot,
+		lexer.TokenAmpersandAmpersand,
+		lexer.TokenVerticalBarVerticalBar,
+		lexer.TokenDoubleQuestionMark:
+
+		return true
+	}
+
+	if len(previousLine) == 0 {
+		return false
+	}
+
+	switch previousLine[len(previousLine)-1].tokenType {
+	case lexer.TokenEqual,
+		lexer.TokenLeftArrow,
+		lexer.TokenLeftArrowExclamation,
+		lexer.TokenAmpersandAmpersand,
+		lexer.TokenVerticalBarVerticalBar,
+		lexer.TokenDoubleQuestionMark:
+
+		return true
+	}
+
+	return false
+}
+
+// breakLongLines breaks the lines which are longer than the line width.
+// A line is broken at the outermost bracketed list:
+// after the opening bracket, after each comma of the list, and before the closing bracket
+//
+func breakLongLines(lines []formattingLine, options formattingOptions) []formattingLine {
+	if options.lineWidth <= 0 {
+		return lines
+	}
+
+	result := make([]formattingLine, len(lines))
+	copy(result, lines)
+
+	for {
+		levels := indentationLevels(result)
+
+		broken := false
+		for lineIndex, line := range result {
+			if lineWidth(line, levels[lineIndex], options) <= options.lineWidth {
+				continue
+			}
+
+			brokenLines := breakLine(line)
+			if brokenLines == nil {
+				continue
+			}
+
+			result = append(
+				result[:lineIndex],
+				append(brokenLines, result[lineIndex+1:]...)...,
+			)
+			broken = true
+			break
+		}
+
+		if !broken {
+			return result
+		}
+	}
+}
+
+// lineWidth returns the width of the given line, when indented by the given level.
+// Lines containing multi-line block comments are never considered too long
+//
+func lineWidth(line formattingLine, level int, options formattingOptions) int {
+	width := 0
+	if options.indentation == "\t" {
+		width = level * options.tabSize
+	} else {
+		width = level * len(options.indentation)
+	}
+
+	for _, piece := range line {
+		if strings.ContainsRune(piece.text, '\n') {
+			return 0
+		}
+		if piece.spaceBefore {
+			width++
+		}
+		width += utf8.RuneCountInString(piece.text)
+	}
+	return width
+}
+
+// breakLine breaks the given line at the outermost bracketed list containing a comma.
+// It returns nil if the line contains no such list
+//
+func breakLine(line formattingLine) []formattingLine {
+	opening, closing := -1, -1
+	openingDepth := 0
+
+	var openings []int
+	for index, piece := range line {
+		switch {
+		case piece.isOpening():
+			openings = append(openings, index)
+
+		case piece.isClosing():
+			if len(openings) == 0 {
+				continue
+			}
+			start := openings[len(openings)-1]
+			openings = openings[:len(openings)-1]
+
+			depth := len(openings)
+			if (opening < 0 || depth <= openingDepth) && hasTopLevelComma(line[start+1:index]) {
+				opening, closing = start, index
+				openingDepth = depth
+			}
+		}
+	}
+
+	if opening < 0 {
+		return nil
+	}
+
+	var lines []formattingLine
+
+	newLine := func(pieces []formattingPiece) {
+		line := make(formattingLine, len(pieces))
+		copy(line, pieces)
+		line[0].spaceBefore = false
+		lines = append(lines, line)
+	}
+
+	newLine(line[:opening+1])
+
+	depth := 0
+	elementStart := opening + 1
+	for index := opening + 1; index < closing; index++ {
+		piece := line[index]
+		switch {
+		case piece.isOpening():
+			depth++
+		case piece.isClosing():
+			depth--
+		case piece.is(lexer.TokenComma) && depth == 0:
+			newLine(line[elementStart : index+1])
+			elementStart = index + 1
+		}
+	}
+	newLine(line[elementStart:closing])
+
+	newLine(line[closing:])
+
+	return lines
+}
+
+func hasTopLevelComma(pieces []formattingPiece) bool {
+	depth := 0
+	for _, piece := range pieces {
+		switch {
+		case piece.isOpening():
+			depth++
+		case piece.isClosing():
+			depth--
+		case piece.is(lexer.TokenComma) && depth == 0:
+			return true
+		}
+	}
+	return false
+}
+
+func renderFormattingLines(lines []formattingLine, options formattingOptions) string {
+	levels := indentationLevels(lines)
+
+	var builder strings.Builder
+
+	for lineIndex, line := range lines {
+		if len(line) > 0 {
+			indentation := strings.Repeat(options.indentation, levels[lineIndex])
+			builder.WriteString(indentation)
+
+			for pieceIndex, piece := range line {
+				if piece.spaceBefore {
+					builder.WriteByte(' ')
+				}
+
+				text := piece.text
+				if pieceIndex == 0 && piece.is(lexer.TokenBlockCommentStart) {
+					text = reindentBlockComment(piece, indentation)
+				}
+				builder.WriteString(text)
+			}
+		}
+
+		builder.WriteByte('\n')
+	}
+
+	return builder.String()
+}
+
+// reindentBlockComment re-indents the continuation lines of the given block comment,
+// which starts a line, if they are indented at least as much as the comment originally started
+//
+func reindentBlockComment(piece formattingPiece, indentation string) string {
+	lines := strings.Split(piece.text, "\n")
+	for i := 1; i < len(lines); i++ {
+		line := lines[i]
+		if len(line) < piece.column ||
+			strings.TrimLeft(line[:piece.column], " \t") != "" {
+
+			continue
+		}
+		lines[i] = indentation + line[piece.column:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sameProgram returns true if the given code parses to the given program,
+// ignoring positions and the indentation of doc strings
+//
+func sameProgram(program interface{}, code string) bool {
+	otherProgram, err := parser2.ParseProgram(code)
+	if err != nil {
+		return false
+	}
+
+	normalized, err := normalizedProgram(program)
+	if err != nil {
+		return false
+	}
+
+	otherNormalized, err := normalizedProgram(otherProgram)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalized, otherNormalized)
+}
+
+func normalizedProgram(program interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(program)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	err = json.Unmarshal(encoded, &decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutPositions(decoded), nil
+}
+
+// withoutPositions removes positions and ranges from the given JSON value,
+// and normalizes the indentation of doc strings
+//
+func withoutPositions(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if isPosition(value) {
+			return nil
+		}
+		for key, element := range value {
+			if docString, ok := element.(string); ok && key == "DocString" {
+				value[key] = withoutIndentation(docString)
+				continue
+			}
+			value[key] = withoutPositions(element)
+		}
+		return value
+
+	case []interface{}:
+		for i, element := range value {
+			value[i] = withoutPositions(element)
+		}
+		return value
+
+	default:
+		return value
+	}
+}
+
+// withoutIndentation removes the leading and trailing whitespace of each line of the given doc string,
+// as the continuation lines of block comments are re-indented
+//
+func withoutIndentation(docString string) string {
+	lines := strings.Split(docString, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isPosition(value map[string]interface{}) bool {
+	if len(value) != 3 {
+		return false
+	}
+	for _, key := range []string{"Offset", "Line", "Column"} {
+		if _, ok := value[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// formattingEdits returns the minimal line-based edits
+// which transform the given original code into the given formatted code
+//
+func formattingEdits(original, formatted string) []*protocol.TextEdit {
+	originalLines := splitLines(original)
+	formattedLines := splitLines(formatted)
+
+	// Skip the common prefix and suffix
+
+	prefix := 0
+	for prefix < len(originalLines) &&
+		prefix < len(formattedLines) &&
+		originalLines[prefix] == formattedLines[prefix] {
+
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(originalLines)-prefix &&
+		suffix < len(formattedLines)-prefix &&
+		originalLines[len(originalLines)-1-suffix] == formattedLines[len(formattedLines)-1-suffix] {
+
+		suffix++
+	}
+
+	originalMiddle := originalLines[prefix : len(originalLines)-suffix]
+	formattedMiddle := formattedLines[prefix : len(formattedLines)-suffix]
+
+	if len(originalMiddle) == 0 && len(formattedMiddle) == 0 {
+		return []*protocol.TextEdit{}
+	}
+
+	newEdit := func(originalStart, originalEnd, formattedStart, formattedEnd int) *protocol.TextEdit {
+		return &protocol.TextEdit{
+			Range: protocol.Range{
+				Start: lineStartPosition(originalLines, prefix+originalStart),
+				End:   lineStartPosition(originalLines, prefix+originalEnd),
+			},
+			NewText: strings.Join(formattedMiddle[formattedStart:formattedEnd], ""),
+		}
+	}
+
+	if len(originalMiddle)*len(formattedMiddle) > maxDiffCells {
+		return []*protocol.TextEdit{
+			newEdit(0, len(originalMiddle), 0, len(formattedMiddle)),
+		}
+	}
+
+	// Compute the longest common subsequence of lines,
+	// and replace the lines between the common lines
+
+	n, m := len(originalMiddle), len(formattedMiddle)
+	lengths := make([][]int, n+1)
+	for i := range lengths {
+		lengths[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if originalMiddle[i] == formattedMiddle[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	edits := []*protocol.TextEdit{}
+
+	i, j := 0, 0
+	editStartI, editStartJ := 0, 0
+	for i < n || j < m {
+		if i < n && j < m && originalMiddle[i] == formattedMiddle[j] {
+			if editStartI < i || editStartJ < j {
+				edits = append(edits, newEdit(editStartI, i, editStartJ, j))
+			}
+			i++
+			j++
+			editStartI, editStartJ = i, j
+		} else if j >= m || (i < n && lengths[i+1][j] >= lengths[i][j+1]) {
+			i++
+		} else {
+			j++
+		}
+	}
+	if editStartI < n || editStartJ < m {
+		edits = append(edits, newEdit(editStartI, n, editStartJ, m))
+	}
+
+	return edits
+}
+
+// splitLines splits the given text into lines, including their line terminators
+//
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// lineStartPosition returns the position of the start of the line with the given index.
+// If the index is the number of lines, the position is the end of the text
+//
+func lineStartPosition(lines []string, index int) protocol.Position {
+	if index == len(lines) && index > 0 {
+		lastLine := lines[index-1]
+		if !strings.HasSuffix(lastLine, "\n") {
+			return protocol.Position{
+				Line:      float64(index - 1),
+				Character: float64(len(utf16.Encode([]rune(lastLine)))),
+			}
+		}
+	}
+
+	return protocol.Position{
+		Line:      float64(index),
+		Character: 0,
+	}
+}
+
+// editsInRange returns the edits which overlap the lines of the given range
+//
+func editsInRange(edits []*protocol.TextEdit, requestedRange protocol.Range) []*protocol.TextEdit {
+	result := []*protocol.TextEdit{}
+	for _, edit := range edits {
+		if edit.Range.End.Line < requestedRange.Start.Line ||
+			edit.Range.Start.Line > requestedRange.End.Line {
+
+			continue
+		}
+		result = append(result, edit)
+	}
+	return result
+}
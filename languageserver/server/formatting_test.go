@@ -0,0 +1,352 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/parser2"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+func testFormattingOptions(lineWidth int) formattingOptions {
+	return newFormattingOptions(
+		protocol.FormattingOptions{
+			TabSize:      4,
+			InsertSpaces: true,
+		},
+		lineWidth,
+	)
+}
+
+func TestFormatCode(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("indentation and spacing", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+
+
+pub   fun test( a:Int ,b: Int ):Int{
+      let x=a+b
+  if x==1 {
+return x
+        }
+
+
+
+  return [ 1,2 ][0]
+}
+`
+
+		formatted, err := formatCode(code, testFormattingOptions(defaultLineWidth))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`pub fun test(a: Int, b: Int): Int{
+    let x = a+b
+    if x == 1 {
+        return x
+    }
+
+    return [1, 2][0]
+}
+`,
+			formatted,
+		)
+	})
+
+	t.Run("switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+fun test(x: Int): Int {
+switch x {
+case 1:
+return 1
+default:
+return 2
+}
+}
+`
+
+		formatted, err := formatCode(code, testFormattingOptions(defaultLineWidth))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`fun test(x: Int): Int {
+    switch x {
+    case 1:
+        return 1
+    default:
+        return 2
+    }
+}
+`,
+			formatted,
+		)
+	})
+
+	t.Run("comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+// test is a test
+  fun test() {
+        /* a
+           b */
+    let x = 1 // one
+          /// doc
+  let y = 2
+}
+`
+
+		formatted, err := formatCode(code, testFormattingOptions(defaultLineWidth))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`// test is a test
+fun test() {
+    /* a
+       b */
+    let x = 1 // one
+    /// doc
+    let y = 2
+}
+`,
+			formatted,
+		)
+	})
+
+	t.Run("doc comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+  /** test is
+        a test */
+    fun test() {
+        ///   doc
+  let x = 1
+}
+`
+
+		formatted, err := formatCode(code, testFormattingOptions(defaultLineWidth))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`/** test is
+      a test */
+fun test() {
+    ///   doc
+    let x = 1
+}
+`,
+			formatted,
+		)
+	})
+
+	t.Run("continuation", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+fun test(): Bool {
+let x =
+1
+return true
+&& false
+}
+`
+
+		formatted, err := formatCode(code, testFormattingOptions(defaultLineWidth))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`fun test(): Bool {
+    let x =
+        1
+    return true
+        && false
+}
+`,
+			formatted,
+		)
+	})
+
+	t.Run("line width", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+fun test() {
+    let result = someFunction(firstArgument, [secondArgument, thirdArgument], fourth)
+}
+`
+
+		formatted, err := formatCode(code, testFormattingOptions(40))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`fun test() {
+    let result = someFunction(
+        firstArgument,
+        [secondArgument, thirdArgument],
+        fourth
+    )
+}
+`,
+			formatted,
+		)
+	})
+
+	t.Run("tabs", func(t *testing.T) {
+
+		t.Parallel()
+
+		formatted, err := formatCode(
+			"fun test() {\n  let x = 1\n}\n",
+			newFormattingOptions(protocol.FormattingOptions{TabSize: 4}, defaultLineWidth),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, "fun test() {\n\tlet x = 1\n}\n", formatted)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := formatCode("fun test( {", testFormattingOptions(defaultLineWidth))
+		require.Error(t, err)
+	})
+}
+
+func TestSameProgram(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+/// test is a test
+fun test() {}
+`
+
+	program, err := parser2.ParseProgram(code)
+	require.NoError(t, err)
+
+	assert.True(t, sameProgram(program, "///   test is a test\nfun test() {}\n"))
+	assert.False(t, sameProgram(program, "/// test is another test\nfun test() {}\n"))
+	assert.False(t, sameProgram(program, "fun test() {}\n"))
+}
+
+func TestFormattingEdits(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("no changes", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = "let x = 1\nlet y = 2\n"
+
+		edits := formattingEdits(code, code)
+		require.NotNil(t, edits)
+		assert.Empty(t, edits)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+
+		t.Parallel()
+
+		edits := formattingEdits(
+			"a\n b\nc\nd\n  e\n",
+			"a\nb\nc\nd\ne\n",
+		)
+
+		assert.Equal(t,
+			[]*protocol.TextEdit{
+				{
+					Range: protocol.Range{
+						Start: protocol.Position{Line: 1},
+						End:   protocol.Position{Line: 2},
+					},
+					NewText: "b\n",
+				},
+				{
+					Range: protocol.Range{
+						Start: protocol.Position{Line: 4},
+						End:   protocol.Position{Line: 5},
+					},
+					NewText: "e\n",
+				},
+			},
+			edits,
+		)
+
+		edits = editsInRange(
+			edits,
+			protocol.Range{
+				Start: protocol.Position{Line: 3},
+				End:   protocol.Position{Line: 4, Character: 2},
+			},
+		)
+
+		assert.Equal(t,
+			[]*protocol.TextEdit{
+				{
+					Range: protocol.Range{
+						Start: protocol.Position{Line: 4},
+						End:   protocol.Position{Line: 5},
+					},
+					NewText: "e\n",
+				},
+			},
+			edits,
+		)
+	})
+
+	t.Run("missing trailing newline", func(t *testing.T) {
+
+		t.Parallel()
+
+		edits := formattingEdits("a\n  b", "a\nb\n")
+
+		assert.Equal(t,
+			[]*protocol.TextEdit{
+				{
+					Range: protocol.Range{
+						Start: protocol.Position{Line: 1},
+						End:   protocol.Position{Line: 1, Character: 3},
+					},
+					NewText: "b\n",
+				},
+			},
+			edits,
+		)
+	})
+}
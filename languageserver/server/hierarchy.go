@@ -0,0 +1,623 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// transactionQualifier is the qualifier of the special functions of transactions,
+// e.g. `transaction.prepare`. It cannot clash with a type name, as it is a keyword
+//
+const transactionQualifier = "transaction"
+
+// hierarchyDeclaration is a declaration which can be an item of a call hierarchy or a type hierarchy:
+// a function, a special function, a composite, or an interface
+//
+type hierarchyDeclaration struct {
+	// qualifiedIdentifier is the qualified identifier of the declaration,
+	// in the form used by symbols, e.g. `FungibleToken.Vault.deposit`
+	qualifiedIdentifier string
+	containerName       string
+	declaration         ast.Declaration
+}
+
+func (d hierarchyDeclaration) isCallable() bool {
+	switch d.declaration.(type) {
+	case *ast.FunctionDeclaration, *ast.SpecialFunctionDeclaration:
+		return true
+	}
+	return false
+}
+
+func (d hierarchyDeclaration) isType() bool {
+	switch d.declaration.(type) {
+	case *ast.CompositeDeclaration, *ast.InterfaceDeclaration:
+		return true
+	}
+	return false
+}
+
+func (d hierarchyDeclaration) kind() protocol.SymbolKind {
+	if _, ok := d.declaration.(*ast.FunctionDeclaration); ok && d.containerName != "" {
+		return protocol.Method
+	}
+	return conversion.DeclarationKindToSymbolKind(d.declaration.DeclarationKind())
+}
+
+func (d hierarchyDeclaration) ranges() (r protocol.Range, selectionRange protocol.Range) {
+	identifier := d.declaration.DeclarationIdentifier()
+
+	r = conversion.ASTToProtocolRange(
+		d.declaration.StartPosition(),
+		d.declaration.EndPosition(),
+	)
+
+	selectionRange = conversion.ASTToProtocolRange(
+		identifier.StartPosition(),
+		identifier.EndPosition(),
+	)
+
+	return
+}
+
+func (d hierarchyDeclaration) callHierarchyItem(uri protocol.DocumentUri) *protocol.CallHierarchyItem {
+	r, selectionRange := d.ranges()
+
+	return &protocol.CallHierarchyItem{
+		Name:           d.declaration.DeclarationIdentifier().Identifier,
+		Kind:           d.kind(),
+		Detail:         d.containerName,
+		URI:            uri,
+		Range:          r,
+		SelectionRange: selectionRange,
+		Data:           d.qualifiedIdentifier,
+	}
+}
+
+func (d hierarchyDeclaration) typeHierarchyItem(uri protocol.DocumentUri) *protocol.TypeHierarchyItem {
+	r, selectionRange := d.ranges()
+
+	return &protocol.TypeHierarchyItem{
+		Name:           d.declaration.DeclarationIdentifier().Identifier,
+		Kind:           d.kind(),
+		Detail:         d.containerName,
+		URI:            uri,
+		Range:          r,
+		SelectionRange: selectionRange,
+		Data:           d.qualifiedIdentifier,
+	}
+}
+
+// hierarchyDeclarations returns the declarations of the given program
+// which can be items of a call hierarchy or type hierarchy
+//
+func hierarchyDeclarations(program *ast.Program) []hierarchyDeclaration {
+	var declarations []hierarchyDeclaration
+
+	var addDeclaration func(declaration ast.Declaration, containerName string)
+	addDeclaration = func(declaration ast.Declaration, containerName string) {
+		switch declaration.(type) {
+		case *ast.CompositeDeclaration,
+			*ast.InterfaceDeclaration,
+			*ast.FunctionDeclaration,
+			*ast.SpecialFunctionDeclaration:
+		default:
+			return
+		}
+
+		identifier := declaration.DeclarationIdentifier()
+		if identifier == nil || identifier.Identifier == "" {
+			return
+		}
+
+		qualifiedIdentifier := identifier.Identifier
+		if containerName != "" {
+			qualifiedIdentifier = containerName + "." + qualifiedIdentifier
+		}
+
+		declarations = append(declarations, hierarchyDeclaration{
+			qualifiedIdentifier: qualifiedIdentifier,
+			containerName:       containerName,
+			declaration:         declaration,
+		})
+
+		members := declaration.DeclarationMembers()
+		if members == nil {
+			return
+		}
+
+		for _, member := range members.Declarations() {
+			addDeclaration(member, qualifiedIdentifier)
+		}
+	}
+
+	for _, declaration := range program.Declarations() {
+		if transaction, ok := declaration.(*ast.TransactionDeclaration); ok {
+			if transaction.Prepare != nil {
+				addDeclaration(transaction.Prepare, transactionQualifier)
+			}
+			if transaction.Execute != nil {
+				addDeclaration(transaction.Execute, transactionQualifier)
+			}
+			continue
+		}
+
+		addDeclaration(declaration, "")
+	}
+
+	return declarations
+}
+
+// hierarchyDeclaration returns the declaration of the given symbol in the workspace
+//
+func (index *workspaceIndex) hierarchyDeclaration(s symbol) (*workspaceFile, *hierarchyDeclaration) {
+	for _, file := range index.files {
+		if file.location != s.location || file.program == nil {
+			continue
+		}
+
+		for _, declaration := range hierarchyDeclarations(file.program) {
+			if declaration.qualifiedIdentifier == s.qualifiedIdentifier {
+				return file, &declaration
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// itemSymbol returns the symbol of the call hierarchy or type hierarchy item with the given URI and data
+//
+func (index *workspaceIndex) itemSymbol(uri protocol.DocumentUri, data interface{}) (symbol, bool) {
+	file := index.file(uri)
+	if file == nil {
+		return symbol{}, false
+	}
+
+	qualifiedIdentifier, ok := data.(string)
+	if !ok {
+		return symbol{}, false
+	}
+
+	return symbol{
+		location:            file.location,
+		qualifiedIdentifier: qualifiedIdentifier,
+	}, true
+}
+
+// hierarchyDeclarationAt returns the declaration at the given position of the given document,
+// either at its declaration or at a reference to it
+//
+func (s *Server) hierarchyDeclarationAt(
+	index *workspaceIndex,
+	uri protocol.DocumentUri,
+	position protocol.Position,
+) (*workspaceFile, *hierarchyDeclaration) {
+
+	currentFile := index.file(uri)
+	if currentFile == nil || currentFile.program == nil {
+		return nil, nil
+	}
+
+	semaPosition := conversion.ProtocolToSemaPosition(position)
+
+	// Special functions are not symbols, so check the declarations of the document first
+
+	for _, declaration := range hierarchyDeclarations(currentFile.program) {
+		identifier := declaration.declaration.DeclarationIdentifier()
+		if identifier.Pos.Line == semaPosition.Line &&
+			identifier.StartPosition().Column <= semaPosition.Column &&
+			semaPosition.Column <= identifier.EndPosition().Column+1 {
+
+			return currentFile, &declaration
+		}
+	}
+
+	referencedSymbol, ok := symbolAt(
+		index.fileReferences(currentFile, s.fileChecker(currentFile)),
+		semaPosition,
+	)
+	if !ok {
+		return nil, nil
+	}
+
+	return index.hierarchyDeclaration(referencedSymbol)
+}
+
+// call is an invocation of a symbol
+//
+type call struct {
+	// caller is the function in which the invocation occurs,
+	// or nil if the invocation is not in a function, e.g. in a field initializer
+	caller *hierarchyDeclaration
+	callee symbol
+	// Range is the range of the invoked identifier
+	ast.Range
+}
+
+// fileCalls returns the invocations of symbols in the given file
+//
+func (index *workspaceIndex) fileCalls(file *workspaceFile, checker *sema.Checker) []call {
+	if file.program == nil || checker == nil {
+		return nil
+	}
+
+	symbols := map[ast.Position]symbol{}
+	for _, reference := range index.fileReferences(file, checker) {
+		if !reference.isDeclaration {
+			symbols[reference.StartPos] = reference.symbol
+		}
+	}
+
+	var callers []hierarchyDeclaration
+	for _, declaration := range hierarchyDeclarations(file.program) {
+		if declaration.isCallable() {
+			callers = append(callers, declaration)
+		}
+	}
+
+	// innermostCaller returns the innermost function which contains the given position
+	innermostCaller := func(position ast.Position) *hierarchyDeclaration {
+		var result *hierarchyDeclaration
+		for i, caller := range callers {
+			if !positionInRange(position, caller.declaration) {
+				continue
+			}
+			if result == nil ||
+				positionInRange(caller.declaration.StartPosition(), result.declaration) {
+
+				result = &callers[i]
+			}
+		}
+		return result
+	}
+
+	var calls []call
+
+	for _, declaration := range file.program.Declarations() {
+		walkElements(declaration, func(element ast.Element) bool {
+			invocation, ok := element.(*ast.InvocationExpression)
+			if !ok {
+				return true
+			}
+
+			var identifier ast.Identifier
+
+			switch invokedExpression := invocation.InvokedExpression.(type) {
+			case *ast.IdentifierExpression:
+				identifier = invokedExpression.Identifier
+			case *ast.MemberExpression:
+				identifier = invokedExpression.Identifier
+			default:
+				return true
+			}
+
+			callee, ok := symbols[identifier.StartPosition()]
+			if !ok {
+				return true
+			}
+
+			calls = append(calls, call{
+				caller: innermostCaller(identifier.StartPosition()),
+				callee: callee,
+				Range: ast.Range{
+					StartPos: identifier.StartPosition(),
+					EndPos:   identifier.EndPosition(),
+				},
+			})
+
+			return true
+		})
+	}
+
+	return calls
+}
+
+// PrepareCallHierarchy returns the function at the given position
+//
+func (s *Server) PrepareCallHierarchy(
+	_ protocol.Conn,
+	params *protocol.CallHierarchyPrepareParams,
+) (
+	[]*protocol.CallHierarchyItem,
+	error,
+) {
+	index := s.newWorkspaceIndex()
+
+	file, declaration := s.hierarchyDeclarationAt(index, params.TextDocument.URI, params.Position)
+	if declaration == nil || !declaration.isCallable() {
+		return nil, nil
+	}
+
+	return []*protocol.CallHierarchyItem{
+		declaration.callHierarchyItem(file.uri),
+	}, nil
+}
+
+// CallHierarchyIncomingCalls returns the functions which call the given function,
+// in the workspace files which may refer to it
+//
+func (s *Server) CallHierarchyIncomingCalls(
+	_ protocol.Conn,
+	params *protocol.CallHierarchyIncomingCallsParams,
+) (
+	[]*protocol.CallHierarchyIncomingCall,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	incomingCalls := []*protocol.CallHierarchyIncomingCall{}
+
+	index := s.newWorkspaceIndex()
+
+	callee, ok := index.itemSymbol(params.Item.URI, params.Item.Data)
+	if !ok {
+		return incomingCalls, nil
+	}
+
+	for _, file := range index.files {
+		if file.location != callee.location &&
+			!index.imports(file, callee.location) {
+
+			continue
+		}
+
+		incomingCallsByCaller := map[string]*protocol.CallHierarchyIncomingCall{}
+
+		for _, call := range index.fileCalls(file, s.fileChecker(file)) {
+			if call.callee != callee || call.caller == nil {
+				continue
+			}
+
+			callRange := conversion.ASTToProtocolRange(call.StartPos, call.EndPos)
+
+			incomingCall, ok := incomingCallsByCaller[call.caller.qualifiedIdentifier]
+			if ok {
+				incomingCall.FromRanges = append(incomingCall.FromRanges, callRange)
+				continue
+			}
+
+			incomingCall = &protocol.CallHierarchyIncomingCall{
+				From:       call.caller.callHierarchyItem(file.uri),
+				FromRanges: []protocol.Range{callRange},
+			}
+			incomingCallsByCaller[call.caller.qualifiedIdentifier] = incomingCall
+			incomingCalls = append(incomingCalls, incomingCall)
+		}
+	}
+
+	return incomingCalls, nil
+}
+
+// CallHierarchyOutgoingCalls returns the functions declared in the workspace which are called by the given function
+//
+func (s *Server) CallHierarchyOutgoingCalls(
+	_ protocol.Conn,
+	params *protocol.CallHierarchyOutgoingCallsParams,
+) (
+	[]*protocol.CallHierarchyOutgoingCall,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	outgoingCalls := []*protocol.CallHierarchyOutgoingCall{}
+
+	index := s.newWorkspaceIndex()
+
+	caller, ok := index.itemSymbol(params.Item.URI, params.Item.Data)
+	if !ok {
+		return outgoingCalls, nil
+	}
+
+	file := index.file(params.Item.URI)
+
+	outgoingCallsByCallee := map[symbol]*protocol.CallHierarchyOutgoingCall{}
+
+	for _, call := range index.fileCalls(file, s.fileChecker(file)) {
+		if call.caller == nil || call.caller.qualifiedIdentifier != caller.qualifiedIdentifier {
+			continue
+		}
+
+		callRange := conversion.ASTToProtocolRange(call.StartPos, call.EndPos)
+
+		outgoingCall, ok := outgoingCallsByCallee[call.callee]
+		if ok {
+			outgoingCall.FromRanges = append(outgoingCall.FromRanges, callRange)
+			continue
+		}
+
+		calleeFile, callee := index.hierarchyDeclaration(call.callee)
+		if callee == nil || !callee.isCallable() {
+			continue
+		}
+
+		outgoingCall = &protocol.CallHierarchyOutgoingCall{
+			To:         callee.callHierarchyItem(calleeFile.uri),
+			FromRanges: []protocol.Range{callRange},
+		}
+		outgoingCallsByCallee[call.callee] = outgoingCall
+		outgoingCalls = append(outgoingCalls, outgoingCall)
+	}
+
+	return outgoingCalls, nil
+}
+
+// PrepareTypeHierarchy returns the composite or interface at the given position
+//
+func (s *Server) PrepareTypeHierarchy(
+	_ protocol.Conn,
+	params *protocol.TypeHierarchyPrepareParams,
+) (
+	[]*protocol.TypeHierarchyItem,
+	error,
+) {
+	index := s.newWorkspaceIndex()
+
+	file, declaration := s.hierarchyDeclarationAt(index, params.TextDocument.URI, params.Position)
+	if declaration == nil || !declaration.isType() {
+		return nil, nil
+	}
+
+	return []*protocol.TypeHierarchyItem{
+		declaration.typeHierarchyItem(file.uri),
+	}, nil
+}
+
+// conformanceSymbols returns the symbols of the interfaces
+// which the given composite type explicitly conforms to
+//
+func (index *workspaceIndex) conformanceSymbols(compositeType *sema.CompositeType) []symbol {
+	symbols := make([]symbol, 0, len(compositeType.ExplicitInterfaceConformances))
+
+	for _, conformance := range compositeType.ExplicitInterfaceConformances {
+		location := conformance.Location
+
+		// Address locations are canonicalized by the name of the declaring contract,
+		// i.e. the first part of the qualified identifier
+
+		if addressLocation, ok := location.(common.AddressLocation); ok && addressLocation.Name == "" {
+			addressLocation.Name = strings.SplitN(conformance.QualifiedIdentifier(), ".", 2)[0]
+			location = addressLocation
+		}
+
+		symbols = append(symbols, symbol{
+			location:            index.canonicalLocation(location),
+			qualifiedIdentifier: conformance.QualifiedIdentifier(),
+		})
+	}
+
+	return symbols
+}
+
+// TypeHierarchySupertypes returns the interfaces which the given composite conforms to,
+// if they are declared in the workspace
+//
+func (s *Server) TypeHierarchySupertypes(
+	_ protocol.Conn,
+	params *protocol.TypeHierarchySupertypesParams,
+) (
+	[]*protocol.TypeHierarchyItem,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	items := []*protocol.TypeHierarchyItem{}
+
+	index := s.newWorkspaceIndex()
+
+	subtype, ok := index.itemSymbol(params.Item.URI, params.Item.Data)
+	if !ok {
+		return items, nil
+	}
+
+	file, declaration := index.hierarchyDeclaration(subtype)
+	if declaration == nil {
+		return items, nil
+	}
+
+	compositeDeclaration, ok := declaration.declaration.(*ast.CompositeDeclaration)
+	if !ok {
+		return items, nil
+	}
+
+	checker := s.fileChecker(file)
+	if checker == nil {
+		return items, nil
+	}
+
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[compositeDeclaration]
+	if compositeType == nil {
+		return items, nil
+	}
+
+	for _, supertype := range index.conformanceSymbols(compositeType) {
+		supertypeFile, supertypeDeclaration := index.hierarchyDeclaration(supertype)
+		if supertypeDeclaration == nil {
+			continue
+		}
+
+		items = append(items, supertypeDeclaration.typeHierarchyItem(supertypeFile.uri))
+	}
+
+	return items, nil
+}
+
+// TypeHierarchySubtypes returns the composites which conform to the given interface,
+// in the workspace files which may refer to it
+//
+func (s *Server) TypeHierarchySubtypes(
+	_ protocol.Conn,
+	params *protocol.TypeHierarchySubtypesParams,
+) (
+	[]*protocol.TypeHierarchyItem,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	items := []*protocol.TypeHierarchyItem{}
+
+	index := s.newWorkspaceIndex()
+
+	supertype, ok := index.itemSymbol(params.Item.URI, params.Item.Data)
+	if !ok {
+		return items, nil
+	}
+
+	for _, file := range index.files {
+		if file.program == nil ||
+			(file.location != supertype.location && !index.imports(file, supertype.location)) {
+
+			continue
+		}
+
+		checker := s.fileChecker(file)
+		if checker == nil {
+			continue
+		}
+
+		for _, declaration := range hierarchyDeclarations(file.program) {
+			compositeDeclaration, ok := declaration.declaration.(*ast.CompositeDeclaration)
+			if !ok {
+				continue
+			}
+
+			compositeType := checker.Elaboration.CompositeDeclarationTypes[compositeDeclaration]
+			if compositeType == nil {
+				continue
+			}
+
+			for _, conformance := range index.conformanceSymbols(compositeType) {
+				if conformance == supertype {
+					items = append(items, declaration.typeHierarchyItem(file.uri))
+					break
+				}
+			}
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,303 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const testReceiversContract = `
+pub contract Receivers {
+
+    pub resource interface Receiver {
+        pub fun deposit(amount: UFix64)
+    }
+}
+`
+
+const testBankContract = `
+import Receivers from "./Receivers.cdc"
+
+pub contract Bank {
+
+    pub resource Account: Receivers.Receiver {
+        pub fun deposit(amount: UFix64) {}
+    }
+
+    pub resource Safe {}
+}
+`
+
+// testCallHierarchyItem returns the given item as a string
+// of the form `detail.name path:line:character`, with the path relative to the given folder
+//
+func testCallHierarchyItem(dir string, item *protocol.CallHierarchyItem) string {
+	name := item.Name
+	if item.Detail != "" {
+		name = item.Detail + "." + name
+	}
+	return fmt.Sprintf("%s %s", name, testLocation(dir, item.URI, item.SelectionRange))
+}
+
+// testTypeHierarchyItems returns the given items as strings
+// of the form `detail.name path:line:character`, with the path relative to the given folder
+//
+func testTypeHierarchyItems(dir string, items []*protocol.TypeHierarchyItem) []string {
+	result := make([]string, len(items))
+	for i, item := range items {
+		name := item.Name
+		if item.Detail != "" {
+			name = item.Detail + "." + name
+		}
+		result[i] = fmt.Sprintf("%s %s", name, testLocation(dir, item.URI, item.SelectionRange))
+	}
+	return result
+}
+
+func TestCallHierarchy(t *testing.T) {
+
+	t.Parallel()
+
+	prepareCallHierarchy := func(
+		t *testing.T,
+		server *Server,
+		uri protocol.DocumentUri,
+		position protocol.Position,
+	) *protocol.CallHierarchyItem {
+		items, err := server.PrepareCallHierarchy(
+			testConn{},
+			&protocol.CallHierarchyPrepareParams{
+				TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+					TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+					Position:     position,
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		return items[0]
+	}
+
+	t.Run("incoming calls", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		// `createVault` in the declaration of the contract
+
+		item := prepareCallHierarchy(t, server,
+			pathToURI(filepath.Join(dir, "Foo.cdc")),
+			protocol.Position{Line: 15, Character: 14},
+		)
+		assert.Equal(t, "Foo.createVault Foo.cdc:15:12", testCallHierarchyItem(dir, item))
+
+		incomingCalls, err := server.CallHierarchyIncomingCalls(
+			testConn{},
+			&protocol.CallHierarchyIncomingCallsParams{Item: *item},
+		)
+		require.NoError(t, err)
+
+		var result []string
+		for _, incomingCall := range incomingCalls {
+			var fromLocations []*protocol.Location
+			for _, fromRange := range incomingCall.FromRanges {
+				fromLocations = append(fromLocations, &protocol.Location{
+					URI:   incomingCall.From.URI,
+					Range: fromRange,
+				})
+			}
+			result = append(result, fmt.Sprintf(
+				"%s %v",
+				testCallHierarchyItem(dir, incomingCall.From),
+				testLocations(dir, fromLocations),
+			))
+		}
+
+		assert.Equal(t,
+			[]string{
+				"main scripts/balance.cdc:3:8 [scripts/balance.cdc:4:21]",
+				"transaction.prepare transactions/deposit.cdc:4:4 [transactions/deposit.cdc:5:25]",
+			},
+			result,
+		)
+	})
+
+	t.Run("outgoing calls", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		// `prepare` in the transaction
+
+		item := prepareCallHierarchy(t, server,
+			pathToURI(filepath.Join(dir, "transactions/deposit.cdc")),
+			protocol.Position{Line: 4, Character: 6},
+		)
+		assert.Equal(t,
+			"transaction.prepare transactions/deposit.cdc:4:4",
+			testCallHierarchyItem(dir, item),
+		)
+
+		outgoingCalls, err := server.CallHierarchyOutgoingCalls(
+			testConn{},
+			&protocol.CallHierarchyOutgoingCallsParams{Item: *item},
+		)
+		require.NoError(t, err)
+
+		var result []string
+		for _, outgoingCall := range outgoingCalls {
+			result = append(result, fmt.Sprintf(
+				"%s %d",
+				testCallHierarchyItem(dir, outgoingCall.To),
+				len(outgoingCall.FromRanges),
+			))
+		}
+
+		assert.Equal(t,
+			[]string{
+				"Foo.createVault Foo.cdc:15:12 1",
+				"Foo.Vault.deposit Foo.cdc:10:16 1",
+			},
+			result,
+		)
+	})
+}
+
+func TestTypeHierarchy(t *testing.T) {
+
+	t.Parallel()
+
+	newServer := func(t *testing.T) (*Server, string) {
+		server, dir := newTestWorkspaceServer(t)
+
+		files := map[string]string{
+			"Receivers.cdc": testReceiversContract,
+			"Bank.cdc":      testBankContract,
+		}
+
+		for name, code := range files {
+			err := ioutil.WriteFile(filepath.Join(dir, name), []byte(code), 0600)
+			require.NoError(t, err)
+		}
+
+		return server, dir
+	}
+
+	prepareTypeHierarchy := func(
+		t *testing.T,
+		server *Server,
+		uri protocol.DocumentUri,
+		position protocol.Position,
+	) []*protocol.TypeHierarchyItem {
+		items, err := server.PrepareTypeHierarchy(
+			testConn{},
+			&protocol.TypeHierarchyPrepareParams{
+				TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+					TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+					Position:     position,
+				},
+			},
+		)
+		require.NoError(t, err)
+		return items
+	}
+
+	t.Run("supertypes", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newServer(t)
+		defer os.RemoveAll(dir)
+
+		// `Account` in the declaration of the resource
+
+		items := prepareTypeHierarchy(t, server,
+			pathToURI(filepath.Join(dir, "Bank.cdc")),
+			protocol.Position{Line: 5, Character: 18},
+		)
+		require.Equal(t, []string{"Bank.Account Bank.cdc:5:17"}, testTypeHierarchyItems(dir, items))
+
+		supertypes, err := server.TypeHierarchySupertypes(
+			testConn{},
+			&protocol.TypeHierarchySupertypesParams{Item: *items[0]},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{"Receivers.Receiver Receivers.cdc:3:27"},
+			testTypeHierarchyItems(dir, supertypes),
+		)
+	})
+
+	t.Run("subtypes", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newServer(t)
+		defer os.RemoveAll(dir)
+
+		// `Receiver` in the declaration of the interface
+
+		items := prepareTypeHierarchy(t, server,
+			pathToURI(filepath.Join(dir, "Receivers.cdc")),
+			protocol.Position{Line: 3, Character: 29},
+		)
+		require.Equal(t, []string{"Receivers.Receiver Receivers.cdc:3:27"}, testTypeHierarchyItems(dir, items))
+
+		subtypes, err := server.TypeHierarchySubtypes(
+			testConn{},
+			&protocol.TypeHierarchySubtypesParams{Item: *items[0]},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{"Bank.Account Bank.cdc:5:17"},
+			testTypeHierarchyItems(dir, subtypes),
+		)
+	})
+
+	t.Run("no type", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newServer(t)
+		defer os.RemoveAll(dir)
+
+		// `deposit` in the declaration of the function
+
+		items := prepareTypeHierarchy(t, server,
+			pathToURI(filepath.Join(dir, "Bank.cdc")),
+			protocol.Position{Line: 6, Character: 18},
+		)
+		assert.Empty(t, items)
+	})
+}
@@ -0,0 +1,278 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const importSearchRootsOption = "importSearchRoots"
+
+// importSearchPaths returns the folders in which path imports are searched
+// if they cannot be found relative to the importing file:
+// the configured search roots, followed by the workspace folders.
+//
+// Relative search roots are relative to each workspace folder
+//
+func (s *Server) importSearchPaths() []string {
+	var paths []string
+
+	for _, root := range s.importSearchRoots {
+		if filepath.IsAbs(root) {
+			paths = append(paths, root)
+			continue
+		}
+
+		for _, folder := range s.workspaceFolders {
+			paths = append(paths, filepath.Join(folder, root))
+		}
+	}
+
+	return append(paths, s.workspaceFolders...)
+}
+
+// pathExists returns true if the file with the given path is open or exists on disk
+//
+func (s *Server) pathExists(path string) bool {
+	if _, ok := s.documents[pathToURI(path)]; ok {
+		return true
+	}
+
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// resolvePathLocation resolves the given path location imported by the file with the given location.
+//
+// A relative path is resolved relative to the importing file.
+// If no such file exists, the path is resolved relative to the import search paths.
+// If no file exists in any of them, the path relative to the importing file is returned
+//
+func (s *Server) resolvePathLocation(base, relative common.Location) common.Location {
+	resolved := normalizePathLocation(base, relative)
+
+	relativePath := locationToPath(relative)
+	if relativePath == "" || filepath.IsAbs(relativePath) {
+		return resolved
+	}
+
+	if s.pathExists(locationToPath(resolved)) {
+		return resolved
+	}
+
+	for _, searchPath := range s.importSearchPaths() {
+		path := filepath.Join(searchPath, relativePath)
+		if s.pathExists(path) {
+			return common.StringLocation(path)
+		}
+	}
+
+	return resolved
+}
+
+// importCache caches the checkers of imported programs which are not open documents,
+// so they are not parsed and checked again every time an importing document changes
+//
+type importCache struct {
+	checkers map[common.LocationID]*sema.Checker
+	// importers are the locations importing each location
+	importers map[common.LocationID]map[common.LocationID]struct{}
+}
+
+func newImportCache() *importCache {
+	return &importCache{
+		checkers:  map[common.LocationID]*sema.Checker{},
+		importers: map[common.LocationID]map[common.LocationID]struct{}{},
+	}
+}
+
+func (c *importCache) get(location common.LocationID) (*sema.Checker, bool) {
+	checker, ok := c.checkers[location]
+	return checker, ok
+}
+
+func (c *importCache) add(location common.LocationID, checker *sema.Checker) {
+	c.checkers[location] = checker
+}
+
+// remove removes the checker of the given location,
+// but keeps the recorded imports, so the importers are still invalidated when the location changes
+//
+func (c *importCache) remove(location common.LocationID) {
+	delete(c.checkers, location)
+}
+
+// recordImport records that the given importer imports the given location,
+// so the importer is invalidated when the imported location is invalidated
+//
+func (c *importCache) recordImport(importer, imported common.LocationID) {
+	importers, ok := c.importers[imported]
+	if !ok {
+		importers = map[common.LocationID]struct{}{}
+		c.importers[imported] = importers
+	}
+	importers[importer] = struct{}{}
+}
+
+// invalidate removes the checkers of the given location, and of all locations which directly or indirectly import it.
+// It returns all these locations, including the locations which are not cached, e.g. open documents
+//
+func (c *importCache) invalidate(location common.LocationID) map[common.LocationID]struct{} {
+	invalidated := map[common.LocationID]struct{}{}
+
+	var invalidate func(location common.LocationID)
+	invalidate = func(location common.LocationID) {
+		if _, ok := invalidated[location]; ok {
+			return
+		}
+		invalidated[location] = struct{}{}
+
+		delete(c.checkers, location)
+
+		importers := c.importers[location]
+		delete(c.importers, location)
+
+		for importer := range importers {
+			invalidate(importer)
+		}
+	}
+
+	invalidate(location)
+
+	return invalidated
+}
+
+// invalidatePath invalidates the file with the given path,
+// or all files in the folder with the given path
+//
+func (c *importCache) invalidatePath(path string) map[common.LocationID]struct{} {
+	invalidated := c.invalidate(common.StringLocation(path).ID())
+
+	folderPrefix := string(common.StringLocation(path + "/").ID())
+
+	var locations []common.LocationID
+	for location := range c.checkers {
+		if strings.HasPrefix(string(location), folderPrefix) {
+			locations = append(locations, location)
+		}
+	}
+	for location := range c.importers {
+		if strings.HasPrefix(string(location), folderPrefix) {
+			locations = append(locations, location)
+		}
+	}
+
+	for _, location := range locations {
+		for invalidatedLocation := range c.invalidate(location) {
+			invalidated[invalidatedLocation] = struct{}{}
+		}
+	}
+
+	return invalidated
+}
+
+// importedChecker returns the checker for the given location imported by the given checker.
+//
+// Open documents are checked when they change, so their checkers are used.
+// The checkers of all other imported programs are cached
+//
+func (s *Server) importedChecker(checker *sema.Checker, location common.Location) (*sema.Checker, error) {
+	locationID := location.ID()
+
+	s.importCache.recordImport(checker.Location.ID(), locationID)
+
+	if importedChecker, ok := s.checkers[locationID]; ok {
+		return importedChecker, nil
+	}
+
+	if importedChecker, ok := s.importCache.get(locationID); ok {
+		return importedChecker, nil
+	}
+
+	importedProgram, err := s.resolveImport(location)
+	if err != nil {
+		return nil, err
+	}
+	if importedProgram == nil {
+		return nil, nil
+	}
+
+	importedChecker, err := checker.SubChecker(importedProgram, location)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: cache the checker before checking it,
+	// so cyclic imports do not lead to infinite recursion
+	s.importCache.add(locationID, importedChecker)
+
+	err = importedChecker.Check()
+	if err != nil {
+		// Do not keep the checker of an invalid program,
+		// so the error is reported again when the program is imported again
+		s.importCache.remove(locationID)
+		return nil, err
+	}
+
+	return importedChecker, nil
+}
+
+// invalidateImports invalidates the cached imports of the changed files,
+// and checks the open documents which directly or indirectly import them again
+//
+func (s *Server) invalidateImports(conn protocol.Conn, changes []protocol.FileEvent) {
+	invalidated := map[common.LocationID]struct{}{}
+
+	for _, change := range changes {
+		// Open documents are checked when they change, not when they are saved
+		if _, ok := s.documents[change.URI]; ok {
+			continue
+		}
+
+		path := string(uriToLocation(change.URI))
+
+		for location := range s.importCache.invalidatePath(path) {
+			invalidated[location] = struct{}{}
+		}
+	}
+
+	uris := make([]protocol.DocumentUri, 0, len(s.documents))
+	for uri := range s.documents {
+		if _, ok := invalidated[uriToLocation(uri).ID()]; ok {
+			uris = append(uris, uri)
+		}
+	}
+
+	sort.Slice(uris, func(i, j int) bool {
+		return uris[i] < uris[j]
+	})
+
+	for _, uri := range uris {
+		document := s.documents[uri]
+		s.checkAndPublishDiagnostics(conn, uri, document.Text, document.Version)
+	}
+}
@@ -0,0 +1,205 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const testAnswerContract = `
+pub contract Answer {
+    pub fun get(): Int {
+        return 42
+    }
+}
+`
+
+const testAnswerScript = `
+import Answer from "Answer.cdc"
+
+pub fun main(): Int {
+    return Answer.get()
+}
+`
+
+func TestImportResolution(t *testing.T) {
+
+	t.Parallel()
+
+	newServer := func(t *testing.T) (*Server, string, protocol.DocumentUri) {
+		server, dir := newTestWorkspaceServer(t)
+
+		files := map[string]string{
+			"contracts/Answer.cdc": testAnswerContract,
+			"scripts/answer.cdc":   testAnswerScript,
+		}
+
+		for name, code := range files {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+			require.NoError(t, ioutil.WriteFile(path, []byte(code), 0600))
+		}
+
+		return server, dir, pathToURI(filepath.Join(dir, "scripts/answer.cdc"))
+	}
+
+	t.Run("relative to importing file", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir, uri := newServer(t)
+		defer os.RemoveAll(dir)
+
+		// The import is not found next to the script, and there is no search root for the contracts
+
+		diagnostics, err := server.getDiagnostics(testConn{}, uri, testAnswerScript, 1)
+		require.NoError(t, err)
+		assert.NotEmpty(t, diagnostics)
+
+		// A relative import which is found next to the script
+
+		err = ioutil.WriteFile(filepath.Join(dir, "scripts/Answer.cdc"), []byte(testAnswerContract), 0600)
+		require.NoError(t, err)
+
+		diagnostics, err = server.getDiagnostics(testConn{}, uri, testAnswerScript, 2)
+		require.NoError(t, err)
+		assert.Empty(t, diagnostics)
+	})
+
+	t.Run("search roots", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir, uri := newServer(t)
+		defer os.RemoveAll(dir)
+
+		server.configure(map[string]interface{}{
+			importSearchRootsOption: []interface{}{"contracts"},
+		})
+
+		diagnostics, err := server.getDiagnostics(testConn{}, uri, testAnswerScript, 1)
+		require.NoError(t, err)
+		assert.Empty(t, diagnostics)
+
+		assert.Equal(t,
+			filepath.Join(dir, "contracts/Answer.cdc"),
+			locationToPath(server.resolvePathLocation(uriToLocation(uri), common.StringLocation("Answer.cdc"))),
+		)
+	})
+
+	t.Run("cache invalidation", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir, uri := newServer(t)
+		defer os.RemoveAll(dir)
+
+		server.configure(map[string]interface{}{
+			importSearchRootsOption: []interface{}{"contracts"},
+		})
+
+		openTestDocument(t, server, uri)
+
+		contractPath := filepath.Join(dir, "contracts/Answer.cdc")
+		contractLocationID := uriToLocation(pathToURI(contractPath)).ID()
+
+		importedChecker, ok := server.importCache.get(contractLocationID)
+		require.True(t, ok)
+
+		// Checking the document again uses the cached import
+
+		diagnostics, err := server.getDiagnostics(testConn{}, uri, testAnswerScript, 2)
+		require.NoError(t, err)
+		assert.Empty(t, diagnostics)
+
+		cachedChecker, ok := server.importCache.get(contractLocationID)
+		require.True(t, ok)
+		assert.Same(t, importedChecker, cachedChecker)
+
+		// Changing the imported file invalidates the cached import,
+		// and checks the importing document again
+
+		err = ioutil.WriteFile(contractPath, []byte("pub contract Answer {}"), 0600)
+		require.NoError(t, err)
+
+		documentChecker := server.checkerForDocument(uri)
+
+		err = server.DidChangeWatchedFiles(
+			testConn{},
+			&protocol.DidChangeWatchedFilesParams{
+				Changes: []protocol.FileEvent{
+					{
+						URI:  pathToURI(contractPath),
+						Type: protocol.Changed,
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.NotSame(t, documentChecker, server.checkerForDocument(uri))
+
+		cachedChecker, ok = server.importCache.get(contractLocationID)
+		require.True(t, ok)
+		assert.NotSame(t, importedChecker, cachedChecker)
+
+		diagnostics, err = server.getDiagnostics(testConn{}, uri, testAnswerScript, 3)
+		require.NoError(t, err)
+		assert.NotEmpty(t, diagnostics)
+	})
+
+	t.Run("invalid import", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir, uri := newServer(t)
+		defer os.RemoveAll(dir)
+
+		server.configure(map[string]interface{}{
+			importSearchRootsOption: []interface{}{"contracts"},
+		})
+
+		contractPath := filepath.Join(dir, "contracts/Answer.cdc")
+		contractLocationID := uriToLocation(pathToURI(contractPath)).ID()
+
+		err := ioutil.WriteFile(contractPath, []byte("pub contract Answer { let x: Int }"), 0600)
+		require.NoError(t, err)
+
+		// The invalid import is reported every time the document is checked
+
+		for version := 1; version <= 2; version++ {
+			diagnostics, err := server.getDiagnostics(testConn{}, uri, testAnswerScript, float64(version))
+			require.NoError(t, err)
+			assert.NotEmpty(t, diagnostics)
+
+			_, ok := server.importCache.get(contractLocationID)
+			assert.False(t, ok)
+		}
+	})
+}
@@ -0,0 +1,314 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const inlayHintsOption = "inlayHints"
+
+const (
+	// inlayHintTypesOption enables hints for the inferred types of variable declarations
+	inlayHintTypesOption = "types"
+	// inlayHintParameterNamesOption enables hints for the parameter names of unlabeled arguments
+	inlayHintParameterNamesOption = "parameterNames"
+	// inlayHintMovesOption enables hints for the targets of resource moves
+	inlayHintMovesOption = "moves"
+)
+
+// inlayHintOptions are the kinds of inlay hints which are enabled
+//
+type inlayHintOptions struct {
+	types          bool
+	parameterNames bool
+	moves          bool
+}
+
+var defaultInlayHintOptions = inlayHintOptions{
+	types:          true,
+	parameterNames: true,
+	moves:          true,
+}
+
+// newInlayHintOptions returns the inlay hint options for the given initialization option,
+// a map from the hint kind to a boolean. Kinds which are not configured are enabled
+//
+func newInlayHintOptions(option interface{}) inlayHintOptions {
+	options := defaultInlayHintOptions
+
+	optionMap, ok := option.(map[string]interface{})
+	if !ok {
+		return options
+	}
+
+	if enabled, ok := optionMap[inlayHintTypesOption].(bool); ok {
+		options.types = enabled
+	}
+
+	if enabled, ok := optionMap[inlayHintParameterNamesOption].(bool); ok {
+		options.parameterNames = enabled
+	}
+
+	if enabled, ok := optionMap[inlayHintMovesOption].(bool); ok {
+		options.moves = enabled
+	}
+
+	return options
+}
+
+// invokedFunctionType returns the type of the function invoked by the given invocation,
+// or nil if it is unknown
+//
+func invokedFunctionType(elaboration *sema.Elaboration, invocation *ast.InvocationExpression) *sema.FunctionType {
+	var invokedType sema.Type
+
+	switch invokedExpression := invocation.InvokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		invokedType = elaboration.IdentifierInInvocationTypes[invokedExpression]
+
+	case *ast.MemberExpression:
+		member := elaboration.MemberExpressionMemberInfos[invokedExpression].Member
+		if member != nil && member.TypeAnnotation != nil {
+			invokedType = member.TypeAnnotation.Type
+		}
+	}
+
+	functionType, _ := invokedType.(*sema.FunctionType)
+	return functionType
+}
+
+// invokedFunctionName returns the name of the function invoked by the given invocation,
+// or the empty string if the invoked expression is not a name
+//
+func invokedFunctionName(invocation *ast.InvocationExpression) string {
+	switch invokedExpression := invocation.InvokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		return invokedExpression.Identifier.Identifier
+
+	case *ast.MemberExpression:
+		return invokedExpression.Identifier.Identifier
+	}
+
+	return ""
+}
+
+func isMove(expression ast.Expression) bool {
+	unaryExpression, ok := expression.(*ast.UnaryExpression)
+	return ok && unaryExpression.Operation == ast.OperationMove
+}
+
+// isMoveOf returns true if the given expression is the given name or a move of the given name
+//
+func isMoveOf(expression ast.Expression, name string) bool {
+	if isMove(expression) {
+		expression = expression.(*ast.UnaryExpression).Expression
+	}
+
+	identifierExpression, ok := expression.(*ast.IdentifierExpression)
+	return ok && identifierExpression.Identifier.Identifier == name
+}
+
+// inlayHintCollector collects the inlay hints of a checked program
+//
+type inlayHintCollector struct {
+	elaboration *sema.Elaboration
+	options     inlayHintOptions
+	hints       []*protocol.InlayHint
+}
+
+func (c *inlayHintCollector) addHint(hint *protocol.InlayHint) {
+	c.hints = append(c.hints, hint)
+}
+
+// addTypeHint adds a hint for the inferred type of the given variable declaration,
+// if it has no type annotation
+//
+func (c *inlayHintCollector) addTypeHint(declaration *ast.VariableDeclaration) {
+	if !c.options.types || declaration.TypeAnnotation != nil {
+		return
+	}
+
+	targetType := c.elaboration.VariableDeclarationTargetTypes[declaration]
+	if targetType == nil || targetType.IsInvalidType() {
+		return
+	}
+
+	c.addHint(&protocol.InlayHint{
+		Position: conversion.ASTToProtocolPosition(
+			declaration.Identifier.EndPosition().Shifted(1),
+		),
+		Label: fmt.Sprintf(": %s", sema.NewTypeAnnotation(targetType).QualifiedString()),
+		Kind:  protocol.TypeInlayHint,
+	})
+}
+
+// addArgumentHints adds hints for the parameter names of the unlabeled arguments of the given invocation,
+// and for the parameters which resources are moved into
+//
+func (c *inlayHintCollector) addArgumentHints(invocation *ast.InvocationExpression) {
+	functionType := invokedFunctionType(c.elaboration, invocation)
+	if functionType == nil {
+		return
+	}
+
+	for i, argument := range invocation.Arguments {
+		if i >= len(functionType.Parameters) {
+			break
+		}
+
+		parameter := functionType.Parameters[i]
+		if parameter.Identifier == "" {
+			continue
+		}
+
+		// Only hint the parameter name if the argument is not labeled,
+		// and the name is not obvious from the argument
+
+		if c.options.parameterNames &&
+			argument.Label == "" &&
+			parameter.Label == sema.ArgumentLabelNotRequired &&
+			!isMoveOf(argument.Expression, parameter.Identifier) {
+
+			c.addHint(&protocol.InlayHint{
+				Position:     conversion.ASTToProtocolPosition(argument.Expression.StartPosition()),
+				Label:        fmt.Sprintf("%s:", parameter.Identifier),
+				Kind:         protocol.ParameterInlayHint,
+				PaddingRight: true,
+			})
+		}
+
+		if isMove(argument.Expression) {
+			c.addMoveHint(
+				argument.Expression,
+				fmt.Sprintf("→ %s", parameter.Identifier),
+				fmt.Sprintf(
+					"moved into parameter `%s` of `%s`",
+					parameter.Identifier,
+					invokedFunctionName(invocation),
+				),
+			)
+		}
+	}
+}
+
+// addMoveHint adds a hint for the target of the given resource move.
+//
+// Only moves into targets which are not visible at the move are hinted,
+// i.e. moves into parameters and out of functions, but not moves into variables
+//
+func (c *inlayHintCollector) addMoveHint(expression ast.Expression, label string, tooltip string) {
+	if !c.options.moves {
+		return
+	}
+
+	c.addHint(&protocol.InlayHint{
+		Position:    conversion.ASTToProtocolPosition(expression.EndPosition().Shifted(1)),
+		Label:       label,
+		Tooltip:     tooltip,
+		PaddingLeft: true,
+	})
+}
+
+func (c *inlayHintCollector) collect(program *ast.Program) {
+	ast.Inspect(program, func(element ast.Element) bool {
+		switch element := element.(type) {
+		case *ast.VariableDeclaration:
+			c.addTypeHint(element)
+
+		case *ast.InvocationExpression:
+			c.addArgumentHints(element)
+
+		case *ast.ReturnStatement:
+			if isMove(element.Expression) {
+				c.addMoveHint(
+					element.Expression,
+					"→ return",
+					"moved to the caller",
+				)
+			}
+		}
+
+		return true
+	})
+}
+
+// inlayHints returns the inlay hints of the given checked program, sorted by position
+//
+func inlayHints(checker *sema.Checker, options inlayHintOptions) []*protocol.InlayHint {
+	collector := &inlayHintCollector{
+		elaboration: checker.Elaboration,
+		options:     options,
+	}
+
+	collector.collect(checker.Program)
+
+	hints := collector.hints
+
+	sort.SliceStable(hints, func(i, j int) bool {
+		return positionLess(hints[i].Position, hints[j].Position)
+	})
+
+	return hints
+}
+
+func positionLess(a, b protocol.Position) bool {
+	return a.Line < b.Line ||
+		(a.Line == b.Line && a.Character < b.Character)
+}
+
+// InlayHint returns the inlay hints in the given range of the document:
+// the inferred types of variable declarations, the parameter names of unlabeled arguments,
+// and the targets of resource moves
+//
+func (s *Server) InlayHint(
+	_ protocol.Conn,
+	params *protocol.InlayHintParams,
+) (
+	[]*protocol.InlayHint,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	hints := []*protocol.InlayHint{}
+
+	checker := s.checkerForDocument(params.TextDocument.URI)
+	if checker == nil {
+		return hints, nil
+	}
+
+	for _, hint := range inlayHints(checker, s.inlayHintOptions) {
+		if positionLess(hint.Position, params.Range.Start) ||
+			positionLess(params.Range.End, hint.Position) {
+
+			continue
+		}
+
+		hints = append(hints, hint)
+	}
+
+	return hints, nil
+}
@@ -0,0 +1,175 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const testInlayHintsScript = `
+pub resource R {}
+
+pub fun consume(_ r: @R, _ amount: Int, label: String) {
+    destroy r
+}
+
+pub fun make(): @R {
+    return <- create R()
+}
+
+pub fun main() {
+    let r <- make()
+    let amount = 1
+    consume(<-r, amount, label: "r")
+    consume(<-make(), 2, label: "made")
+}
+`
+
+// testInlayHints returns the given inlay hints as strings
+// of the form `line:character label`
+//
+func testInlayHints(hints []*protocol.InlayHint) []string {
+	result := make([]string, len(hints))
+	for i, hint := range hints {
+		result[i] = fmt.Sprintf(
+			"%d:%d %s",
+			int(hint.Position.Line),
+			int(hint.Position.Character),
+			hint.Label,
+		)
+	}
+	return result
+}
+
+func TestInlayHint(t *testing.T) {
+
+	t.Parallel()
+
+	queryInlayHints := func(t *testing.T, server *Server, uri protocol.DocumentUri, r protocol.Range) []string {
+		hints, err := server.InlayHint(
+			testConn{},
+			&protocol.InlayHintParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Range:        r,
+			},
+		)
+		require.NoError(t, err)
+		require.NotNil(t, hints)
+		return testInlayHints(hints)
+	}
+
+	openDocument := func(t *testing.T, server *Server, dir string) protocol.DocumentUri {
+		uri := pathToURI(filepath.Join(dir, "hints.cdc"))
+
+		err := server.DidOpenTextDocument(
+			testConn{},
+			&protocol.DidOpenTextDocumentParams{
+				TextDocument: protocol.TextDocumentItem{
+					URI:  uri,
+					Text: testInlayHintsScript,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		return uri
+	}
+
+	wholeDocument := protocol.Range{
+		Start: protocol.Position{Line: 0, Character: 0},
+		End:   protocol.Position{Line: 100, Character: 0},
+	}
+
+	t.Run("all", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := openDocument(t, server, dir)
+
+		assert.Equal(t,
+			[]string{
+				"8:24 → return",
+				"12:9 : @R",
+				"13:14 : Int",
+				"14:15 → r",
+				"15:12 r:",
+				"15:20 → r",
+				"15:22 amount:",
+			},
+			queryInlayHints(t, server, uri, wholeDocument),
+		)
+	})
+
+	t.Run("range", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := openDocument(t, server, dir)
+
+		assert.Equal(t,
+			[]string{
+				"13:14 : Int",
+				"14:15 → r",
+			},
+			queryInlayHints(t, server, uri, protocol.Range{
+				Start: protocol.Position{Line: 13, Character: 0},
+				End:   protocol.Position{Line: 14, Character: 40},
+			}),
+		)
+	})
+
+	t.Run("configured kinds", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		server.configure(map[string]interface{}{
+			inlayHintsOption: map[string]interface{}{
+				inlayHintTypesOption: false,
+				inlayHintMovesOption: false,
+			},
+		})
+
+		uri := openDocument(t, server, dir)
+
+		assert.Equal(t,
+			[]string{
+				"15:12 r:",
+				"15:22 amount:",
+			},
+			queryInlayHints(t, server, uri, wholeDocument),
+		)
+	})
+}
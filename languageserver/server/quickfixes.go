@@ -0,0 +1,601 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// quickFix returns a quick fix code action for the given diagnostic,
+// which applies the given edits to the given document
+//
+func quickFix(
+	title string,
+	diagnostic protocol.Diagnostic,
+	uri protocol.DocumentUri,
+	isPreferred bool,
+	textEdits ...protocol.TextEdit,
+) *protocol.CodeAction {
+	return &protocol.CodeAction{
+		Title:       title,
+		Kind:        protocol.QuickFix,
+		Diagnostics: []protocol.Diagnostic{diagnostic},
+		Edit: &protocol.WorkspaceEdit{
+			Changes: &map[string][]protocol.TextEdit{
+				string(uri): textEdits,
+			},
+		},
+		IsPreferred: isPreferred,
+	}
+}
+
+func insertionTextEdit(position ast.Position, text string) protocol.TextEdit {
+	protocolPosition := conversion.ASTToProtocolPosition(position)
+	return protocol.TextEdit{
+		Range: protocol.Range{
+			Start: protocolPosition,
+			End:   protocolPosition,
+		},
+		NewText: text,
+	}
+}
+
+// combinedCodeActionsResolver returns a resolver which returns the code actions of all given resolvers,
+// or nil if none of the resolvers is given
+//
+func combinedCodeActionsResolver(resolvers ...func() []*protocol.CodeAction) func() []*protocol.CodeAction {
+	var nonNilResolvers []func() []*protocol.CodeAction
+	for _, resolver := range resolvers {
+		if resolver != nil {
+			nonNilResolvers = append(nonNilResolvers, resolver)
+		}
+	}
+
+	switch len(nonNilResolvers) {
+	case 0:
+		return nil
+	case 1:
+		return nonNilResolvers[0]
+	}
+
+	return func() []*protocol.CodeAction {
+		var codeActions []*protocol.CodeAction
+		for _, resolver := range nonNilResolvers {
+			codeActions = append(codeActions, resolver()...)
+		}
+		return codeActions
+	}
+}
+
+// lineIndentation returns the leading whitespace of the given line (1-based) of the given text
+//
+func lineIndentation(text string, line int) string {
+	lines := strings.SplitN(text, "\n", line+1)
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+
+	content := lines[line-1]
+	return content[:len(content)-len(strings.TrimLeft(content, " \t"))]
+}
+
+// missingMoveOperationCodeActionsResolver returns a resolver which proposes
+// to insert the move operator in front of a resource expression
+//
+func missingMoveOperationCodeActionsResolver(
+	diagnostic protocol.Diagnostic,
+	uri protocol.DocumentUri,
+	err *sema.MissingMoveOperationError,
+) func() []*protocol.CodeAction {
+
+	return func() []*protocol.CodeAction {
+		return []*protocol.CodeAction{
+			quickFix(
+				"Insert move operator `<-`",
+				diagnostic,
+				uri,
+				true,
+				insertionTextEdit(err.Pos, "<- "),
+			),
+		}
+	}
+}
+
+// incorrectTransferOperationCodeActionsResolver returns a resolver which proposes
+// to replace the transfer operation with the expected one, e.g. `=` with `<-` for a resource
+//
+func incorrectTransferOperationCodeActionsResolver(
+	diagnostic protocol.Diagnostic,
+	uri protocol.DocumentUri,
+	err *sema.IncorrectTransferOperationError,
+) func() []*protocol.CodeAction {
+
+	operator := err.ExpectedOperation.Operator()
+
+	return func() []*protocol.CodeAction {
+		return []*protocol.CodeAction{
+			quickFix(
+				fmt.Sprintf("Replace with `%s`", operator),
+				diagnostic,
+				uri,
+				true,
+				protocol.TextEdit{
+					Range:   conversion.ASTToProtocolRange(err.StartPos, err.EndPos),
+					NewText: operator,
+				},
+			),
+		}
+	}
+}
+
+// missingAccessModifierCodeActionsResolver returns a resolver which proposes
+// to add an access modifier to a declaration.
+// Type declarations must be public, so only public access is proposed for them
+//
+func missingAccessModifierCodeActionsResolver(
+	diagnostic protocol.Diagnostic,
+	uri protocol.DocumentUri,
+	err *sema.MissingAccessModifierError,
+) func() []*protocol.CodeAction {
+
+	accesses := []ast.Access{
+		ast.AccessPublic,
+	}
+
+	if err.Explanation == "" {
+		accesses = append(accesses,
+			ast.AccessContract,
+			ast.AccessAccount,
+			ast.AccessPrivate,
+		)
+	}
+
+	return func() []*protocol.CodeAction {
+		codeActions := make([]*protocol.CodeAction, 0, len(accesses))
+
+		for i, access := range accesses {
+			keyword := access.Keyword()
+
+			codeActions = append(codeActions,
+				quickFix(
+					fmt.Sprintf("Add access modifier `%s`", keyword),
+					diagnostic,
+					uri,
+					i == 0,
+					insertionTextEdit(err.Pos, keyword+" "),
+				),
+			)
+		}
+
+		return codeActions
+	}
+}
+
+// resourceLossCodeActionsResolver returns a resolver which proposes to destroy a lost resource:
+// Either the resource of an expression statement, e.g. `create R()`,
+// or a resource variable or parameter, which is destroyed at the end of its scope
+//
+func (s *Server) resourceLossCodeActionsResolver(
+	diagnostic protocol.Diagnostic,
+	uri protocol.DocumentUri,
+	err *sema.ResourceLossError,
+) func() []*protocol.CodeAction {
+
+	return func() []*protocol.CodeAction {
+
+		document, ok := s.documents[uri]
+		if !ok {
+			return nil
+		}
+
+		checker := s.checkerForDocument(uri)
+		if checker == nil {
+			return nil
+		}
+
+		expressionStatement, scope, name := lostResource(checker.Program, err.Range)
+
+		switch {
+		case expressionStatement != nil:
+			return []*protocol.CodeAction{
+				quickFix(
+					"Destroy the resource",
+					diagnostic,
+					uri,
+					true,
+					insertionTextEdit(expressionStatement.StartPosition(), "destroy "),
+				),
+			}
+
+		case scope != nil:
+			return []*protocol.CodeAction{
+				quickFix(
+					fmt.Sprintf("Destroy `%s` at the end of its scope", name),
+					diagnostic,
+					uri,
+					true,
+					destroyTextEdit(document.Text, scope, name),
+				),
+			}
+		}
+
+		return nil
+	}
+}
+
+// lostResource finds the source of a lost resource with the given range in the given program:
+// Either an expression statement, or the name of a variable or parameter, and the block in which it is declared
+//
+func lostResource(program *ast.Program, lossRange ast.Range) (
+	expressionStatement *ast.ExpressionStatement,
+	scope *ast.Block,
+	name string,
+) {
+	declaresResource := func(identifier ast.Identifier) bool {
+		return identifier.Pos == lossRange.StartPos
+	}
+
+	checkFunction := func(function *ast.FunctionDeclaration) {
+		if function.FunctionBlock == nil || function.ParameterList == nil {
+			return
+		}
+
+		for _, parameter := range function.ParameterList.Parameters {
+			if declaresResource(parameter.Identifier) {
+				scope = function.FunctionBlock.Block
+				name = parameter.Identifier.Identifier
+			}
+		}
+	}
+
+	walkElements(program, func(element ast.Element) bool {
+		if expressionStatement != nil || scope != nil {
+			return false
+		}
+
+		switch element := element.(type) {
+		case *ast.ExpressionStatement:
+			expression := element.Expression
+			if expression.StartPosition() == lossRange.StartPos &&
+				expression.EndPosition() == lossRange.EndPos {
+
+				expressionStatement = element
+			}
+
+		case *ast.Block:
+			for _, statement := range element.Statements {
+				declaration, ok := statement.(*ast.VariableDeclaration)
+				if ok && declaresResource(declaration.Identifier) {
+					scope = element
+					name = declaration.Identifier.Identifier
+				}
+			}
+
+		case *ast.IfStatement:
+			// The variable of an optional binding is declared in the then-branch
+			declaration, ok := element.Test.(*ast.VariableDeclaration)
+			if ok && declaresResource(declaration.Identifier) {
+				scope = element.Then
+				name = declaration.Identifier.Identifier
+			}
+
+		case *ast.FunctionDeclaration:
+			checkFunction(element)
+
+		case *ast.SpecialFunctionDeclaration:
+			checkFunction(element.FunctionDeclaration)
+
+		case *ast.FunctionExpression:
+			if element.FunctionBlock != nil && element.ParameterList != nil {
+				for _, parameter := range element.ParameterList.Parameters {
+					if declaresResource(parameter.Identifier) {
+						scope = element.FunctionBlock.Block
+						name = parameter.Identifier.Identifier
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	return
+}
+
+// destroyTextEdit returns the edit which destroys the resource with the given name at the end of the given block.
+// If the block ends with a return statement, the resource is destroyed before it
+//
+func destroyTextEdit(text string, block *ast.Block, name string) protocol.TextEdit {
+	destroyStatement := fmt.Sprintf("destroy %s", name)
+
+	statements := block.Statements
+
+	if len(statements) == 0 {
+		indentation := lineIndentation(text, block.StartPos.Line)
+		innerIndentation := indentation + strings.Repeat(" ", indentationCount)
+		return insertionTextEdit(
+			block.StartPos.Shifted(1),
+			"\n"+innerIndentation+destroyStatement+"\n"+indentation,
+		)
+	}
+
+	lastStatement := statements[len(statements)-1]
+	indentation := lineIndentation(text, lastStatement.StartPosition().Line)
+
+	if _, ok := lastStatement.(*ast.ReturnStatement); ok {
+		return insertionTextEdit(
+			lastStatement.StartPosition(),
+			destroyStatement+"\n"+indentation,
+		)
+	}
+
+	return insertionTextEdit(
+		lastStatement.EndPosition().Shifted(1),
+		"\n"+indentation+destroyStatement,
+	)
+}
+
+// argumentLabelCodeActionsResolver returns a resolver which proposes to fix the label of an argument:
+// Insert a missing label, replace an incorrect label, or remove a label which is not expected
+//
+func (s *Server) argumentLabelCodeActionsResolver(
+	diagnostic protocol.Diagnostic,
+	uri protocol.DocumentUri,
+	labelRange ast.Range,
+	actualLabel string,
+	expectedLabel string,
+) func() []*protocol.CodeAction {
+
+	return func() []*protocol.CodeAction {
+
+		// A missing label is inserted before the argument expression
+
+		if actualLabel == "" {
+			return []*protocol.CodeAction{
+				quickFix(
+					fmt.Sprintf("Insert argument label `%s`", expectedLabel),
+					diagnostic,
+					uri,
+					true,
+					insertionTextEdit(labelRange.StartPos, expectedLabel+": "),
+				),
+			}
+		}
+
+		if expectedLabel != "" {
+			return []*protocol.CodeAction{
+				quickFix(
+					fmt.Sprintf("Replace argument label with `%s`", expectedLabel),
+					diagnostic,
+					uri,
+					true,
+					protocol.TextEdit{
+						Range:   conversion.ASTToProtocolRange(labelRange.StartPos, labelRange.EndPos),
+						NewText: expectedLabel,
+					},
+				),
+			}
+		}
+
+		// The label is removed together with the separating colon,
+		// i.e. up to the argument expression
+
+		checker := s.checkerForDocument(uri)
+		if checker == nil {
+			return nil
+		}
+
+		var argument *ast.Argument
+
+		walkElements(checker.Program, func(element ast.Element) bool {
+			if argument != nil {
+				return false
+			}
+
+			invocation, ok := element.(*ast.InvocationExpression)
+			if !ok {
+				return true
+			}
+
+			for _, invocationArgument := range invocation.Arguments {
+				if invocationArgument.LabelStartPos != nil &&
+					*invocationArgument.LabelStartPos == labelRange.StartPos {
+
+					argument = invocationArgument
+				}
+			}
+
+			return true
+		})
+
+		if argument == nil {
+			return nil
+		}
+
+		return []*protocol.CodeAction{
+			quickFix(
+				"Remove argument label",
+				diagnostic,
+				uri,
+				true,
+				protocol.TextEdit{
+					Range: protocol.Range{
+						Start: conversion.ASTToProtocolPosition(labelRange.StartPos),
+						End:   conversion.ASTToProtocolPosition(argument.Expression.StartPosition()),
+					},
+				},
+			),
+		}
+	}
+}
+
+// missingImportCodeActionsResolver returns a resolver which proposes to import an undeclared type,
+// e.g. a contract, from the files of the workspace which declare it,
+// or from the addresses of known contracts, i.e. addresses which are already imported
+//
+func (s *Server) missingImportCodeActionsResolver(
+	diagnostic protocol.Diagnostic,
+	uri protocol.DocumentUri,
+	name string,
+) func() []*protocol.CodeAction {
+
+	return func() []*protocol.CodeAction {
+
+		checker := s.checkerForDocument(uri)
+		if checker == nil {
+			return nil
+		}
+
+		var codeActions []*protocol.CodeAction
+
+		addImport := func(importedLocation string) {
+			codeActions = append(codeActions,
+				quickFix(
+					fmt.Sprintf("Import `%s` from %s", name, importedLocation),
+					diagnostic,
+					uri,
+					len(codeActions) == 0,
+					importTextEdit(checker.Program, name, importedLocation),
+				),
+			)
+		}
+
+		for _, importedPath := range s.importablePaths(uri, name) {
+			addImport(fmt.Sprintf("%q", importedPath))
+		}
+
+		for _, address := range s.importableAddresses(name) {
+			addImport(address.ShortHexWithPrefix())
+		}
+
+		return codeActions
+	}
+}
+
+// importablePaths returns the paths, relative to the given document, of the files of the workspace
+// which declare a top-level type with the given name
+//
+func (s *Server) importablePaths(uri protocol.DocumentUri, name string) []string {
+	documentPath := locationToPath(uriToLocation(uri))
+
+	var paths []string
+
+	for _, symbol := range s.workspaceSymbolIndex().query(name) {
+		if symbol.Name != name ||
+			symbol.ContainerName != "" ||
+			(symbol.Kind != protocol.Class && symbol.Kind != protocol.Interface) ||
+			symbol.Location.URI == uri {
+
+			continue
+		}
+
+		symbolPath := locationToPath(uriToLocation(symbol.Location.URI))
+
+		relativePath, err := filepath.Rel(path.Dir(documentPath), symbolPath)
+		if err != nil {
+			continue
+		}
+
+		relativePath = filepath.ToSlash(relativePath)
+		if !strings.HasPrefix(relativePath, ".") {
+			relativePath = "./" + relativePath
+		}
+
+		paths = append(paths, relativePath)
+	}
+
+	return paths
+}
+
+// importableAddresses returns the addresses imported by the open documents
+// which have a contract with the given name deployed
+//
+func (s *Server) importableAddresses(name string) []common.Address {
+	if s.resolveAddressContractNames == nil {
+		return nil
+	}
+
+	importedAddresses := map[common.Address]struct{}{}
+
+	for _, checker := range s.checkers {
+		for _, declaration := range checker.Program.ImportDeclarations() {
+			addressLocation, ok := declaration.Location.(common.AddressLocation)
+			if !ok {
+				continue
+			}
+
+			importedAddresses[addressLocation.Address] = struct{}{}
+		}
+	}
+
+	var addresses []common.Address
+
+	for address := range importedAddresses {
+		contractNames, err := s.resolveAddressContractNames(address)
+		if err != nil {
+			continue
+		}
+
+		for _, contractName := range contractNames {
+			if contractName == name {
+				addresses = append(addresses, address)
+				break
+			}
+		}
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Hex() < addresses[j].Hex()
+	})
+
+	return addresses
+}
+
+// importTextEdit returns the edit which imports the given name from the given location:
+// After the last import declaration, or at the beginning of the program if there is none
+//
+func importTextEdit(program *ast.Program, name string, importedLocation string) protocol.TextEdit {
+	importDeclaration := fmt.Sprintf("import %s from %s\n", name, importedLocation)
+
+	imports := program.ImportDeclarations()
+	if len(imports) == 0 {
+		return insertionTextEdit(
+			ast.Position{Line: 1},
+			importDeclaration+"\n",
+		)
+	}
+
+	lastImport := imports[len(imports)-1]
+
+	return insertionTextEdit(
+		ast.Position{Line: lastImport.EndPosition().Line + 1},
+		importDeclaration,
+	)
+}
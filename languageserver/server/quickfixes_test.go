@@ -0,0 +1,413 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// testQuickFixes opens a document with the given code,
+// and returns the quick fixes for all its diagnostics, with the edited code for each
+//
+func testQuickFixes(t *testing.T, server *Server, uri protocol.DocumentUri, code string) map[string]string {
+
+	conn := publishingTestConn{
+		diagnostics: make(chan *protocol.PublishDiagnosticsParams, 1),
+	}
+
+	err := server.DidOpenTextDocument(
+		conn,
+		&protocol.DidOpenTextDocumentParams{
+			TextDocument: protocol.TextDocumentItem{
+				URI:  uri,
+				Text: code,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// Pass the diagnostics through JSON, like the client does
+
+	data, err := json.Marshal((<-conn.diagnostics).Diagnostics)
+	require.NoError(t, err)
+
+	var diagnostics []protocol.Diagnostic
+	err = json.Unmarshal(data, &diagnostics)
+	require.NoError(t, err)
+
+	codeActions, err := server.CodeAction(
+		testConn{},
+		&protocol.CodeActionParams{
+			TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+			Context: protocol.CodeActionContext{
+				Diagnostics: diagnostics,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	fixes := map[string]string{}
+
+	for _, codeAction := range codeActions {
+		require.Equal(t, protocol.QuickFix, codeAction.Kind)
+
+		textEdits := append([]protocol.TextEdit(nil), (*codeAction.Edit.Changes)[string(uri)]...)
+
+		// Apply the edits from the end, so the ranges of the remaining edits stay valid
+
+		sort.SliceStable(textEdits, func(i, j int) bool {
+			return positionLess(textEdits[j].Range.Start, textEdits[i].Range.Start)
+		})
+
+		edited := code
+		for _, textEdit := range textEdits {
+			textEditRange := textEdit.Range
+			edited = applyContentChange(edited, protocol.TextDocumentContentChangeEvent{
+				Range: &textEditRange,
+				Text:  textEdit.NewText,
+			})
+		}
+
+		fixes[codeAction.Title] = edited
+	}
+
+	return fixes
+}
+
+func TestQuickFixes(t *testing.T) {
+
+	t.Parallel()
+
+	const uri = protocol.DocumentUri("file:///test.cdc")
+
+	newServer := func(t *testing.T) *Server {
+		server, err := NewServer()
+		require.NoError(t, err)
+		return server
+	}
+
+	t.Run("move operator", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := testQuickFixes(t, newServer(t), uri, `
+pub resource R {}
+
+pub fun consume(_ r: @R) {
+    destroy r
+}
+
+pub fun test() {
+    let r = create R()
+    consume(r)
+}
+`)
+
+		assert.Equal(t,
+			map[string]string{
+				"Destroy `r` at the end of its scope": `
+pub resource R {}
+
+pub fun consume(_ r: @R) {
+    destroy r
+}
+
+pub fun test() {
+    let r = create R()
+    consume(r)
+    destroy r
+}
+`,
+				"Replace with `<-`": `
+pub resource R {}
+
+pub fun consume(_ r: @R) {
+    destroy r
+}
+
+pub fun test() {
+    let r <- create R()
+    consume(r)
+}
+`,
+				"Insert move operator `<-`": `
+pub resource R {}
+
+pub fun consume(_ r: @R) {
+    destroy r
+}
+
+pub fun test() {
+    let r = create R()
+    consume(<- r)
+}
+`,
+			},
+			fixes,
+		)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := testQuickFixes(t, newServer(t), uri, `
+pub resource R {}
+
+pub fun test(): Int {
+    create R()
+    let r <- create R()
+    return 1
+}
+`)
+
+		assert.Equal(t,
+			map[string]string{
+				"Destroy the resource": `
+pub resource R {}
+
+pub fun test(): Int {
+    destroy create R()
+    let r <- create R()
+    return 1
+}
+`,
+				"Destroy `r` at the end of its scope": `
+pub resource R {}
+
+pub fun test(): Int {
+    create R()
+    let r <- create R()
+    destroy r
+    return 1
+}
+`,
+			},
+			fixes,
+		)
+	})
+
+	t.Run("destroy parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := testQuickFixes(t, newServer(t), uri, `
+pub resource R {}
+
+pub fun test(r: @R) {}
+`)
+
+		assert.Equal(t,
+			map[string]string{
+				"Destroy `r` at the end of its scope": `
+pub resource R {}
+
+pub fun test(r: @R) {
+    destroy r
+}
+`,
+			},
+			fixes,
+		)
+	})
+
+	t.Run("access modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := testQuickFixes(t, newServer(t), uri, `
+pub contract C {
+    let x: Int
+
+    init() {
+        self.x = 1
+    }
+}
+`)
+
+		edited := func(access string) string {
+			return `
+pub contract C {
+    ` + access + ` let x: Int
+
+    init() {
+        self.x = 1
+    }
+}
+`
+		}
+
+		assert.Equal(t,
+			map[string]string{
+				"Add access modifier `pub`":              edited("pub"),
+				"Add access modifier `access(contract)`": edited("access(contract)"),
+				"Add access modifier `access(account)`":  edited("access(account)"),
+				"Add access modifier `priv`":             edited("priv"),
+			},
+			fixes,
+		)
+	})
+
+	t.Run("argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := testQuickFixes(t, newServer(t), uri, `
+pub fun add(a: Int, b: Int): Int {
+    return a + b
+}
+
+pub fun negate(_ a: Int): Int {
+    return -a
+}
+
+pub let x = add(1, c: 2)
+pub let y = negate(a: 1)
+`)
+
+		edited := func(x, y string) string {
+			return `
+pub fun add(a: Int, b: Int): Int {
+    return a + b
+}
+
+pub fun negate(_ a: Int): Int {
+    return -a
+}
+
+pub let x = ` + x + `
+pub let y = ` + y + `
+`
+		}
+
+		assert.Equal(t,
+			map[string]string{
+				"Insert argument label `a`":       edited("add(a: 1, c: 2)", "negate(a: 1)"),
+				"Replace argument label with `b`": edited("add(1, b: 2)", "negate(a: 1)"),
+				"Remove argument label":           edited("add(1, c: 2)", "negate(1)"),
+			},
+			fixes,
+		)
+	})
+
+	t.Run("missing members", func(t *testing.T) {
+
+		t.Parallel()
+
+		fixes := testQuickFixes(t, newServer(t), uri, `
+pub resource interface Receiver {
+    pub fun deposit(amount: Int)
+}
+
+pub resource Vault: Receiver {}
+`)
+
+		assert.Equal(t,
+			map[string]string{
+				"Add missing members": `
+pub resource interface Receiver {
+    pub fun deposit(amount: Int)
+}
+
+pub resource Vault: Receiver {
+    pub fun deposit(amount: Int) {
+        panic("TODO")
+    }
+}
+`,
+			},
+			fixes,
+		)
+	})
+
+	t.Run("import from workspace", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		fixes := testQuickFixes(t, server, pathToURI(filepath.Join(dir, "scripts", "vault.cdc")), `pub fun main(): @Foo.Vault {
+    return <- Foo.createVault()
+}
+`)
+
+		// The undeclared identifier could also be declared
+
+		assert.Contains(t, fixes, "Declare constant")
+
+		assert.Equal(t,
+			`import Foo from "../Foo.cdc"
+
+pub fun main(): @Foo.Vault {
+    return <- Foo.createVault()
+}
+`,
+			fixes[`Import `+"`Foo`"+` from "../Foo.cdc"`],
+		)
+	})
+
+	t.Run("import from address", func(t *testing.T) {
+
+		t.Parallel()
+
+		server := newServer(t)
+
+		err := server.SetOptions(
+			WithAddressImportResolver(func(location common.AddressLocation) (string, error) {
+				return `pub contract ` + location.Name + ` {}`, nil
+			}),
+			WithAddressContractNamesResolver(func(address common.Address) ([]string, error) {
+				return []string{"Bar", "Baz"}, nil
+			}),
+		)
+		require.NoError(t, err)
+
+		fixes := testQuickFixes(t, server, uri, `
+import Bar from 0x1
+
+pub fun main(): &Baz {
+    return &Baz as &Baz
+}
+`)
+
+		assert.Equal(t,
+			`
+import Bar from 0x1
+import Baz from 0x1
+
+pub fun main(): &Baz {
+    return &Baz as &Baz
+}
+`,
+			fixes["Import `Baz` from 0x1"],
+		)
+	})
+}
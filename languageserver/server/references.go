@@ -0,0 +1,780 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// symbol identifies a declaration across files:
+// by the location of the file declaring it, and its qualified identifier,
+// e.g. `FungibleToken.Vault.deposit`
+//
+type symbol struct {
+	location            common.Location
+	qualifiedIdentifier string
+}
+
+func (s symbol) identifier() string {
+	index := strings.LastIndexByte(s.qualifiedIdentifier, '.')
+	return s.qualifiedIdentifier[index+1:]
+}
+
+// symbolReference is an occurrence of a symbol in a file
+//
+type symbolReference struct {
+	symbol symbol
+	ast.Range
+	isDeclaration bool
+}
+
+// workspaceIndex provides information about the files of the workspace
+// which is needed to find references across files
+//
+type workspaceIndex struct {
+	files []*workspaceFile
+	// contractLocations are the locations of the files declaring contracts and contract interfaces, by name.
+	// They are used to resolve address imports to files of the workspace
+	contractLocations map[string]common.Location
+	// declarationNames are the names of the top-level declarations of each file
+	declarationNames map[common.Location]map[string]struct{}
+	// resolvePathLocation resolves path imports, like the checker's import handler
+	resolvePathLocation func(base, relative common.Location) common.Location
+}
+
+// newWorkspaceIndex returns an index of the current workspace files
+//
+func (s *Server) newWorkspaceIndex() *workspaceIndex {
+	s.runPendingChecks()
+	return newWorkspaceIndex(s.workspaceFiles(), s.resolvePathLocation)
+}
+
+func newWorkspaceIndex(
+	files []*workspaceFile,
+	resolvePathLocation func(base, relative common.Location) common.Location,
+) *workspaceIndex {
+	index := &workspaceIndex{
+		files:               files,
+		contractLocations:   map[string]common.Location{},
+		declarationNames:    map[common.Location]map[string]struct{}{},
+		resolvePathLocation: resolvePathLocation,
+	}
+
+	for _, file := range files {
+		if file.program == nil {
+			continue
+		}
+
+		names := map[string]struct{}{}
+		index.declarationNames[file.location] = names
+
+		declare := func(identifier ast.Identifier, isContract bool) {
+			names[identifier.Identifier] = struct{}{}
+
+			// NOTE: if multiple files declare a contract with the same name,
+			// the first file is used
+
+			if isContract {
+				if _, ok := index.contractLocations[identifier.Identifier]; !ok {
+					index.contractLocations[identifier.Identifier] = file.location
+				}
+			}
+		}
+
+		for _, declaration := range file.program.CompositeDeclarations() {
+			declare(
+				declaration.Identifier,
+				declaration.CompositeKind == common.CompositeKindContract,
+			)
+		}
+
+		for _, declaration := range file.program.InterfaceDeclarations() {
+			declare(
+				declaration.Identifier,
+				declaration.CompositeKind == common.CompositeKindContract,
+			)
+		}
+
+		for _, declaration := range file.program.FunctionDeclarations() {
+			declare(declaration.Identifier, false)
+		}
+
+		for _, declaration := range file.program.VariableDeclarations() {
+			declare(declaration.Identifier, false)
+		}
+	}
+
+	return index
+}
+
+// canonicalLocation returns the location of the workspace file for the given location:
+// An address location refers to the workspace file which declares the contract with the same name.
+// All other locations are returned unchanged
+//
+func (index *workspaceIndex) canonicalLocation(location common.Location) common.Location {
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return location
+	}
+
+	contractLocation, ok := index.contractLocations[addressLocation.Name]
+	if !ok {
+		return location
+	}
+
+	return contractLocation
+}
+
+// importLocation returns the canonical location of the given identifier
+// imported by the given import declaration of the given file.
+//
+// The identifier may be empty if the import declaration imports all declarations.
+// Address locations are resolved by the checker to one location per identifier,
+// so an address location without an identifier cannot be resolved to a workspace file
+//
+func (index *workspaceIndex) importLocation(
+	file *workspaceFile,
+	declaration *ast.ImportDeclaration,
+	identifier string,
+) common.Location {
+	switch location := declaration.Location.(type) {
+	case common.AddressLocation:
+		if location.Name == "" {
+			location.Name = identifier
+		}
+		return index.canonicalLocation(location)
+
+	default:
+		if isPathLocation(location) {
+			return index.resolvePathLocation(file.location, location)
+		}
+		return location
+	}
+}
+
+func (index *workspaceIndex) imports(file *workspaceFile, location common.Location) bool {
+	if file.program == nil {
+		return false
+	}
+
+	for _, declaration := range file.program.ImportDeclarations() {
+		if len(declaration.Identifiers) == 0 {
+			if index.importLocation(file, declaration, "") == location {
+				return true
+			}
+			continue
+		}
+
+		for _, identifier := range declaration.Identifiers {
+			if index.importLocation(file, declaration, identifier.Identifier) == location {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (index *workspaceIndex) file(uri protocol.DocumentUri) *workspaceFile {
+	for _, file := range index.files {
+		if file.uri == uri {
+			return file
+		}
+	}
+	return nil
+}
+
+// referenceCollector collects the references to symbols in a file
+//
+type referenceCollector struct {
+	index   *workspaceIndex
+	file    *workspaceFile
+	checker *sema.Checker
+	lines   []string
+	// declarations are the symbols declared in the file, by the position of their identifier
+	declarations map[sema.Position]symbol
+	// imports are the locations of the imported declarations, by name
+	imports    map[string]common.Location
+	seen       map[sema.Position]struct{}
+	references []symbolReference
+}
+
+// fileReferences returns all references to symbols which can be referred to from other files,
+// i.e. top-level declarations and members of composites and interfaces.
+// Local variables, parameters, etc. are not included
+//
+func (index *workspaceIndex) fileReferences(file *workspaceFile, checker *sema.Checker) []symbolReference {
+	if file.program == nil {
+		return nil
+	}
+
+	collector := &referenceCollector{
+		index:        index,
+		file:         file,
+		checker:      checker,
+		lines:        strings.Split(file.code, "\n"),
+		declarations: map[sema.Position]symbol{},
+		imports:      map[string]common.Location{},
+		seen:         map[sema.Position]struct{}{},
+	}
+
+	collector.collectDeclarations()
+	collector.collectImports()
+
+	if checker != nil {
+		collector.collectOccurrences()
+		collector.collectMemberExpressions()
+	}
+
+	references := collector.references
+
+	sort.Slice(references, func(i, j int) bool {
+		a := references[i].StartPos
+		b := references[j].StartPos
+		return a.Line < b.Line ||
+			(a.Line == b.Line && a.Column < b.Column)
+	})
+
+	return references
+}
+
+func (c *referenceCollector) add(reference symbolReference) {
+	position := sema.ASTToSemaPosition(reference.StartPos)
+	if _, ok := c.seen[position]; ok {
+		return
+	}
+	c.seen[position] = struct{}{}
+	c.references = append(c.references, reference)
+}
+
+func (c *referenceCollector) declare(identifier ast.Identifier, qualifiedIdentifier string) {
+	declaredSymbol := symbol{
+		location:            c.file.location,
+		qualifiedIdentifier: qualifiedIdentifier,
+	}
+
+	c.declarations[sema.ASTToSemaPosition(identifier.Pos)] = declaredSymbol
+
+	c.add(symbolReference{
+		symbol: declaredSymbol,
+		Range: ast.Range{
+			StartPos: identifier.StartPosition(),
+			EndPos:   identifier.EndPosition(),
+		},
+		isDeclaration: true,
+	})
+}
+
+func (c *referenceCollector) collectDeclarations() {
+	program := c.file.program
+
+	for _, declaration := range program.CompositeDeclarations() {
+		c.declareComposite(declaration, "")
+	}
+
+	for _, declaration := range program.InterfaceDeclarations() {
+		c.declareInterface(declaration, "")
+	}
+
+	for _, declaration := range program.FunctionDeclarations() {
+		c.declare(declaration.Identifier, declaration.Identifier.Identifier)
+	}
+
+	for _, declaration := range program.VariableDeclarations() {
+		c.declare(declaration.Identifier, declaration.Identifier.Identifier)
+	}
+}
+
+func qualifiedIdentifier(prefix string, identifier ast.Identifier) string {
+	if prefix == "" {
+		return identifier.Identifier
+	}
+	return prefix + "." + identifier.Identifier
+}
+
+func (c *referenceCollector) declareComposite(declaration *ast.CompositeDeclaration, prefix string) {
+	qualifiedIdentifier := qualifiedIdentifier(prefix, declaration.Identifier)
+	c.declare(declaration.Identifier, qualifiedIdentifier)
+	c.declareMembers(declaration.Members, qualifiedIdentifier)
+}
+
+func (c *referenceCollector) declareInterface(declaration *ast.InterfaceDeclaration, prefix string) {
+	qualifiedIdentifier := qualifiedIdentifier(prefix, declaration.Identifier)
+	c.declare(declaration.Identifier, qualifiedIdentifier)
+	c.declareMembers(declaration.Members, qualifiedIdentifier)
+}
+
+func (c *referenceCollector) declareMembers(members *ast.Members, prefix string) {
+	if members == nil {
+		return
+	}
+
+	for _, field := range members.Fields() {
+		c.declare(field.Identifier, qualifiedIdentifier(prefix, field.Identifier))
+	}
+
+	for _, function := range members.Functions() {
+		c.declare(function.Identifier, qualifiedIdentifier(prefix, function.Identifier))
+	}
+
+	for _, enumCase := range members.EnumCases() {
+		c.declare(enumCase.Identifier, qualifiedIdentifier(prefix, enumCase.Identifier))
+	}
+
+	for _, composite := range members.Composites() {
+		c.declareComposite(composite, prefix)
+	}
+
+	for _, nestedInterface := range members.Interfaces() {
+		c.declareInterface(nestedInterface, prefix)
+	}
+}
+
+// collectImports collects the imported identifiers of import declarations, e.g. `Foo` in `import Foo from 0x1`,
+// and the names imported by the file
+//
+func (c *referenceCollector) collectImports() {
+	for _, declaration := range c.file.program.ImportDeclarations() {
+
+		if len(declaration.Identifiers) == 0 {
+			location := c.index.importLocation(c.file, declaration, "")
+			for name := range c.index.declarationNames[location] {
+				c.imports[name] = location
+			}
+			continue
+		}
+
+		for _, identifier := range declaration.Identifiers {
+			location := c.index.importLocation(c.file, declaration, identifier.Identifier)
+			c.imports[identifier.Identifier] = location
+
+			c.add(symbolReference{
+				symbol: symbol{
+					location:            location,
+					qualifiedIdentifier: identifier.Identifier,
+				},
+				Range: ast.Range{
+					StartPos: identifier.StartPosition(),
+					EndPos:   identifier.EndPosition(),
+				},
+			})
+		}
+	}
+}
+
+// collectOccurrences collects the references of identifiers,
+// which either refer to declarations in the file, or to imported top-level declarations,
+// and the references of the nested types following them, e.g. `Vault` in `FungibleToken.Vault`
+//
+func (c *referenceCollector) collectOccurrences() {
+	for _, occurrence := range c.checker.Occurrences.All() {
+		origin := occurrence.Origin
+		if origin == nil || origin.StartPos == nil {
+			continue
+		}
+
+		name := c.text(occurrence.StartPos, occurrence.EndPos)
+		originPosition := sema.ASTToSemaPosition(*origin.StartPos)
+
+		referencedSymbol, ok := c.declarations[originPosition]
+		if !ok || referencedSymbol.identifier() != name {
+
+			// Imported declarations have no position
+
+			location, ok := c.imports[name]
+			if !ok || originPosition.Line != 0 {
+				continue
+			}
+
+			referencedSymbol = symbol{
+				location:            location,
+				qualifiedIdentifier: name,
+			}
+		}
+
+		c.add(symbolReference{
+			symbol: referencedSymbol,
+			Range: ast.Range{
+				StartPos: semaToASTPosition(occurrence.StartPos),
+				EndPos:   semaToASTPosition(occurrence.EndPos),
+			},
+		})
+
+		c.collectNestedTypes(origin.Type, occurrence.EndPos)
+	}
+}
+
+// collectNestedTypes collects the references of the nested types of the given type
+// which follow the given position, e.g. `Vault` in `FungibleToken.Vault`.
+//
+// Only the occurrence of the outermost type is recorded by the checker,
+// so the nested identifiers are found in the text of the file
+//
+func (c *referenceCollector) collectNestedTypes(ty sema.Type, endPos sema.Position) {
+	for {
+		containerType, ok := ty.(sema.ContainerType)
+		if !ok || containerType.GetNestedTypes() == nil {
+			return
+		}
+
+		identifier, startPos, identifierEndPos, ok := c.nestedIdentifier(endPos)
+		if !ok {
+			return
+		}
+
+		nestedType, ok := containerType.GetNestedTypes().Get(identifier)
+		if !ok {
+			return
+		}
+
+		var location common.Location
+		var qualifiedIdentifier string
+
+		switch nestedType := nestedType.(type) {
+		case *sema.CompositeType:
+			location = nestedType.Location
+			qualifiedIdentifier = nestedType.QualifiedIdentifier()
+
+		case *sema.InterfaceType:
+			location = nestedType.Location
+			qualifiedIdentifier = nestedType.QualifiedIdentifier()
+
+		default:
+			return
+		}
+
+		if location == nil {
+			return
+		}
+
+		c.add(symbolReference{
+			symbol: symbol{
+				location:            c.index.canonicalLocation(location),
+				qualifiedIdentifier: qualifiedIdentifier,
+			},
+			Range: ast.Range{
+				StartPos: semaToASTPosition(startPos),
+				EndPos:   semaToASTPosition(identifierEndPos),
+			},
+		})
+
+		ty = nestedType
+		endPos = identifierEndPos
+	}
+}
+
+// nestedIdentifier returns the identifier following a dot directly after the given position,
+// e.g. `Vault` after `FungibleToken` in `FungibleToken.Vault`
+//
+func (c *referenceCollector) nestedIdentifier(endPos sema.Position) (
+	identifier string,
+	startPos sema.Position,
+	identifierEndPos sema.Position,
+	ok bool,
+) {
+	if endPos.Line < 1 || endPos.Line > len(c.lines) {
+		return
+	}
+
+	line := []rune(c.lines[endPos.Line-1])
+
+	column := endPos.Column + 1
+
+	if column >= len(line) || line[column] != '.' {
+		return
+	}
+	column++
+
+	startColumn := column
+	for column < len(line) &&
+		(line[column] == '_' || unicode.IsLetter(line[column]) || unicode.IsDigit(line[column])) {
+
+		column++
+	}
+	if column == startColumn {
+		return
+	}
+
+	startPos = sema.Position{Line: endPos.Line, Column: startColumn}
+	identifierEndPos = sema.Position{Line: endPos.Line, Column: column - 1}
+
+	return string(line[startColumn:column]), startPos, identifierEndPos, true
+}
+
+// collectMemberExpressions collects the references of members of composites and interfaces,
+// e.g. `deposit` in `vault.deposit(from: <-tokens)`
+//
+func (c *referenceCollector) collectMemberExpressions() {
+	for expression, memberInfo := range c.checker.Elaboration.MemberExpressionMemberInfos {
+		member := memberInfo.Member
+		if member == nil {
+			continue
+		}
+
+		var location common.Location
+		var containerIdentifier string
+
+		switch containerType := member.ContainerType.(type) {
+		case *sema.CompositeType:
+			location = containerType.Location
+			containerIdentifier = containerType.QualifiedIdentifier()
+
+		case *sema.InterfaceType:
+			location = containerType.Location
+			containerIdentifier = containerType.QualifiedIdentifier()
+		}
+
+		if location == nil {
+			continue
+		}
+
+		c.add(symbolReference{
+			symbol: symbol{
+				location:            c.index.canonicalLocation(location),
+				qualifiedIdentifier: containerIdentifier + "." + member.Identifier.Identifier,
+			},
+			Range: ast.Range{
+				StartPos: expression.Identifier.StartPosition(),
+				EndPos:   expression.Identifier.EndPosition(),
+			},
+		})
+	}
+}
+
+// text returns the text of the file in the given range on a single line
+//
+func (c *referenceCollector) text(startPos, endPos sema.Position) string {
+	if startPos.Line != endPos.Line ||
+		startPos.Line < 1 ||
+		startPos.Line > len(c.lines) {
+
+		return ""
+	}
+
+	line := []rune(c.lines[startPos.Line-1])
+	if startPos.Column < 0 ||
+		endPos.Column >= len(line) ||
+		startPos.Column > endPos.Column {
+
+		return ""
+	}
+
+	return string(line[startPos.Column : endPos.Column+1])
+}
+
+func semaToASTPosition(position sema.Position) ast.Position {
+	return ast.Position{
+		Line:   position.Line,
+		Column: position.Column,
+	}
+}
+
+// symbolAt returns the symbol referred to at the given position.
+// The position may also be directly after the identifier
+//
+func symbolAt(references []symbolReference, position sema.Position) (symbol, bool) {
+	for _, reference := range references {
+		if reference.StartPos.Line == position.Line &&
+			reference.StartPos.Column <= position.Column &&
+			position.Column <= reference.EndPos.Column+1 {
+
+			return reference.symbol, true
+		}
+	}
+
+	return symbol{}, false
+}
+
+// fileChecker returns the checker for the given workspace file.
+// Open documents are already checked, all other files are checked
+//
+func (s *Server) fileChecker(file *workspaceFile) *sema.Checker {
+	if _, ok := s.documents[file.uri]; ok {
+		if checker := s.checkerForDocument(file.uri); checker != nil {
+			return checker
+		}
+	}
+
+	if file.program == nil {
+		return nil
+	}
+
+	checker, err := s.newChecker(file.program, file.location)
+	if err != nil {
+		return nil
+	}
+
+	// NOTE: the file might be invalid, e.g. while it is being edited,
+	// but the references in it are still useful
+	_ = checker.Check()
+
+	return checker
+}
+
+// workspaceReferences returns the references to the symbol at the given position of the given document,
+// in all workspace files which may refer to it:
+// the file declaring the symbol, and all files importing the file declaring the symbol,
+// either through a path import, or through an address import of the declared contract.
+//
+// It returns false if there is no symbol at the given position
+// which can be referred to from other files, e.g. a local variable.
+//
+func (s *Server) workspaceReferences(
+	uri protocol.DocumentUri,
+	position protocol.Position,
+) (
+	references map[protocol.DocumentUri][]symbolReference,
+	ok bool,
+) {
+	index := s.newWorkspaceIndex()
+
+	currentFile := index.file(uri)
+	if currentFile == nil {
+		return nil, false
+	}
+
+	currentChecker := s.fileChecker(currentFile)
+
+	referencedSymbol, ok := symbolAt(
+		index.fileReferences(currentFile, currentChecker),
+		conversion.ProtocolToSemaPosition(position),
+	)
+	if !ok {
+		return nil, false
+	}
+
+	references = map[protocol.DocumentUri][]symbolReference{}
+
+	for _, file := range index.files {
+		if file.location != referencedSymbol.location &&
+			!index.imports(file, referencedSymbol.location) {
+
+			continue
+		}
+
+		checker := currentChecker
+		if file != currentFile {
+			checker = s.fileChecker(file)
+		}
+
+		for _, reference := range index.fileReferences(file, checker) {
+			if reference.symbol != referencedSymbol {
+				continue
+			}
+			references[file.uri] = append(references[file.uri], reference)
+		}
+	}
+
+	return references, true
+}
+
+// localOccurrences returns the ranges of all occurrences of the variable at the given position of the given document
+//
+func localOccurrences(checker *sema.Checker, protocolPosition protocol.Position) []ast.Range {
+	position := conversion.ProtocolToSemaPosition(protocolPosition)
+	occurrences := checker.Occurrences.FindAll(position)
+	// If there are no occurrences,
+	// then try the preceding position
+	if len(occurrences) == 0 && position.Column > 0 {
+		previousPosition := position
+		previousPosition.Column -= 1
+		occurrences = checker.Occurrences.FindAll(previousPosition)
+	}
+
+	var ranges []ast.Range
+
+	for _, occurrence := range occurrences {
+
+		origin := occurrence.Origin
+		if origin == nil || origin.StartPos == nil || origin.EndPos == nil {
+			continue
+		}
+
+		ranges = append(ranges, origin.Occurrences...)
+	}
+
+	return ranges
+}
+
+func sortedReferenceURIs(references map[protocol.DocumentUri][]symbolReference) []protocol.DocumentUri {
+	uris := make([]protocol.DocumentUri, 0, len(references))
+	for uri := range references {
+		uris = append(uris, uri)
+	}
+	sort.Slice(uris, func(i, j int) bool {
+		return uris[i] < uris[j]
+	})
+	return uris
+}
+
+// workspaceRenameEdit returns the edit which renames all given references.
+// Symbols which are not declared in the workspace cannot be renamed,
+// as the declaration would not be renamed
+//
+func workspaceRenameEdit(
+	references map[protocol.DocumentUri][]symbolReference,
+	newName string,
+) (
+	*protocol.WorkspaceEdit,
+	error,
+) {
+	declared := false
+
+	changes := map[string][]protocol.TextEdit{}
+
+	for uri, fileReferences := range references {
+		textEdits := make([]protocol.TextEdit, 0, len(fileReferences))
+
+		for _, reference := range fileReferences {
+			if reference.isDeclaration {
+				declared = true
+			}
+
+			textEdits = append(textEdits,
+				protocol.TextEdit{
+					Range: conversion.ASTToProtocolRange(
+						reference.StartPos,
+						reference.EndPos,
+					),
+					NewText: newName,
+				},
+			)
+		}
+
+		changes[string(uri)] = textEdits
+	}
+
+	if !declared {
+		return nil, fmt.Errorf("cannot rename symbol which is not declared in the workspace")
+	}
+
+	return &protocol.WorkspaceEdit{
+		Changes: &changes,
+	}, nil
+}
@@ -0,0 +1,455 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+type testConn struct{}
+
+var _ protocol.Conn = testConn{}
+
+func (testConn) Notify(_ string, _ interface{}) error {
+	return nil
+}
+
+func (testConn) ShowMessage(_ *protocol.ShowMessageParams) {}
+
+func (testConn) LogMessage(_ *protocol.LogMessageParams) {}
+
+func (testConn) PublishDiagnostics(_ *protocol.PublishDiagnosticsParams) error {
+	return nil
+}
+
+func (testConn) RegisterCapability(_ *protocol.RegistrationParams) error {
+	return nil
+}
+
+const testFooContract = `
+pub contract Foo {
+
+    pub resource Vault {
+        pub var balance: UFix64
+
+        init() {
+            self.balance = 0.0
+        }
+
+        pub fun deposit(amount: UFix64) {
+            self.balance = self.balance + amount
+        }
+    }
+
+    pub fun createVault(): @Vault {
+        return <- create Vault()
+    }
+}
+`
+
+const testFooTransaction = `
+import Foo from "../Foo.cdc"
+
+transaction {
+    prepare() {
+        let vault <- Foo.createVault()
+        vault.deposit(amount: 1.0)
+        destroy vault
+    }
+}
+`
+
+const testFooScript = `
+import Foo from 0x1
+
+pub fun main(): UFix64 {
+    let vault <- Foo.createVault()
+    let balance = vault.balance
+    destroy vault
+    return balance
+}
+`
+
+const testUnrelatedScript = `
+pub fun createVault() {}
+
+pub fun main() {
+    createVault()
+}
+`
+
+// newTestWorkspaceServer returns a server for a workspace with test files.
+// The caller is responsible for removing the returned workspace folder
+//
+func newTestWorkspaceServer(t *testing.T) (server *Server, dir string) {
+
+	dir, err := ioutil.TempDir("", "cadence-languageserver-test")
+	require.NoError(t, err)
+
+	files := map[string]string{
+		"Foo.cdc":                  testFooContract,
+		"transactions/deposit.cdc": testFooTransaction,
+		"scripts/balance.cdc":      testFooScript,
+		"unrelated.cdc":            testUnrelatedScript,
+	}
+
+	for name, code := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, ioutil.WriteFile(path, []byte(code), 0600))
+	}
+
+	server, err = NewServer()
+	require.NoError(t, err)
+
+	err = server.SetOptions(
+		WithStringImportResolver(func(location common.StringLocation) (string, error) {
+			data, err := ioutil.ReadFile(string(location))
+			return string(data), err
+		}),
+		WithAddressImportResolver(func(location common.AddressLocation) (string, error) {
+			return testFooContract, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	server.workspaceFolders = []string{dir}
+
+	return server, dir
+}
+
+// testLocations returns the given locations as strings
+// of the form `path:line:character`, with the path relative to the given folder
+//
+func testLocations(dir string, locations []*protocol.Location) []string {
+	result := make([]string, len(locations))
+	for i, location := range locations {
+		result[i] = testLocation(dir, location.URI, location.Range)
+	}
+	return result
+}
+
+func testLocation(dir string, uri protocol.DocumentUri, r protocol.Range) string {
+	path := strings.TrimPrefix(string(uri), filePrefix+dir+"/")
+	return fmt.Sprintf("%s:%d:%d", path, int(r.Start.Line), int(r.Start.Character))
+}
+
+// testEdits returns the given edits as sorted strings
+// of the form `path:line:character`, with the path relative to the given folder
+//
+func testEdits(dir string, edit *protocol.WorkspaceEdit, newName string) []string {
+	var result []string
+	for uri, textEdits := range *edit.Changes {
+		for _, textEdit := range textEdits {
+			if textEdit.NewText != newName {
+				continue
+			}
+			result = append(result, testLocation(dir, protocol.DocumentUri(uri), textEdit.Range))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func openTestDocument(t *testing.T, server *Server, uri protocol.DocumentUri) {
+	data, err := ioutil.ReadFile(strings.TrimPrefix(string(uri), filePrefix))
+	require.NoError(t, err)
+
+	err = server.DidOpenTextDocument(
+		testConn{},
+		&protocol.DidOpenTextDocumentParams{
+			TextDocument: protocol.TextDocumentItem{
+				URI:  uri,
+				Text: string(data),
+			},
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestReferences(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("member across files", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "transactions/deposit.cdc"))
+		openTestDocument(t, server, uri)
+
+		params := &protocol.ReferenceParams{
+			TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Position:     protocol.Position{Line: 5, Character: 27},
+			},
+			Context: protocol.ReferenceContext{
+				IncludeDeclaration: true,
+			},
+		}
+
+		locations, err := server.References(testConn{}, params)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.cdc:15:12",
+				"scripts/balance.cdc:4:21",
+				"transactions/deposit.cdc:5:25",
+			},
+			testLocations(dir, locations),
+		)
+
+		params.Context.IncludeDeclaration = false
+
+		locations, err = server.References(testConn{}, params)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"scripts/balance.cdc:4:21",
+				"transactions/deposit.cdc:5:25",
+			},
+			testLocations(dir, locations),
+		)
+	})
+
+	t.Run("local variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "transactions/deposit.cdc"))
+		openTestDocument(t, server, uri)
+
+		locations, err := server.References(
+			testConn{},
+			&protocol.ReferenceParams{
+				TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+					TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+					Position:     protocol.Position{Line: 6, Character: 10},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"transactions/deposit.cdc:5:12",
+				"transactions/deposit.cdc:6:8",
+				"transactions/deposit.cdc:7:16",
+			},
+			testLocations(dir, locations),
+		)
+	})
+
+	t.Run("unrelated declaration with same name", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "unrelated.cdc"))
+		openTestDocument(t, server, uri)
+
+		locations, err := server.References(
+			testConn{},
+			&protocol.ReferenceParams{
+				TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+					TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+					Position:     protocol.Position{Line: 4, Character: 6},
+				},
+				Context: protocol.ReferenceContext{
+					IncludeDeclaration: true,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"unrelated.cdc:1:8",
+				"unrelated.cdc:4:4",
+			},
+			testLocations(dir, locations),
+		)
+	})
+}
+
+func TestWorkspaceRename(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("field from declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "Foo.cdc"))
+		openTestDocument(t, server, uri)
+
+		edit, err := server.Rename(
+			testConn{},
+			&protocol.RenameParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Position:     protocol.Position{Line: 4, Character: 18},
+				NewName:      "amount",
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.cdc:11:17",
+				"Foo.cdc:11:32",
+				"Foo.cdc:4:16",
+				"Foo.cdc:7:17",
+				"scripts/balance.cdc:5:24",
+			},
+			testEdits(dir, edit, "amount"),
+		)
+	})
+
+	t.Run("contract from address import", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "scripts/balance.cdc"))
+		openTestDocument(t, server, uri)
+
+		edit, err := server.Rename(
+			testConn{},
+			&protocol.RenameParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Position:     protocol.Position{Line: 1, Character: 8},
+				NewName:      "Bar",
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.cdc:1:13",
+				"scripts/balance.cdc:1:7",
+				"scripts/balance.cdc:4:17",
+				"transactions/deposit.cdc:1:7",
+				"transactions/deposit.cdc:5:21",
+			},
+			testEdits(dir, edit, "Bar"),
+		)
+	})
+
+	t.Run("nested type", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		err := ioutil.WriteFile(
+			filepath.Join(dir, "scripts/vault.cdc"),
+			[]byte(`
+import Foo from "../Foo.cdc"
+
+pub fun main(): UFix64 {
+    let vault: @Foo.Vault <- Foo.createVault()
+    let ref: &Foo.Vault = &vault as &Foo.Vault
+    let balance = ref.balance
+    destroy vault
+    return balance
+}
+`),
+			0600,
+		)
+		require.NoError(t, err)
+
+		uri := pathToURI(filepath.Join(dir, "Foo.cdc"))
+		openTestDocument(t, server, uri)
+
+		edit, err := server.Rename(
+			testConn{},
+			&protocol.RenameParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Position:     protocol.Position{Line: 3, Character: 19},
+				NewName:      "Safe",
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.cdc:15:28",
+				"Foo.cdc:16:25",
+				"Foo.cdc:3:17",
+				"scripts/vault.cdc:4:20",
+				"scripts/vault.cdc:5:18",
+				"scripts/vault.cdc:5:41",
+			},
+			testEdits(dir, edit, "Safe"),
+		)
+	})
+
+	t.Run("symbol not declared in workspace", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		err := os.Remove(filepath.Join(dir, "Foo.cdc"))
+		require.NoError(t, err)
+
+		uri := pathToURI(filepath.Join(dir, "scripts/balance.cdc"))
+		openTestDocument(t, server, uri)
+
+		_, err = server.Rename(
+			testConn{},
+			&protocol.RenameParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Position:     protocol.Position{Line: 4, Character: 22},
+				NewName:      "create",
+			},
+		)
+		require.Error(t, err)
+	})
+}
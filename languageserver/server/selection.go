@@ -0,0 +1,166 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser2"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// positionInRange returns true if the given position is in the given range,
+// or directly after it, i.e. a cursor at the end of the range is considered in the range
+//
+func positionInRange(position ast.Position, r ast.HasPosition) bool {
+	startPos := r.StartPosition()
+	endPos := r.EndPosition()
+
+	if position.Line < startPos.Line ||
+		(position.Line == startPos.Line && position.Column < startPos.Column) {
+
+		return false
+	}
+
+	return position.Line < endPos.Line ||
+		(position.Line == endPos.Line && position.Column <= endPos.Column+1)
+}
+
+// selectionRangeCollector collects the nested ranges which contain a position, from the outermost to the innermost
+//
+type selectionRangeCollector struct {
+	position ast.Position
+	ranges   []ast.Range
+}
+
+// add adds the given range if it contains the position.
+// Ranges which are equal to or not contained in the previous range are ignored
+//
+func (c *selectionRangeCollector) add(r ast.HasPosition) bool {
+	if !positionInRange(c.position, r) {
+		return false
+	}
+
+	newRange := ast.NewRangeFromPositioned(r)
+
+	if len(c.ranges) > 0 {
+		previous := c.ranges[len(c.ranges)-1]
+		if newRange == previous ||
+			!positionInRange(newRange.StartPos, previous) ||
+			!positionInRange(newRange.EndPos, previous) {
+
+			return true
+		}
+	}
+
+	c.ranges = append(c.ranges, newRange)
+
+	return true
+}
+
+func (c *selectionRangeCollector) collect(program *ast.Program, tokens codeTokens) {
+	for _, declaration := range program.Declarations() {
+		walkElements(declaration, func(element ast.Element) bool {
+			if !c.add(element) {
+				return false
+			}
+
+			for _, r := range tokens.conditionsRanges(element) {
+				c.add(r)
+			}
+
+			if declaration, ok := element.(ast.Declaration); ok {
+				if identifier := declaration.DeclarationIdentifier(); identifier != nil {
+					c.add(identifier)
+				}
+			}
+
+			return true
+		})
+	}
+
+	for _, comment := range tokens.comments {
+		c.add(comment)
+	}
+}
+
+// selectionRange returns the nested ranges of the given program which contain the given position,
+// linked from the innermost to the outermost
+//
+func selectionRange(program *ast.Program, tokens codeTokens, position protocol.Position) *protocol.SelectionRange {
+	collector := &selectionRangeCollector{
+		position: ast.Position{
+			// AST lines are 1-based, protocol lines are 0-based
+			Line:   int(position.Line) + 1,
+			Column: int(position.Character),
+		},
+	}
+
+	if program != nil {
+		collector.collect(program, tokens)
+	}
+
+	// If the position is not in any range, the position itself is the only range
+
+	result := &protocol.SelectionRange{
+		Range: protocol.Range{
+			Start: position,
+			End:   position,
+		},
+	}
+
+	for i, r := range collector.ranges {
+		selectionRange := &protocol.SelectionRange{
+			Range: conversion.ASTToProtocolRange(r.StartPos, r.EndPos),
+		}
+		if i > 0 {
+			selectionRange.Parent = result
+		}
+		result = selectionRange
+	}
+
+	return result
+}
+
+// SelectionRange returns the selection ranges for the given positions of the document:
+// For each position, the nested ranges of declarations, statements, expressions,
+// conditions, and comments which contain the position
+//
+func (s *Server) SelectionRange(
+	_ protocol.Conn,
+	params *protocol.SelectionRangeParams,
+) (
+	[]*protocol.SelectionRange,
+	error,
+) {
+	document := s.documents[params.TextDocument.URI]
+
+	tokens := lexCodeTokens(document.Text)
+
+	// NOTE: the program might be partial if there are syntax errors
+	program, _ := parser2.ParseProgram(document.Text)
+
+	ranges := make([]*protocol.SelectionRange, len(params.Positions))
+	for i, position := range params.Positions {
+		ranges[i] = selectionRange(program, tokens, position)
+	}
+
+	return ranges, nil
+}
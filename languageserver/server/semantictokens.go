@@ -0,0 +1,520 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// semanticTokenType is the index of a token type in the legend
+//
+type semanticTokenType uint32
+
+const (
+	semanticTokenTypeNamespace semanticTokenType = iota
+	semanticTokenTypeType
+	semanticTokenTypeClass
+	semanticTokenTypeStruct
+	semanticTokenTypeInterface
+	semanticTokenTypeEnum
+	semanticTokenTypeEnumMember
+	semanticTokenTypeEvent
+	semanticTokenTypeFunction
+	semanticTokenTypeMethod
+	semanticTokenTypeProperty
+	semanticTokenTypeParameter
+	semanticTokenTypeVariable
+)
+
+var semanticTokenTypeNames = []string{
+	semanticTokenTypeNamespace:  "namespace",
+	semanticTokenTypeType:       "type",
+	semanticTokenTypeClass:      "class",
+	semanticTokenTypeStruct:     "struct",
+	semanticTokenTypeInterface:  "interface",
+	semanticTokenTypeEnum:       "enum",
+	semanticTokenTypeEnumMember: "enumMember",
+	semanticTokenTypeEvent:      "event",
+	semanticTokenTypeFunction:   "function",
+	semanticTokenTypeMethod:     "method",
+	semanticTokenTypeProperty:   "property",
+	semanticTokenTypeParameter:  "parameter",
+	semanticTokenTypeVariable:   "variable",
+}
+
+// semanticTokenModifier is the index of a token modifier in the legend
+//
+type semanticTokenModifier uint32
+
+const (
+	// semanticTokenModifierDeclaration marks the declaration of a symbol, as opposed to a reference
+	semanticTokenModifierDeclaration semanticTokenModifier = iota
+	// semanticTokenModifierReadonly marks constants
+	semanticTokenModifierReadonly
+	// semanticTokenModifierResource marks resource types and values
+	semanticTokenModifierResource
+	// semanticTokenModifierCapability marks capability values
+	semanticTokenModifierCapability
+	// semanticTokenModifierPath marks path values
+	semanticTokenModifierPath
+	// semanticTokenModifierPublic marks declarations with `pub` or `pub(set)` access
+	semanticTokenModifierPublic
+	// semanticTokenModifierPrivate marks declarations with `priv` or `access(self)` access
+	semanticTokenModifierPrivate
+	// semanticTokenModifierContract marks declarations with `access(contract)` access
+	semanticTokenModifierContract
+	// semanticTokenModifierAccount marks declarations with `access(account)` access
+	semanticTokenModifierAccount
+)
+
+var semanticTokenModifierNames = []string{
+	semanticTokenModifierDeclaration: "declaration",
+	semanticTokenModifierReadonly:    "readonly",
+	semanticTokenModifierResource:    "resource",
+	semanticTokenModifierCapability:  "capability",
+	semanticTokenModifierPath:        "path",
+	semanticTokenModifierPublic:      "public",
+	semanticTokenModifierPrivate:     "private",
+	semanticTokenModifierContract:    "contract",
+	semanticTokenModifierAccount:     "account",
+}
+
+var semanticTokensLegend = protocol.SemanticTokensLegend{
+	TokenTypes:     semanticTokenTypeNames,
+	TokenModifiers: semanticTokenModifierNames,
+}
+
+type semanticTokenModifiers uint32
+
+func (m *semanticTokenModifiers) add(modifier semanticTokenModifier) {
+	*m |= 1 << modifier
+}
+
+// semanticToken is a token on a single line. Columns are in characters, not in UTF-16 code units
+//
+type semanticToken struct {
+	line        int
+	startColumn int
+	endColumn   int
+	tokenType   semanticTokenType
+	modifiers   semanticTokenModifiers
+}
+
+// semanticTokenTypeForDeclarationKind returns the token type for the given declaration kind,
+// and false if identifiers of the declaration kind are not highlighted semantically
+//
+func semanticTokenTypeForDeclarationKind(kind common.DeclarationKind, isMember bool) (semanticTokenType, bool) {
+	switch kind {
+	case common.DeclarationKindContract:
+		return semanticTokenTypeNamespace, true
+
+	case common.DeclarationKindResource:
+		return semanticTokenTypeClass, true
+
+	case common.DeclarationKindStructure:
+		return semanticTokenTypeStruct, true
+
+	case common.DeclarationKindStructureInterface,
+		common.DeclarationKindResourceInterface,
+		common.DeclarationKindContractInterface:
+		return semanticTokenTypeInterface, true
+
+	case common.DeclarationKindEvent:
+		return semanticTokenTypeEvent, true
+
+	case common.DeclarationKindEnum:
+		return semanticTokenTypeEnum, true
+
+	case common.DeclarationKindEnumCase:
+		return semanticTokenTypeEnumMember, true
+
+	case common.DeclarationKindFunction:
+		if isMember {
+			return semanticTokenTypeMethod, true
+		}
+		return semanticTokenTypeFunction, true
+
+	case common.DeclarationKindField:
+		return semanticTokenTypeProperty, true
+
+	case common.DeclarationKindParameter:
+		return semanticTokenTypeParameter, true
+
+	case common.DeclarationKindConstant,
+		common.DeclarationKindVariable:
+		return semanticTokenTypeVariable, true
+
+	case common.DeclarationKindType:
+		return semanticTokenTypeType, true
+	}
+
+	return 0, false
+}
+
+// addTypeModifiers adds the modifiers for values of the given type:
+// resources, capabilities, and paths
+//
+func (m *semanticTokenModifiers) addTypeModifiers(ty sema.Type, kind common.DeclarationKind) {
+	if ty == nil {
+		return
+	}
+
+	switch kind {
+	case common.DeclarationKindResource,
+		common.DeclarationKindResourceInterface:
+
+		m.add(semanticTokenModifierResource)
+		return
+
+	case common.DeclarationKindFunction:
+		// The type of a function is a function type, never a resource, capability, or path
+		return
+	}
+
+	if ty.IsResourceType() {
+		m.add(semanticTokenModifierResource)
+	}
+
+	if optionalType, ok := ty.(*sema.OptionalType); ok {
+		ty = optionalType.Type
+	}
+
+	switch ty {
+	case sema.PathType,
+		sema.StoragePathType,
+		sema.CapabilityPathType,
+		sema.PublicPathType,
+		sema.PrivatePathType:
+
+		m.add(semanticTokenModifierPath)
+	}
+
+	if _, ok := ty.(*sema.CapabilityType); ok {
+		m.add(semanticTokenModifierCapability)
+	}
+}
+
+func (m *semanticTokenModifiers) addAccessModifier(access ast.Access) {
+	switch access {
+	case ast.AccessPublic, ast.AccessPublicSettable:
+		m.add(semanticTokenModifierPublic)
+	case ast.AccessPrivate:
+		m.add(semanticTokenModifierPrivate)
+	case ast.AccessContract:
+		m.add(semanticTokenModifierContract)
+	case ast.AccessAccount:
+		m.add(semanticTokenModifierAccount)
+	}
+}
+
+// memberDeclaration is the information about a declaration which is not available in its origin
+//
+type memberDeclaration struct {
+	access       ast.Access
+	variableKind ast.VariableKind
+	isMember     bool
+}
+
+// memberDeclarations returns the access, variable kind, and member-ness
+// of the declarations in the given program, by the position of their identifier
+//
+func memberDeclarations(program *ast.Program) map[sema.Position]memberDeclaration {
+	declarations := map[sema.Position]memberDeclaration{}
+
+	var addDeclaration func(declaration ast.Declaration, isMember bool)
+	addDeclaration = func(declaration ast.Declaration, isMember bool) {
+		identifier := declaration.DeclarationIdentifier()
+		if identifier == nil {
+			return
+		}
+
+		info := memberDeclaration{
+			access:   declaration.DeclarationAccess(),
+			isMember: isMember,
+		}
+
+		switch declaration := declaration.(type) {
+		case *ast.FieldDeclaration:
+			info.variableKind = declaration.VariableKind
+		case *ast.VariableDeclaration:
+			if declaration.IsConstant {
+				info.variableKind = ast.VariableKindConstant
+			} else {
+				info.variableKind = ast.VariableKindVariable
+			}
+		}
+
+		declarations[sema.ASTToSemaPosition(identifier.Pos)] = info
+
+		members := declaration.DeclarationMembers()
+		if members == nil {
+			return
+		}
+
+		for _, member := range members.Declarations() {
+			addDeclaration(member, true)
+		}
+	}
+
+	for _, declaration := range program.Declarations() {
+		addDeclaration(declaration, false)
+	}
+
+	return declarations
+}
+
+// semanticTokens returns the semantic tokens of the given checked program, sorted by position.
+//
+// Identifiers are classified based on the checker's occurrences, which refer to the origin of the identifier,
+// and based on the member information of the elaboration, which also covers members of imported types
+//
+func semanticTokens(checker *sema.Checker) []semanticToken {
+	tokens := map[sema.Position]semanticToken{}
+
+	declarations := memberDeclarations(checker.Program)
+
+	for _, occurrence := range checker.Occurrences.All() {
+		origin := occurrence.Origin
+		if origin == nil ||
+			occurrence.StartPos.Line != occurrence.EndPos.Line {
+
+			continue
+		}
+
+		var declaration memberDeclaration
+		if origin.StartPos != nil {
+			declaration = declarations[sema.ASTToSemaPosition(*origin.StartPos)]
+		}
+
+		tokenType, ok := semanticTokenTypeForDeclarationKind(origin.DeclarationKind, declaration.isMember)
+		if !ok {
+			continue
+		}
+
+		var modifiers semanticTokenModifiers
+
+		if origin.StartPos != nil &&
+			sema.ASTToSemaPosition(*origin.StartPos) == occurrence.StartPos {
+
+			modifiers.add(semanticTokenModifierDeclaration)
+		}
+
+		if origin.DeclarationKind == common.DeclarationKindConstant ||
+			declaration.variableKind == ast.VariableKindConstant {
+
+			modifiers.add(semanticTokenModifierReadonly)
+		}
+
+		modifiers.addTypeModifiers(origin.Type, origin.DeclarationKind)
+		modifiers.addAccessModifier(declaration.access)
+
+		tokens[occurrence.StartPos] = semanticToken{
+			line:        occurrence.StartPos.Line,
+			startColumn: occurrence.StartPos.Column,
+			endColumn:   occurrence.EndPos.Column,
+			tokenType:   tokenType,
+			modifiers:   modifiers,
+		}
+	}
+
+	for expression, memberInfo := range checker.Elaboration.MemberExpressionMemberInfos {
+		member := memberInfo.Member
+		if member == nil {
+			continue
+		}
+
+		tokenType, ok := semanticTokenTypeForDeclarationKind(member.DeclarationKind, true)
+		if !ok {
+			continue
+		}
+
+		var modifiers semanticTokenModifiers
+
+		if member.DeclarationKind == common.DeclarationKindField &&
+			member.VariableKind == ast.VariableKindConstant {
+
+			modifiers.add(semanticTokenModifierReadonly)
+		}
+
+		if member.TypeAnnotation != nil {
+			modifiers.addTypeModifiers(member.TypeAnnotation.Type, member.DeclarationKind)
+		}
+		modifiers.addAccessModifier(member.Access)
+
+		startPos := expression.Identifier.StartPosition()
+		endPos := expression.Identifier.EndPosition()
+
+		position := sema.ASTToSemaPosition(startPos)
+
+		tokens[position] = semanticToken{
+			line:        startPos.Line,
+			startColumn: startPos.Column,
+			endColumn:   endPos.Column,
+			tokenType:   tokenType,
+			modifiers:   modifiers,
+		}
+	}
+
+	result := make([]semanticToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, token)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		return a.line < b.line ||
+			(a.line == b.line && a.startColumn < b.startColumn)
+	})
+
+	return result
+}
+
+// encodeSemanticTokens encodes the given tokens of the given text, relative to each other.
+// Positions and lengths are converted from characters to UTF-16 code units
+//
+func encodeSemanticTokens(tokens []semanticToken, text string) []uint32 {
+	lines := strings.Split(text, "\n")
+
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later is serialized as null, which is not a valid result
+	data := make([]uint32, 0, len(tokens)*5)
+
+	previousLine := 0
+	previousStart := 0
+
+	for _, token := range tokens {
+		if token.line < 1 || token.line > len(lines) {
+			continue
+		}
+
+		// lines are 1-based, protocol lines are 0-based
+		line := token.line - 1
+		lineText := lines[line]
+
+		start := utf16Column(lineText, token.startColumn)
+		end := utf16Column(lineText, token.endColumn+1)
+
+		deltaLine := line - previousLine
+		deltaStart := start
+		if deltaLine == 0 {
+			deltaStart = start - previousStart
+		}
+
+		data = append(data,
+			uint32(deltaLine),
+			uint32(deltaStart),
+			uint32(end-start),
+			uint32(token.tokenType),
+			uint32(token.modifiers),
+		)
+
+		previousLine = line
+		previousStart = start
+	}
+
+	return data
+}
+
+// utf16Column returns the UTF-16 column of the given character column in the given line
+//
+func utf16Column(line string, column int) int {
+	result := 0
+	for _, r := range line {
+		if column <= 0 {
+			break
+		}
+		if utf8.RuneLen(r) == 4 {
+			// characters outside of the basic multilingual plane are encoded as surrogate pairs
+			result += 2
+		} else {
+			result++
+		}
+		column--
+	}
+	return result + column
+}
+
+func (s *Server) semanticTokens(uri protocol.DocumentUri, tokenRange *protocol.Range) *protocol.SemanticTokens {
+	result := &protocol.SemanticTokens{
+		Data: []uint32{},
+	}
+
+	document, ok := s.documents[uri]
+	if !ok {
+		return result
+	}
+
+	checker := s.checkerForDocument(uri)
+	if checker == nil {
+		return result
+	}
+
+	tokens := semanticTokens(checker)
+
+	if tokenRange != nil {
+		// Both token lines and range lines are inclusive,
+		// token lines are 1-based and range lines are 0-based
+		startLine := int(tokenRange.Start.Line) + 1
+		endLine := int(tokenRange.End.Line) + 1
+
+		filtered := tokens[:0]
+		for _, token := range tokens {
+			if token.line >= startLine && token.line <= endLine {
+				filtered = append(filtered, token)
+			}
+		}
+		tokens = filtered
+	}
+
+	result.Data = encodeSemanticTokens(tokens, document.Text)
+
+	return result
+}
+
+// SemanticTokensFull returns the semantic tokens of the whole document
+//
+func (s *Server) SemanticTokensFull(
+	_ protocol.Conn,
+	params *protocol.SemanticTokensParams,
+) (
+	*protocol.SemanticTokens,
+	error,
+) {
+	return s.semanticTokens(params.TextDocument.URI, nil), nil
+}
+
+// SemanticTokensRange returns the semantic tokens of the lines of the given range of the document
+//
+func (s *Server) SemanticTokensRange(
+	_ protocol.Conn,
+	params *protocol.SemanticTokensRangeParams,
+) (
+	*protocol.SemanticTokens,
+	error,
+) {
+	return s.semanticTokens(params.TextDocument.URI, &params.Range), nil
+}
@@ -0,0 +1,173 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// testSemanticTokens decodes the given semantic tokens of the given text into strings
+// of the form `line:character text type modifiers...`
+//
+func testSemanticTokens(text string, tokens *protocol.SemanticTokens) []string {
+	lines := strings.Split(text, "\n")
+
+	var result []string
+
+	line := 0
+	character := 0
+
+	for i := 0; i+4 < len(tokens.Data); i += 5 {
+		deltaLine := int(tokens.Data[i])
+		deltaStart := int(tokens.Data[i+1])
+		length := int(tokens.Data[i+2])
+		tokenType := tokens.Data[i+3]
+		modifiers := tokens.Data[i+4]
+
+		if deltaLine > 0 {
+			character = 0
+		}
+		line += deltaLine
+		character += deltaStart
+
+		parts := []string{
+			fmt.Sprintf("%d:%d", line, character),
+			lines[line][character : character+length],
+			semanticTokenTypeNames[tokenType],
+		}
+
+		for modifier, name := range semanticTokenModifierNames {
+			if modifiers&(1<<modifier) != 0 {
+				parts = append(parts, name)
+			}
+		}
+
+		result = append(result, strings.Join(parts, " "))
+	}
+
+	return result
+}
+
+func TestSemanticTokens(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("full", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "Foo.cdc"))
+		openTestDocument(t, server, uri)
+
+		tokens, err := server.SemanticTokensFull(
+			testConn{},
+			&protocol.SemanticTokensParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"1:13 Foo namespace declaration public",
+				"3:17 Vault class declaration resource public",
+				"4:16 balance property declaration public",
+				"4:25 UFix64 type",
+				"7:17 balance property public",
+				"10:16 deposit method declaration public",
+				"10:24 amount parameter declaration",
+				"10:32 UFix64 type",
+				"11:17 balance property public",
+				"11:32 balance property public",
+				"11:42 amount parameter",
+				"15:12 createVault method declaration public",
+				"15:28 Vault class resource public",
+				"16:25 Vault class resource public",
+			},
+			testSemanticTokens(testFooContract, tokens),
+		)
+	})
+
+	t.Run("range", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "transactions/deposit.cdc"))
+		openTestDocument(t, server, uri)
+
+		tokens, err := server.SemanticTokensRange(
+			testConn{},
+			&protocol.SemanticTokensRangeParams{
+				TextDocument: protocol.TextDocumentIdentifier{URI: uri},
+				Range: protocol.Range{
+					Start: protocol.Position{Line: 5, Character: 0},
+					End:   protocol.Position{Line: 6, Character: 0},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"5:12 vault variable declaration readonly resource",
+				"5:21 Foo namespace",
+				"5:25 createVault method public",
+				"6:8 vault variable readonly resource",
+				"6:14 deposit method public",
+			},
+			testSemanticTokens(testFooTransaction, tokens),
+		)
+	})
+
+	t.Run("unknown document", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		tokens, err := server.SemanticTokensFull(
+			testConn{},
+			&protocol.SemanticTokensParams{
+				TextDocument: protocol.TextDocumentIdentifier{
+					URI: pathToURI(filepath.Join(dir, "Missing.cdc")),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		require.NotNil(t, tokens.Data)
+		assert.Empty(t, tokens.Data)
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -189,6 +190,24 @@ type Server struct {
 	// initializationOptionsHandlers are the functions that are used to handle initialization options sent by the client
 	initializationOptionsHandlers []InitializationOptionsHandler
 	accessCheckMode               sema.AccessCheckMode
+	// lineWidth is the maximum line width of formatted code
+	lineWidth int
+	// inlayHintOptions are the kinds of inlay hints which are provided
+	inlayHintOptions inlayHintOptions
+	// workspaceFolders are the paths of the workspace folders
+	workspaceFolders []string
+	// symbolIndex is the index of the symbols of the workspace. It is built when first needed
+	symbolIndex *symbolIndex
+	// importSearchRoots are the configured folders in which path imports are searched
+	importSearchRoots []string
+	// importCache caches the checkers of imported programs which are not open documents
+	importCache *importCache
+	// checkDelay is the delay after which a changed document is checked
+	checkDelay time.Duration
+	// pendingChecks are the scheduled checks of changed documents
+	pendingChecks map[protocol.DocumentUri]*pendingCheck
+	// mu synchronizes the handling of requests and notifications with the checking in the background
+	mu sync.Mutex
 }
 
 type Option func(*Server) error
@@ -285,6 +304,11 @@ func NewServer() (*Server, error) {
 		ranges:               make(map[protocol.DocumentUri]map[string]sema.Range),
 		codeActionsResolvers: make(map[protocol.DocumentUri]map[uuid.UUID]func() []*protocol.CodeAction),
 		commands:             make(map[string]CommandHandler),
+		lineWidth:            defaultLineWidth,
+		inlayHintOptions:     defaultInlayHintOptions,
+		importCache:          newImportCache(),
+		checkDelay:           defaultCheckDelay,
+		pendingChecks:        make(map[protocol.DocumentUri]*pendingCheck),
 	}
 	server.protocolServer = protocol.NewServer(server)
 
@@ -319,6 +343,7 @@ func (s *Server) Stop() error {
 }
 
 func (s *Server) checkerForDocument(uri protocol.DocumentUri) *sema.Checker {
+	s.runPendingCheck(uri)
 	location := uriToLocation(uri)
 	return s.checkers[location.ID()]
 }
@@ -332,7 +357,7 @@ func (s *Server) Initialize(
 ) {
 	result := &protocol.InitializeResult{
 		Capabilities: protocol.ServerCapabilities{
-			TextDocumentSync:   protocol.Full,
+			TextDocumentSync:   protocol.Incremental,
 			HoverProvider:      true,
 			DefinitionProvider: true,
 			CodeLensProvider: &protocol.CodeLensOptions{
@@ -348,10 +373,26 @@ func (s *Server) Initialize(
 			SignatureHelpProvider: &protocol.SignatureHelpOptions{
 				TriggerCharacters: []string{"("},
 			},
-			CodeActionProvider: true,
+			CodeActionProvider:              true,
+			DocumentFormattingProvider:      true,
+			DocumentRangeFormattingProvider: true,
+			ReferencesProvider:              true,
+			WorkspaceSymbolProvider:         true,
+			SemanticTokensProvider: &protocol.SemanticTokensOptions{
+				Legend: semanticTokensLegend,
+				Range:  true,
+				Full:   true,
+			},
+			InlayHintProvider:      true,
+			FoldingRangeProvider:   true,
+			SelectionRangeProvider: true,
+			CallHierarchyProvider:  true,
+			TypeHierarchyProvider:  true,
 		},
 	}
 
+	s.workspaceFolders = workspaceFolderPaths(params)
+
 	options := params.InitializationOptions
 
 	s.configure(options)
@@ -366,6 +407,12 @@ func (s *Server) Initialize(
 	// after initialization, indicate to the client which commands we support
 	go s.registerCommands(conn)
 
+	// and that we want to be notified about changes of Cadence files,
+	// so the workspace symbol index can be kept up-to-date
+	if params.Capabilities.Workspace.DidChangeWatchedFiles.DynamicRegistration {
+		go s.registerFileWatchers(conn)
+	}
+
 	return result, nil
 }
 
@@ -401,6 +448,28 @@ func (s *Server) configure(opts interface{}) {
 	} else {
 		s.accessCheckMode = sema.AccessCheckModeStrict
 	}
+
+	// NOTE: JSON numbers are decoded as float64
+	if lineWidth, ok := optsMap[lineWidthOption].(float64); ok && lineWidth >= 0 {
+		s.lineWidth = int(lineWidth)
+	} else {
+		s.lineWidth = defaultLineWidth
+	}
+
+	s.inlayHintOptions = newInlayHintOptions(optsMap[inlayHintsOption])
+
+	s.importSearchRoots = nil
+	if roots, ok := optsMap[importSearchRootsOption].([]interface{}); ok {
+		for _, root := range roots {
+			if root, ok := root.(string); ok && root != "" {
+				s.importSearchRoots = append(s.importSearchRoots, root)
+			}
+		}
+	}
+
+	if checkDelay, ok := optsMap[checkDelayOption].(float64); ok && checkDelay >= 0 {
+		s.checkDelay = time.Duration(checkDelay) * time.Millisecond
+	}
 }
 
 // Registers the commands that the server is able to handle.
@@ -436,13 +505,40 @@ func (s *Server) registerCommands(conn protocol.Conn) {
 		},
 	}
 
+	registerCapability(conn, &registration, "command")
+}
+
+// Registers the file watchers for Cadence files in the workspace
+//
+func (s *Server) registerFileWatchers(conn protocol.Conn) {
+	registration := protocol.RegistrationParams{
+		Registrations: []protocol.Registration{
+			{
+				ID:     "registerFileWatchers",
+				Method: "workspace/didChangeWatchedFiles",
+				RegisterOptions: protocol.DidChangeWatchedFilesRegistrationOptions{
+					Watchers: []protocol.FileSystemWatcher{
+						{
+							GlobPattern: "**/*" + cadenceFileExtension,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	registerCapability(conn, &registration, "file watchers")
+}
+
+func registerCapability(conn protocol.Conn, registration *protocol.RegistrationParams, description string) {
+
 	// We have occasionally observed the client failing to recognize this
 	// method if the request is sent too soon after the extension loads.
 	// Retrying with a backoff avoids this problem.
 	retryAfter := time.Millisecond * 100
 	nRetries := 10
-	for i = 0; i < nRetries; i++ {
-		err := conn.RegisterCapability(&registration)
+	for i := 0; i < nRetries; i++ {
+		err := conn.RegisterCapability(registration)
 		if err == nil {
 			break
 		}
@@ -451,8 +547,8 @@ func (s *Server) registerCommands(conn protocol.Conn) {
 		conn.LogMessage(&protocol.LogMessageParams{
 			Type: protocol.Warning,
 			Message: fmt.Sprintf(
-				"Failed to register command. Will retry %d more times... err: %s",
-				remainingRetries, err.Error(),
+				"Failed to register %s. Will retry %d more times... err: %s",
+				description, remainingRetries, err.Error(),
 			),
 		})
 
@@ -475,28 +571,33 @@ func (s *Server) DidOpenTextDocument(conn protocol.Conn, params *protocol.DidOpe
 		Version: version,
 	}
 
-	s.checkAndPublishDiagnostics(conn, uri, text, version)
+	s.cancelPendingCheck(uri)
+	s.checkDocument(conn, uri)
 
 	return nil
 }
 
 // DidChangeTextDocument is called whenever the current document changes.
-// We parse and check the text and publish diagnostics about the document.
+// We apply the changes to the text, and schedule the parsing and checking of the document,
+// which eventually publishes diagnostics about the document.
 func (s *Server) DidChangeTextDocument(
 	conn protocol.Conn,
 	params *protocol.DidChangeTextDocumentParams,
 ) error {
 
 	uri := params.TextDocument.URI
-	text := params.ContentChanges[0].Text
-	version := params.TextDocument.Version
+
+	text := s.documents[uri].Text
+	for _, change := range params.ContentChanges {
+		text = applyContentChange(text, change)
+	}
 
 	s.documents[uri] = Document{
 		Text:    text,
-		Version: version,
+		Version: params.TextDocument.Version,
 	}
 
-	s.checkAndPublishDiagnostics(conn, uri, text, version)
+	s.scheduleCheck(conn, uri)
 
 	return nil
 }
@@ -751,38 +852,81 @@ func (s *Server) DocumentHighlight(
 		return nil, nil
 	}
 
-	position := conversion.ProtocolToSemaPosition(params.Position)
-	occurrences := checker.Occurrences.FindAll(position)
-	// If there are no occurrences,
-	// then try the preceding position
-	if len(occurrences) == 0 && position.Column > 0 {
-		previousPosition := position
-		previousPosition.Column -= 1
-		occurrences = checker.Occurrences.FindAll(previousPosition)
+	documentHighlights := make([]*protocol.DocumentHighlight, 0)
+
+	for _, occurrenceRange := range localOccurrences(checker, params.Position) {
+		documentHighlights = append(documentHighlights,
+			&protocol.DocumentHighlight{
+				Range: conversion.ASTToProtocolRange(
+					occurrenceRange.StartPos,
+					occurrenceRange.EndPos,
+				),
+			},
+		)
 	}
 
-	documentHighlights := make([]*protocol.DocumentHighlight, 0)
+	return documentHighlights, nil
+}
 
-	for _, occurrence := range occurrences {
+// References returns the locations of all references to the symbol at the given position.
+//
+// References to top-level declarations and to members of composites and interfaces
+// are found in all files of the workspace which import the file declaring the symbol.
+// References to all other symbols, e.g. local variables, are only found in the document
+//
+func (s *Server) References(
+	_ protocol.Conn,
+	params *protocol.ReferenceParams,
+) (
+	[]*protocol.Location,
+	error,
+) {
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	locations := make([]*protocol.Location, 0)
 
-		origin := occurrence.Origin
-		if origin == nil || origin.StartPos == nil || origin.EndPos == nil {
-			continue
-		}
+	uri := params.TextDocument.URI
 
-		for _, occurrenceRange := range origin.Occurrences {
-			documentHighlights = append(documentHighlights,
-				&protocol.DocumentHighlight{
-					Range: conversion.ASTToProtocolRange(
-						occurrenceRange.StartPos,
-						occurrenceRange.EndPos,
-					),
-				},
-			)
+	if references, ok := s.workspaceReferences(uri, params.Position); ok {
+		for _, uri := range sortedReferenceURIs(references) {
+			for _, reference := range references[uri] {
+				if reference.isDeclaration && !params.Context.IncludeDeclaration {
+					continue
+				}
+
+				locations = append(locations,
+					&protocol.Location{
+						URI: uri,
+						Range: conversion.ASTToProtocolRange(
+							reference.StartPos,
+							reference.EndPos,
+						),
+					},
+				)
+			}
 		}
+
+		return locations, nil
 	}
 
-	return documentHighlights, nil
+	checker := s.checkerForDocument(uri)
+	if checker == nil {
+		return locations, nil
+	}
+
+	for _, occurrenceRange := range localOccurrences(checker, params.Position) {
+		locations = append(locations,
+			&protocol.Location{
+				URI: uri,
+				Range: conversion.ASTToProtocolRange(
+					occurrenceRange.StartPos,
+					occurrenceRange.EndPos,
+				),
+			},
+		)
+	}
+
+	return locations, nil
 }
 
 func (s *Server) Rename(
@@ -793,41 +937,30 @@ func (s *Server) Rename(
 	error,
 ) {
 	uri := params.TextDocument.URI
+
+	// Rename symbols which can be referred to from other files in all files of the workspace
+
+	if references, ok := s.workspaceReferences(uri, params.Position); ok {
+		return workspaceRenameEdit(references, params.NewName)
+	}
+
 	checker := s.checkerForDocument(uri)
 	if checker == nil {
 		return nil, nil
 	}
 
-	position := conversion.ProtocolToSemaPosition(params.Position)
-	occurrences := checker.Occurrences.FindAll(position)
-	// If there are no occurrences,
-	// then try the preceding position
-	if len(occurrences) == 0 && position.Column > 0 {
-		previousPosition := position
-		previousPosition.Column -= 1
-		occurrences = checker.Occurrences.FindAll(previousPosition)
-	}
-
 	textEdits := make([]protocol.TextEdit, 0)
 
-	for _, occurrence := range occurrences {
-
-		origin := occurrence.Origin
-		if origin == nil || origin.StartPos == nil || origin.EndPos == nil {
-			continue
-		}
-
-		for _, occurrenceRange := range origin.Occurrences {
-			textEdits = append(textEdits,
-				protocol.TextEdit{
-					Range: conversion.ASTToProtocolRange(
-						occurrenceRange.StartPos,
-						occurrenceRange.EndPos,
-					),
-					NewText: params.NewName,
-				},
-			)
-		}
+	for _, occurrenceRange := range localOccurrences(checker, params.Position) {
+		textEdits = append(textEdits,
+			protocol.TextEdit{
+				Range: conversion.ASTToProtocolRange(
+					occurrenceRange.StartPos,
+					occurrenceRange.EndPos,
+				),
+				NewText: params.NewName,
+			},
+		)
 	}
 
 	return &protocol.WorkspaceEdit{
@@ -1627,9 +1760,59 @@ func (s *Server) DocumentSymbol(
 	return
 }
 
+// DocumentFormatting is called when the client requests the whole document to be formatted.
+// It returns the edits which format the document, or no edits if the document is not valid
+func (s *Server) DocumentFormatting(
+	_ protocol.Conn,
+	params *protocol.DocumentFormattingParams,
+) (
+	[]*protocol.TextEdit,
+	error,
+) {
+	return s.formattingEdits(params.TextDocument.URI, params.Options), nil
+}
+
+// DocumentRangeFormatting is called when the client requests a range of the document to be formatted.
+// It returns the edits which format the lines of the range
+func (s *Server) DocumentRangeFormatting(
+	_ protocol.Conn,
+	params *protocol.DocumentRangeFormattingParams,
+) (
+	[]*protocol.TextEdit,
+	error,
+) {
+	edits := s.formattingEdits(params.TextDocument.URI, params.Options)
+	return editsInRange(edits, params.Range), nil
+}
+
+func (s *Server) formattingEdits(uri protocol.DocumentUri, options protocol.FormattingOptions) []*protocol.TextEdit {
+
+	// NOTE: Always return an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no edits
+
+	document, ok := s.documents[uri]
+	if !ok {
+		return []*protocol.TextEdit{}
+	}
+
+	formatted, err := formatCode(
+		document.Text,
+		newFormattingOptions(options, s.lineWidth),
+	)
+	if err != nil {
+		return []*protocol.TextEdit{}
+	}
+
+	return formattingEdits(document.Text, formatted)
+}
+
 // Shutdown tells the server to stop accepting any new requests. This can only
 // be followed by a call to Exit, which exits the process.
-func (*Server) Shutdown(conn protocol.Conn) error {
+func (s *Server) Shutdown(conn protocol.Conn) error {
+
+	for uri := range s.pendingChecks {
+		s.cancelPendingCheck(uri)
+	}
 
 	conn.ShowMessage(&protocol.ShowMessageParams{
 		Type:    protocol.Warning,
@@ -1686,13 +1869,66 @@ func (s *Server) getDiagnostics(
 
 	location := uriToLocation(uri)
 
+	// Programs which imported the previous version of the document must be checked again
+	s.importCache.invalidate(location.ID())
+
 	if program == nil {
 		delete(s.checkers, location.ID())
 		return
 	}
 
 	var checker *sema.Checker
-	checker, diagnosticsErr = sema.NewChecker(
+	checker, diagnosticsErr = s.newChecker(program, location)
+	if diagnosticsErr != nil {
+		return
+	}
+
+	start := time.Now()
+	checkError := checker.Check()
+	elapsed := time.Since(start)
+
+	// Log how long it took to check the file
+	conn.LogMessage(&protocol.LogMessageParams{
+		Type:    protocol.Info,
+		Message: fmt.Sprintf("checking %s took %s", string(uri), elapsed),
+	})
+
+	s.checkers[location.ID()] = checker
+
+	if checkError != nil {
+		if parentErr, ok := checkError.(errors.ParentError); ok {
+			checkerDiagnostics := s.getDiagnosticsForParentError(conn, uri, parentErr, codeActionsResolvers)
+			diagnostics = append(diagnostics, checkerDiagnostics...)
+		}
+	}
+
+	for _, provider := range s.diagnosticProviders {
+		var extraDiagnostics []protocol.Diagnostic
+		extraDiagnostics, diagnosticsErr = provider(uri, version, checker)
+		if diagnosticsErr != nil {
+			return
+		}
+		diagnostics = append(diagnostics, extraDiagnostics...)
+	}
+
+	for _, hint := range checker.Hints() {
+		diagnostic, codeActionsResolver := convertHint(hint, uri)
+		if codeActionsResolver != nil {
+			codeActionsResolverID := uuid.New()
+			diagnostic.Data = codeActionsResolverID
+			codeActionsResolvers[codeActionsResolverID] = codeActionsResolver
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+
+	return
+}
+
+// newChecker returns a new checker for the given program,
+// which resolves imports using the server's import resolvers
+//
+func (s *Server) newChecker(program *ast.Program, location common.Location) (*sema.Checker, error) {
+	return sema.NewChecker(
 		program,
 		location,
 		sema.WithPredeclaredValues(valueDeclarations),
@@ -1775,9 +2011,9 @@ func (s *Server) getDiagnostics(
 
 				default:
 					if isPathLocation(importedLocation) {
-						// import may be a relative path and therefore should be normalized
-						// against the current location
-						importedLocation = normalizePathLocation(checker.Location, importedLocation)
+						// import may be a relative path and therefore should be resolved
+						// against the current location or the import search paths
+						importedLocation = s.resolvePathLocation(checker.Location, importedLocation)
 
 						if checker.Location == importedLocation {
 							return nil, &sema.CheckerError{
@@ -1786,28 +2022,13 @@ func (s *Server) getDiagnostics(
 						}
 					}
 
-					importedLocationID := importedLocation.ID()
-
-					importedChecker, ok := s.checkers[importedLocationID]
-					if !ok {
-						importedProgram, err := s.resolveImport(importedLocation)
-						if err != nil {
-							return nil, err
-						}
-						if importedProgram == nil {
-							return nil, &sema.CheckerError{
-								Errors: []error{fmt.Errorf("cannot import %s", importedLocation)},
-							}
-						}
-
-						importedChecker, err = checker.SubChecker(importedProgram, importedLocation)
-						if err != nil {
-							return nil, err
-						}
-						s.checkers[importedLocationID] = importedChecker
-						err = importedChecker.Check()
-						if err != nil {
-							return nil, err
+					importedChecker, err := s.importedChecker(checker, importedLocation)
+					if err != nil {
+						return nil, err
+					}
+					if importedChecker == nil {
+						return nil, &sema.CheckerError{
+							Errors: []error{fmt.Errorf("cannot import %s", importedLocation)},
 						}
 					}
 
@@ -1819,49 +2040,6 @@ func (s *Server) getDiagnostics(
 		),
 		sema.WithAccessCheckMode(s.accessCheckMode),
 	)
-	if diagnosticsErr != nil {
-		return
-	}
-
-	start := time.Now()
-	checkError := checker.Check()
-	elapsed := time.Since(start)
-
-	// Log how long it took to check the file
-	conn.LogMessage(&protocol.LogMessageParams{
-		Type:    protocol.Info,
-		Message: fmt.Sprintf("checking %s took %s", string(uri), elapsed),
-	})
-
-	s.checkers[location.ID()] = checker
-
-	if checkError != nil {
-		if parentErr, ok := checkError.(errors.ParentError); ok {
-			checkerDiagnostics := s.getDiagnosticsForParentError(conn, uri, parentErr, codeActionsResolvers)
-			diagnostics = append(diagnostics, checkerDiagnostics...)
-		}
-	}
-
-	for _, provider := range s.diagnosticProviders {
-		var extraDiagnostics []protocol.Diagnostic
-		extraDiagnostics, diagnosticsErr = provider(uri, version, checker)
-		if diagnosticsErr != nil {
-			return
-		}
-		diagnostics = append(diagnostics, extraDiagnostics...)
-	}
-
-	for _, hint := range checker.Hints() {
-		diagnostic, codeActionsResolver := convertHint(hint, uri)
-		if codeActionsResolver != nil {
-			codeActionsResolverID := uuid.New()
-			diagnostic.Data = codeActionsResolverID
-			codeActionsResolvers[codeActionsResolverID] = codeActionsResolver
-		}
-		diagnostics = append(diagnostics, diagnostic)
-	}
-
-	return
 }
 
 // getDiagnosticsForParentError unpacks all child errors and converts each to
@@ -1944,6 +2122,12 @@ func (s *Server) resolveImport(location common.Location) (program *ast.Program,
 	var code string
 	switch loc := location.(type) {
 	case common.StringLocation:
+		// Open documents might have changes which are not saved yet
+		if document, ok := s.documents[pathToURI(string(loc))]; ok {
+			code = document.Text
+			break
+		}
+
 		if s.resolveStringImport == nil {
 			return nil, nil
 		}
@@ -2170,17 +2354,54 @@ func (s *Server) convertError(
 		codeActionsResolver = maybeAddMissingMembersCodeActionResolver(diagnostic, err, uri)
 
 	case *sema.NotDeclaredError:
-		if err.ExpectedKind == common.DeclarationKindVariable {
-			codeActionsResolver = s.maybeAddDeclarationActionsResolver(
-				diagnostic,
-				uri,
-				err.Expression,
-				err.Pos,
-				err.Name,
-				nil,
+		switch err.ExpectedKind {
+		case common.DeclarationKindVariable:
+			codeActionsResolver = combinedCodeActionsResolver(
+				s.maybeAddDeclarationActionsResolver(
+					diagnostic,
+					uri,
+					err.Expression,
+					err.Pos,
+					err.Name,
+					nil,
+				),
+				s.missingImportCodeActionsResolver(diagnostic, uri, err.Name),
 			)
+
+		case common.DeclarationKindType:
+			codeActionsResolver = s.missingImportCodeActionsResolver(diagnostic, uri, err.Name)
 		}
 
+	case *sema.MissingMoveOperationError:
+		codeActionsResolver = missingMoveOperationCodeActionsResolver(diagnostic, uri, err)
+
+	case *sema.IncorrectTransferOperationError:
+		codeActionsResolver = incorrectTransferOperationCodeActionsResolver(diagnostic, uri, err)
+
+	case *sema.MissingAccessModifierError:
+		codeActionsResolver = missingAccessModifierCodeActionsResolver(diagnostic, uri, err)
+
+	case *sema.ResourceLossError:
+		codeActionsResolver = s.resourceLossCodeActionsResolver(diagnostic, uri, err)
+
+	case *sema.MissingArgumentLabelError:
+		codeActionsResolver = s.argumentLabelCodeActionsResolver(
+			diagnostic,
+			uri,
+			err.Range,
+			"",
+			err.ExpectedArgumentLabel,
+		)
+
+	case *sema.IncorrectArgumentLabelError:
+		codeActionsResolver = s.argumentLabelCodeActionsResolver(
+			diagnostic,
+			uri,
+			err.Range,
+			err.ActualArgumentLabel,
+			err.ExpectedArgumentLabel,
+		)
+
 	case *sema.NotDeclaredMemberError:
 		var declarationGetter func(elaboration *sema.Elaboration) ast.Declaration
 
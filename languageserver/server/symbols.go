@@ -0,0 +1,354 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser2"
+
+	"github.com/onflow/cadence/languageserver/conversion"
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// maxWorkspaceSymbols is the maximum number of symbols returned for a workspace symbol query
+//
+const maxWorkspaceSymbols = 500
+
+// symbolIndex is an index of the symbols declared in the Cadence files of the workspace:
+// contracts, composites (e.g. resources and events), interfaces, functions, and fields
+//
+type symbolIndex struct {
+	symbols map[protocol.DocumentUri][]*protocol.SymbolInformation
+}
+
+func newSymbolIndex() *symbolIndex {
+	return &symbolIndex{
+		symbols: map[protocol.DocumentUri][]*protocol.SymbolInformation{},
+	}
+}
+
+// update indexes the symbols of the given code.
+// If the code cannot be parsed at all, the previous symbols are kept
+//
+func (index *symbolIndex) update(uri protocol.DocumentUri, code string) {
+	// NOTE: the program might be partial if there are syntax errors
+	program, _ := parser2.ParseProgram(code)
+	if program == nil {
+		return
+	}
+
+	index.updateProgram(uri, program)
+}
+
+func (index *symbolIndex) updateProgram(uri protocol.DocumentUri, program *ast.Program) {
+	index.symbols[uri] = programSymbols(uri, program)
+}
+
+// remove removes the symbols of the file with the given URI,
+// or of all files in the folder with the given URI
+//
+func (index *symbolIndex) remove(uri protocol.DocumentUri) {
+	folderPrefix := string(uri) + "/"
+
+	for indexedURI := range index.symbols {
+		if indexedURI == uri || strings.HasPrefix(string(indexedURI), folderPrefix) {
+			delete(index.symbols, indexedURI)
+		}
+	}
+}
+
+// programSymbols returns the symbols declared in the given program
+//
+func programSymbols(uri protocol.DocumentUri, program *ast.Program) []*protocol.SymbolInformation {
+	var symbols []*protocol.SymbolInformation
+
+	var addDeclaration func(declaration ast.Declaration, containerName string)
+	addDeclaration = func(declaration ast.Declaration, containerName string) {
+		switch declaration.(type) {
+		case *ast.CompositeDeclaration,
+			*ast.InterfaceDeclaration,
+			*ast.FunctionDeclaration,
+			*ast.FieldDeclaration:
+		default:
+			return
+		}
+
+		identifier := declaration.DeclarationIdentifier()
+		if identifier == nil || identifier.Identifier == "" {
+			return
+		}
+
+		symbols = append(symbols,
+			&protocol.SymbolInformation{
+				Name: identifier.Identifier,
+				Kind: conversion.DeclarationKindToSymbolKind(declaration.DeclarationKind()),
+				Location: protocol.Location{
+					URI: uri,
+					Range: conversion.ASTToProtocolRange(
+						declaration.StartPosition(),
+						declaration.EndPosition(),
+					),
+				},
+				ContainerName: containerName,
+			},
+		)
+
+		members := declaration.DeclarationMembers()
+		if members == nil {
+			return
+		}
+
+		qualifiedIdentifier := identifier.Identifier
+		if containerName != "" {
+			qualifiedIdentifier = containerName + "." + qualifiedIdentifier
+		}
+
+		for _, member := range members.Declarations() {
+			addDeclaration(member, qualifiedIdentifier)
+		}
+	}
+
+	for _, declaration := range program.Declarations() {
+		addDeclaration(declaration, "")
+	}
+
+	return symbols
+}
+
+// symbolMatch is the quality of a match of a query, lower is better
+//
+type symbolMatch int
+
+const (
+	symbolMatchExact symbolMatch = iota
+	symbolMatchPrefix
+	symbolMatchSubstring
+	symbolMatchFuzzy
+	symbolMatchNone
+)
+
+// matchName matches the given name against the given query, ignoring case.
+// The query matches fuzzily if its characters occur in the name in the same order
+//
+func matchName(name, query string) symbolMatch {
+	name = strings.ToLower(name)
+	query = strings.ToLower(query)
+
+	switch {
+	case name == query:
+		return symbolMatchExact
+	case strings.HasPrefix(name, query):
+		return symbolMatchPrefix
+	case strings.Contains(name, query):
+		return symbolMatchSubstring
+	}
+
+	remaining := name
+	for _, r := range query {
+		index := strings.IndexRune(remaining, r)
+		if index < 0 {
+			return symbolMatchNone
+		}
+		remaining = remaining[index+1:]
+	}
+
+	return symbolMatchFuzzy
+}
+
+// matchSymbol matches the given symbol against the given query.
+// A qualified query, e.g. `FungibleToken.Vault`, also matches the container of the symbol
+//
+func matchSymbol(symbol *protocol.SymbolInformation, query string) symbolMatch {
+	index := strings.LastIndexByte(query, '.')
+	if index < 0 {
+		return matchName(symbol.Name, query)
+	}
+
+	containerQuery := query[:index]
+	if containerQuery != "" &&
+		matchName(symbol.ContainerName, containerQuery) == symbolMatchNone {
+
+		return symbolMatchNone
+	}
+
+	return matchName(symbol.Name, query[index+1:])
+}
+
+// query returns the symbols matching the given query, best matches first
+//
+func (index *symbolIndex) query(query string) []*protocol.SymbolInformation {
+	type match struct {
+		symbol *protocol.SymbolInformation
+		match  symbolMatch
+	}
+
+	var matches []match
+
+	for _, symbols := range index.symbols {
+		for _, symbol := range symbols {
+			symbolMatch := matchSymbol(symbol, query)
+			if symbolMatch == symbolMatchNone {
+				continue
+			}
+			matches = append(matches, match{
+				symbol: symbol,
+				match:  symbolMatch,
+			})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.match != b.match {
+			return a.match < b.match
+		}
+		if a.symbol.Name != b.symbol.Name {
+			return a.symbol.Name < b.symbol.Name
+		}
+		if a.symbol.ContainerName != b.symbol.ContainerName {
+			return a.symbol.ContainerName < b.symbol.ContainerName
+		}
+		if a.symbol.Location.URI != b.symbol.Location.URI {
+			return a.symbol.Location.URI < b.symbol.Location.URI
+		}
+		return a.symbol.Location.Range.Start.Line < b.symbol.Location.Range.Start.Line
+	})
+
+	if len(matches) > maxWorkspaceSymbols {
+		matches = matches[:maxWorkspaceSymbols]
+	}
+
+	// NOTE: Always initialize to an empty slice, i.e DON'T use nil:
+	// The later will be ignored instead of being treated as no items
+	result := make([]*protocol.SymbolInformation, len(matches))
+	for i, match := range matches {
+		result[i] = match.symbol
+	}
+
+	return result
+}
+
+// workspaceSymbolIndex returns the symbol index of the workspace.
+// The index is built from all Cadence files in the workspace folders when it is first needed,
+// and is then kept up-to-date when documents change and when watched files change
+//
+func (s *Server) workspaceSymbolIndex() *symbolIndex {
+	s.runPendingChecks()
+
+	if s.symbolIndex != nil {
+		return s.symbolIndex
+	}
+
+	index := newSymbolIndex()
+
+	for _, folder := range s.workspaceFolders {
+		for _, path := range cadenceFilePaths(folder) {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				continue
+			}
+			index.update(pathToURI(path), string(data))
+		}
+	}
+
+	for uri, document := range s.documents {
+		index.update(uri, document.Text)
+	}
+
+	s.symbolIndex = index
+
+	return index
+}
+
+// updateDocumentSymbols updates the symbols of the given open document in the symbol index,
+// if the index was already built
+//
+func (s *Server) updateDocumentSymbols(uri protocol.DocumentUri) {
+	if s.symbolIndex == nil {
+		return
+	}
+
+	// The document was just checked, so use the checked program instead of parsing again.
+	// If the document could not be parsed at all, the previous symbols are kept
+
+	checker := s.checkerForDocument(uri)
+	if checker == nil {
+		return
+	}
+
+	s.symbolIndex.updateProgram(uri, checker.Program)
+}
+
+// WorkspaceSymbol returns the symbols of all Cadence files in the workspace which match the given query
+//
+func (s *Server) WorkspaceSymbol(
+	_ protocol.Conn,
+	params *protocol.WorkspaceSymbolParams,
+) (
+	[]*protocol.SymbolInformation,
+	error,
+) {
+	return s.workspaceSymbolIndex().query(params.Query), nil
+}
+
+// DidChangeWatchedFiles is called when Cadence files in the workspace are created, changed, or deleted.
+// The cached imports and the symbol index are updated for the changed files.
+// Open documents are indexed when they change, so changes of their files on disk are ignored
+//
+func (s *Server) DidChangeWatchedFiles(
+	conn protocol.Conn,
+	params *protocol.DidChangeWatchedFilesParams,
+) error {
+	s.invalidateImports(conn, params.Changes)
+
+	if s.symbolIndex == nil {
+		return nil
+	}
+
+	for _, change := range params.Changes {
+		uri := change.URI
+
+		if change.Type == protocol.Deleted {
+			s.symbolIndex.remove(uri)
+			continue
+		}
+
+		if _, ok := s.documents[uri]; ok {
+			continue
+		}
+
+		if !strings.HasSuffix(string(uri), cadenceFileExtension) {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(strings.TrimPrefix(string(uri), filePrefix))
+		if err != nil {
+			s.symbolIndex.remove(uri)
+			continue
+		}
+
+		s.symbolIndex.update(uri, string(data))
+	}
+
+	return nil
+}
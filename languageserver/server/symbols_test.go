@@ -0,0 +1,201 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// testSymbols returns the given symbols as strings
+// of the form `container.name path:line`, with the path relative to the given folder
+//
+func testSymbols(dir string, symbols []*protocol.SymbolInformation) []string {
+	result := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		name := symbol.Name
+		if symbol.ContainerName != "" {
+			name = symbol.ContainerName + "." + name
+		}
+		result[i] = fmt.Sprintf(
+			"%s %s",
+			name,
+			testLocation(dir, symbol.Location.URI, symbol.Location.Range),
+		)
+	}
+	return result
+}
+
+func TestWorkspaceSymbol(t *testing.T) {
+
+	t.Parallel()
+
+	queryWorkspaceSymbols := func(t *testing.T, server *Server, query string) []*protocol.SymbolInformation {
+		symbols, err := server.WorkspaceSymbol(
+			testConn{},
+			&protocol.WorkspaceSymbolParams{
+				Query: query,
+			},
+		)
+		require.NoError(t, err)
+		require.NotNil(t, symbols)
+		return symbols
+	}
+
+	t.Run("query", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.Vault.deposit Foo.cdc:10:8",
+			},
+			testSymbols(dir, queryWorkspaceSymbols(t, server, "dep")),
+		)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.Vault Foo.cdc:3:4",
+				"Foo.createVault Foo.cdc:15:4",
+			},
+			testSymbols(dir, queryWorkspaceSymbols(t, server, "Foo.Vault")),
+		)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.Vault.balance Foo.cdc:4:8",
+			},
+			testSymbols(dir, queryWorkspaceSymbols(t, server, "vault.bal")),
+		)
+
+		assert.Empty(t, queryWorkspaceSymbols(t, server, "Bar"))
+	})
+
+	t.Run("watched files", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		assert.Empty(t, queryWorkspaceSymbols(t, server, "Bar"))
+
+		path := filepath.Join(dir, "Bar.cdc")
+		uri := pathToURI(path)
+
+		changeWatchedFile := func(changeType protocol.FileChangeType) {
+			err := server.DidChangeWatchedFiles(
+				testConn{},
+				&protocol.DidChangeWatchedFilesParams{
+					Changes: []protocol.FileEvent{
+						{
+							URI:  uri,
+							Type: changeType,
+						},
+					},
+				},
+			)
+			require.NoError(t, err)
+		}
+
+		// Created
+
+		err := ioutil.WriteFile(path, []byte("pub contract Bar {}"), 0600)
+		require.NoError(t, err)
+
+		changeWatchedFile(protocol.Created)
+
+		assert.Equal(t,
+			[]string{
+				"Bar Bar.cdc:0:0",
+			},
+			testSymbols(dir, queryWorkspaceSymbols(t, server, "Bar")),
+		)
+
+		// Changed
+
+		err = ioutil.WriteFile(path, []byte("pub contract Bar {\n    pub event Baz()\n}"), 0600)
+		require.NoError(t, err)
+
+		changeWatchedFile(protocol.Changed)
+
+		assert.Equal(t,
+			[]string{
+				"Bar.Baz Bar.cdc:1:4",
+			},
+			testSymbols(dir, queryWorkspaceSymbols(t, server, "Baz")),
+		)
+
+		// Deleted
+
+		err = os.Remove(path)
+		require.NoError(t, err)
+
+		changeWatchedFile(protocol.Deleted)
+
+		assert.Empty(t, queryWorkspaceSymbols(t, server, "Bar"))
+	})
+
+	t.Run("open document", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, dir := newTestWorkspaceServer(t)
+		defer os.RemoveAll(dir)
+
+		uri := pathToURI(filepath.Join(dir, "unrelated.cdc"))
+		openTestDocument(t, server, uri)
+
+		assert.Len(t, queryWorkspaceSymbols(t, server, "createVault"), 2)
+
+		err := server.DidChangeTextDocument(
+			testConn{},
+			&protocol.DidChangeTextDocumentParams{
+				TextDocument: protocol.VersionedTextDocumentIdentifier{
+					TextDocumentIdentifier: protocol.TextDocumentIdentifier{URI: uri},
+				},
+				ContentChanges: []protocol.TextDocumentContentChangeEvent{
+					{
+						Text: "pub fun createVaults() {}",
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"Foo.createVault Foo.cdc:15:4",
+				"createVaults unrelated.cdc:0:0",
+			},
+			testSymbols(dir, queryWorkspaceSymbols(t, server, "createVault")),
+		)
+	})
+}
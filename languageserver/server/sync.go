@@ -0,0 +1,221 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+// checkDelayOption is the name of the option which configures the delay, in milliseconds,
+// after which a changed document is checked
+//
+const checkDelayOption = "checkDelay"
+
+const defaultCheckDelay = 300 * time.Millisecond
+
+// WithCheckDelay returns a server option that sets the delay after which a changed document is checked.
+// Further changes within the delay restart it, so a burst of changes only results in a single check.
+// A delay of zero checks each change immediately.
+//
+// Each check is a full re-check of the document.
+// Only checks which have not started yet are cancelled,
+// a check which already started always runs to completion
+//
+func WithCheckDelay(delay time.Duration) Option {
+	return func(s *Server) error {
+		s.checkDelay = delay
+		return nil
+	}
+}
+
+// Lock acquires the lock which synchronizes the handling of requests and notifications
+// with the checking of changed documents in the background
+//
+func (s *Server) Lock() {
+	s.mu.Lock()
+}
+
+// Unlock releases the lock acquired by Lock
+//
+func (s *Server) Unlock() {
+	s.mu.Unlock()
+}
+
+// pendingCheck is a scheduled check of a changed document which has not started yet.
+// The context is cancelled when the check becomes stale before it starts
+//
+type pendingCheck struct {
+	conn   protocol.Conn
+	timer  *time.Timer
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// scheduleCheck schedules the check of the given changed document.
+// A previously scheduled check of the document which has not started yet is stale and gets cancelled.
+//
+// NOTE: a started check is not interrupted: it holds the lock until it completes,
+// so the next change can only be handled, and its check only be scheduled, after it
+//
+func (s *Server) scheduleCheck(conn protocol.Conn, uri protocol.DocumentUri) {
+	s.cancelPendingCheck(uri)
+
+	if s.checkDelay <= 0 {
+		s.checkDocument(conn, uri)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	pending := &pendingCheck{
+		conn:   conn,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	pending.timer = time.AfterFunc(s.checkDelay, func() {
+		s.Lock()
+		defer s.Unlock()
+
+		// The check might have been cancelled while waiting for the lock,
+		// because the document changed again or was already checked on demand
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.runPendingCheck(uri)
+	})
+
+	s.pendingChecks[uri] = pending
+}
+
+// cancelPendingCheck cancels the scheduled check of the given document, if any
+//
+func (s *Server) cancelPendingCheck(uri protocol.DocumentUri) {
+	pending, ok := s.pendingChecks[uri]
+	if !ok {
+		return
+	}
+
+	pending.timer.Stop()
+	pending.cancel()
+	delete(s.pendingChecks, uri)
+}
+
+// runPendingCheck checks the given document immediately if a check of it is scheduled,
+// so the checker of the document reflects all changes
+//
+func (s *Server) runPendingCheck(uri protocol.DocumentUri) {
+	pending, ok := s.pendingChecks[uri]
+	if !ok {
+		return
+	}
+
+	s.cancelPendingCheck(uri)
+	s.checkDocument(pending.conn, uri)
+}
+
+// runPendingChecks checks all documents for which a check is scheduled
+//
+func (s *Server) runPendingChecks() {
+	for _, uri := range sortedPendingCheckURIs(s.pendingChecks) {
+		s.runPendingCheck(uri)
+	}
+}
+
+func sortedPendingCheckURIs(pendingChecks map[protocol.DocumentUri]*pendingCheck) []protocol.DocumentUri {
+	uris := make([]protocol.DocumentUri, 0, len(pendingChecks))
+	for uri := range pendingChecks {
+		uris = append(uris, uri)
+	}
+	sort.Slice(uris, func(i, j int) bool {
+		return uris[i] < uris[j]
+	})
+	return uris
+}
+
+// checkDocument checks the current text of the given document,
+// publishes the diagnostics, and updates the symbols of the document
+//
+func (s *Server) checkDocument(conn protocol.Conn, uri protocol.DocumentUri) {
+	document := s.documents[uri]
+	s.checkAndPublishDiagnostics(conn, uri, document.Text, document.Version)
+	s.updateDocumentSymbols(uri)
+}
+
+// applyContentChange returns the given text with the given change applied.
+// A change without a range replaces the whole text
+//
+func applyContentChange(text string, change protocol.TextDocumentContentChangeEvent) string {
+	if change.Range == nil {
+		return change.Text
+	}
+
+	start := positionOffset(text, change.Range.Start)
+	end := positionOffset(text, change.Range.End)
+	if end < start {
+		end = start
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(text) - (end - start) + len(change.Text))
+	builder.WriteString(text[:start])
+	builder.WriteString(change.Text)
+	builder.WriteString(text[end:])
+	return builder.String()
+}
+
+// positionOffset returns the byte offset of the given position in the given text.
+//
+// The character of the position is in UTF-16 code units, as defined by the protocol.
+// Positions after the end of a line or after the end of the text are clamped
+//
+func positionOffset(text string, position protocol.Position) int {
+	offset := 0
+
+	for line := 0; line < int(position.Line); line++ {
+		index := strings.IndexByte(text[offset:], '\n')
+		if index < 0 {
+			return len(text)
+		}
+		offset += index + 1
+	}
+
+	for character := 0; character < int(position.Character) && offset < len(text); {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		if r == '\n' {
+			break
+		}
+		offset += size
+		if r >= 0x10000 {
+			character += 2
+		} else {
+			character++
+		}
+	}
+
+	return offset
+}
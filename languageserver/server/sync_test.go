@@ -0,0 +1,352 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+func TestApplyContentChange(t *testing.T) {
+
+	t.Parallel()
+
+	change := func(startLine, startCharacter, endLine, endCharacter float64, text string) protocol.TextDocumentContentChangeEvent {
+		return protocol.TextDocumentContentChangeEvent{
+			Range: &protocol.Range{
+				Start: protocol.Position{Line: startLine, Character: startCharacter},
+				End:   protocol.Position{Line: endLine, Character: endCharacter},
+			},
+			Text: text,
+		}
+	}
+
+	t.Run("full", func(t *testing.T) {
+
+		t.Parallel()
+
+		text := applyContentChange(
+			"pub fun foo() {}",
+			protocol.TextDocumentContentChangeEvent{Text: "pub fun bar() {}"},
+		)
+		assert.Equal(t, "pub fun bar() {}", text)
+	})
+
+	t.Run("insert", func(t *testing.T) {
+
+		t.Parallel()
+
+		text := applyContentChange("let x = 1\nlet y = 2\n", change(1, 4, 1, 4, "yy"))
+		assert.Equal(t, "let x = 1\nlet yyy = 2\n", text)
+	})
+
+	t.Run("replace across lines", func(t *testing.T) {
+
+		t.Parallel()
+
+		text := applyContentChange("let x = 1\nlet y = 2\nlet z = 3\n", change(0, 8, 2, 9, "4"))
+		assert.Equal(t, "let x = 4\n", text)
+	})
+
+	t.Run("sequence", func(t *testing.T) {
+
+		t.Parallel()
+
+		text := "let x = 1\n"
+		for _, c := range []protocol.TextDocumentContentChangeEvent{
+			change(0, 9, 0, 9, "\nlet y = 2"),
+			change(1, 4, 1, 5, "z"),
+			change(0, 0, 0, 4, ""),
+		} {
+			text = applyContentChange(text, c)
+		}
+		assert.Equal(t, "x = 1\nlet z = 2\n", text)
+	})
+
+	t.Run("UTF-16 characters", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The emoji is encoded as two UTF-16 code units and four UTF-8 bytes
+
+		text := applyContentChange(`let s = "😀ä"`, change(0, 11, 0, 12, "a"))
+		assert.Equal(t, `let s = "😀a"`, text)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+
+		t.Parallel()
+
+		text := applyContentChange("let x = 1\nlet y = 2", change(0, 20, 5, 0, ""))
+		assert.Equal(t, "let x = 1", text)
+	})
+}
+
+type publishingTestConn struct {
+	testConn
+	diagnostics chan *protocol.PublishDiagnosticsParams
+}
+
+func (conn publishingTestConn) PublishDiagnostics(params *protocol.PublishDiagnosticsParams) error {
+	conn.diagnostics <- params
+	return nil
+}
+
+func changeTestDocument(
+	t testing.TB,
+	server *Server,
+	conn protocol.Conn,
+	uri protocol.DocumentUri,
+	version float64,
+	changes ...protocol.TextDocumentContentChangeEvent,
+) {
+	err := server.DidChangeTextDocument(
+		conn,
+		&protocol.DidChangeTextDocumentParams{
+			TextDocument: protocol.VersionedTextDocumentIdentifier{
+				TextDocumentIdentifier: protocol.TextDocumentIdentifier{URI: uri},
+				Version:                version,
+			},
+			ContentChanges: changes,
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestDebouncedChecking(t *testing.T) {
+
+	t.Parallel()
+
+	const uri = protocol.DocumentUri("file:///test.cdc")
+
+	insertion := func(text string) protocol.TextDocumentContentChangeEvent {
+		return protocol.TextDocumentContentChangeEvent{
+			Range: &protocol.Range{
+				Start: protocol.Position{Line: 0, Character: 22},
+				End:   protocol.Position{Line: 0, Character: 22},
+			},
+			Text: text,
+		}
+	}
+
+	t.Run("on demand", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, err := NewServer()
+		require.NoError(t, err)
+
+		err = server.SetOptions(WithCheckDelay(time.Hour))
+		require.NoError(t, err)
+
+		err = server.DidOpenTextDocument(
+			testConn{},
+			&protocol.DidOpenTextDocumentParams{
+				TextDocument: protocol.TextDocumentItem{
+					URI:  uri,
+					Text: "pub fun test(): Int { return 1 }",
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		changeTestDocument(t, server, testConn{}, uri, 1, insertion("let x = 2; "))
+		changeTestDocument(t, server, testConn{}, uri, 2, insertion("let y = 3; "))
+
+		// The scheduled check is performed when the checker is needed
+
+		assert.Len(t, server.pendingChecks, 1)
+
+		checker := server.checkerForDocument(uri)
+		require.NotNil(t, checker)
+
+		assert.Empty(t, server.pendingChecks)
+		assert.Equal(t,
+			"pub fun test(): Int { let y = 3; let x = 2; return 1 }",
+			server.documents[uri].Text,
+		)
+		assert.Len(t,
+			checker.Program.FunctionDeclarations()[0].FunctionBlock.Block.Statements,
+			3,
+		)
+	})
+
+	t.Run("in background", func(t *testing.T) {
+
+		t.Parallel()
+
+		server, err := NewServer()
+		require.NoError(t, err)
+
+		err = server.SetOptions(WithCheckDelay(10 * time.Millisecond))
+		require.NoError(t, err)
+
+		conn := publishingTestConn{
+			diagnostics: make(chan *protocol.PublishDiagnosticsParams, 10),
+		}
+
+		err = server.DidOpenTextDocument(
+			conn,
+			&protocol.DidOpenTextDocumentParams{
+				TextDocument: protocol.TextDocumentItem{
+					URI:  uri,
+					Text: "pub fun test(): Int { return 1 }",
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		// The document is checked immediately when opened
+
+		require.Len(t, conn.diagnostics, 1)
+		assert.Empty(t, (<-conn.diagnostics).Diagnostics)
+
+		// A burst of changes results in a single check of the final text.
+		// Changes are handled while holding the lock, like the protocol server does
+
+		server.Lock()
+		changeTestDocument(t, server, conn, uri, 1, insertion("let x: Bool = 2; "))
+		changeTestDocument(t, server, conn, uri, 2, insertion("let y = 3; "))
+		server.Unlock()
+
+		select {
+		case params := <-conn.diagnostics:
+			assert.Len(t, params.Diagnostics, 1)
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "document was not checked")
+		}
+
+		select {
+		case <-conn.diagnostics:
+			assert.Fail(t, "stale check was not cancelled")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		server.Lock()
+		defer server.Unlock()
+
+		assert.Empty(t, server.pendingChecks)
+	})
+}
+
+func largeTestContract(functionCount int) string {
+	var builder strings.Builder
+
+	builder.WriteString("pub contract Test {\n\n")
+
+	for i := 0; i < functionCount; i++ {
+		_, _ = fmt.Fprintf(&builder,
+			"    pub fun test%[1]d(a: Int, b: Int): Int {\n"+
+				"        let c = a + b * %[1]d\n"+
+				"        return c\n"+
+				"    }\n\n",
+			i,
+		)
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+// BenchmarkDidChangeTextDocument measures the latency of changing a large document,
+// i.e. typing a character, when each change is checked immediately,
+// and when a burst of changes is checked once
+//
+func BenchmarkDidChangeTextDocument(b *testing.B) {
+
+	const uri = protocol.DocumentUri("file:///test.cdc")
+
+	// Changes alternately insert and delete a character,
+	// so the document stays the same size
+
+	typing := func(i int) protocol.TextDocumentContentChangeEvent {
+		change := protocol.TextDocumentContentChangeEvent{
+			Range: &protocol.Range{
+				Start: protocol.Position{Line: 3, Character: 15},
+				End:   protocol.Position{Line: 3, Character: 15},
+			},
+		}
+		if i%2 == 0 {
+			change.Text = "1"
+		} else {
+			change.Range.End.Character++
+		}
+		return change
+	}
+
+	for _, functionCount := range []int{100, 1000} {
+
+		code := largeTestContract(functionCount)
+		lineCount := strings.Count(code, "\n")
+
+		newServer := func(b *testing.B, delay time.Duration) *Server {
+			server, err := NewServer()
+			require.NoError(b, err)
+
+			err = server.SetOptions(WithCheckDelay(delay))
+			require.NoError(b, err)
+
+			err = server.DidOpenTextDocument(
+				testConn{},
+				&protocol.DidOpenTextDocumentParams{
+					TextDocument: protocol.TextDocumentItem{
+						URI:  uri,
+						Text: code,
+					},
+				},
+			)
+			require.NoError(b, err)
+
+			return server
+		}
+
+		b.Run(fmt.Sprintf("immediate, %d lines", lineCount), func(b *testing.B) {
+			server := newServer(b, 0)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				changeTestDocument(b, server, testConn{}, uri, float64(i+1), typing(i))
+			}
+		})
+
+		b.Run(fmt.Sprintf("debounced burst of 10, %d lines", lineCount), func(b *testing.B) {
+			server := newServer(b, time.Hour)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < 10; j++ {
+					changeTestDocument(b, server, testConn{}, uri, float64(i*10+j+1), typing(j))
+				}
+				server.runPendingChecks()
+			}
+		})
+	}
+}
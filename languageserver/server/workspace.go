@@ -0,0 +1,149 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser2"
+
+	"github.com/onflow/cadence/languageserver/protocol"
+)
+
+const cadenceFileExtension = ".cdc"
+
+// workspaceFolderPaths returns the paths of the workspace folders
+// given in the initialization parameters.
+// If the client does not support workspace folders, the root is used
+//
+func workspaceFolderPaths(params *protocol.InitializeParams) []string {
+	var paths []string
+
+	for _, folder := range params.WorkspaceFolders {
+		paths = append(paths, strings.TrimPrefix(folder.URI, filePrefix))
+	}
+
+	if len(paths) == 0 {
+		switch {
+		case params.RootURI != "":
+			paths = append(paths, strings.TrimPrefix(string(params.RootURI), filePrefix))
+		case params.RootPath != "":
+			paths = append(paths, params.RootPath)
+		}
+	}
+
+	return paths
+}
+
+// cadenceFilePaths returns the paths of all Cadence files in the given folder and its sub-folders.
+// Hidden folders, e.g. `.git`, are skipped
+//
+func cadenceFilePaths(folder string) []string {
+	var paths []string
+
+	_ = filepath.Walk(folder, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// Skip unreadable files and folders
+			return nil
+		}
+
+		name := info.Name()
+
+		if info.IsDir() {
+			if path != folder && strings.HasPrefix(name, ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(name) == cadenceFileExtension {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+
+	return paths
+}
+
+func pathToURI(path string) protocol.DocumentUri {
+	return protocol.DocumentUri(filePrefix + path)
+}
+
+// workspaceFile is a Cadence file of the workspace:
+// either an open document, or a file on disk
+//
+type workspaceFile struct {
+	uri      protocol.DocumentUri
+	location common.StringLocation
+	code     string
+	// program is the parsed program. It is nil if the code could not be parsed
+	program *ast.Program
+}
+
+// workspaceFiles returns all open documents and all Cadence files in the workspace folders.
+// The contents of open documents take precedence over the contents on disk.
+// The files are sorted by URI
+//
+func (s *Server) workspaceFiles() []*workspaceFile {
+	codes := map[protocol.DocumentUri]string{}
+
+	for _, folder := range s.workspaceFolders {
+		for _, path := range cadenceFilePaths(folder) {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				continue
+			}
+			codes[pathToURI(path)] = string(data)
+		}
+	}
+
+	for uri, document := range s.documents {
+		codes[uri] = document.Text
+	}
+
+	files := make([]*workspaceFile, 0, len(codes))
+	for uri, code := range codes {
+		files = append(files, newWorkspaceFile(uri, code))
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].uri < files[j].uri
+	})
+
+	return files
+}
+
+func newWorkspaceFile(uri protocol.DocumentUri, code string) *workspaceFile {
+	// NOTE: the program might be partial if there are syntax errors
+	program, _ := parser2.ParseProgram(code)
+
+	return &workspaceFile{
+		uri:      uri,
+		location: uriToLocation(uri),
+		code:     code,
+		program:  program,
+	}
+}
@@ -0,0 +1,295 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/stdlib"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeABI(t *testing.T) {
+
+	t.Parallel()
+
+	executeScript := func(code string) (cadence.Value, error) {
+		runtime := newTestInterpreterRuntime()
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+		}
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: []byte(code),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  utils.TestLocation,
+			},
+		)
+	}
+
+	// Examples from the Solidity contract ABI specification
+
+	t.Run("static types", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(`
+          pub fun main(): String {
+              let data = ABI.encode([69 as UInt32, true])
+
+              let values = ABI.decode(data, types: [Type<UInt32>(), Type<Bool>()])
+              assert(values[0] as! UInt32 == 69)
+              assert(values[1] as! Bool)
+
+              return String.encodeHex(data)
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.String(
+				"0000000000000000000000000000000000000000000000000000000000000045"+
+					"0000000000000000000000000000000000000000000000000000000000000001",
+			),
+			result,
+		)
+	})
+
+	t.Run("dynamic types", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(`
+          pub fun main(): String {
+              let fixedBytes: [UInt8; 10] = [0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x30]
+
+              let data = ABI.encode([
+                  0x123 as UInt256,
+                  [0x456, 0x789] as [UInt32],
+                  fixedBytes,
+                  "Hello, world!".utf8
+              ])
+
+              let values = ABI.decode(
+                  data,
+                  types: [Type<UInt256>(), Type<[UInt32]>(), Type<[UInt8; 10]>(), Type<[UInt8]>()]
+              )
+              assert(values[0] as! UInt256 == 0x123)
+              assert((values[1] as! [UInt32])[1] == 0x789)
+              assert((values[2] as! [UInt8; 10])[9] == 0x30)
+              assert(String.fromUTF8(values[3] as! [UInt8])! == "Hello, world!")
+              assert(String.encodeHex(ABI.encode(values)) == String.encodeHex(data))
+
+              return String.encodeHex(data)
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.String(
+				"0000000000000000000000000000000000000000000000000000000000000123"+
+					"0000000000000000000000000000000000000000000000000000000000000080"+
+					"3132333435363738393000000000000000000000000000000000000000000000"+
+					"00000000000000000000000000000000000000000000000000000000000000e0"+
+					"0000000000000000000000000000000000000000000000000000000000000002"+
+					"0000000000000000000000000000000000000000000000000000000000000456"+
+					"0000000000000000000000000000000000000000000000000000000000000789"+
+					"000000000000000000000000000000000000000000000000000000000000000d"+
+					"48656c6c6f2c20776f726c642100000000000000000000000000000000000000",
+			),
+			result,
+		)
+	})
+
+	t.Run("nested dynamic types", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(`
+          pub fun main(): String {
+              let data = ABI.encode([
+                  [[1, 2], [3]] as [[UInt256]],
+                  ["one", "two", "three"]
+              ])
+
+              let values = ABI.decode(data, types: [Type<[[UInt256]]>(), Type<[String]>()])
+              assert((values[0] as! [[UInt256]])[1][0] == 3)
+              assert((values[1] as! [String])[2] == "three")
+              assert(String.encodeHex(ABI.encode(values)) == String.encodeHex(data))
+
+              return String.encodeHex(data)
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.String(
+				"0000000000000000000000000000000000000000000000000000000000000040"+
+					"0000000000000000000000000000000000000000000000000000000000000140"+
+					"0000000000000000000000000000000000000000000000000000000000000002"+
+					"0000000000000000000000000000000000000000000000000000000000000040"+
+					"00000000000000000000000000000000000000000000000000000000000000a0"+
+					"0000000000000000000000000000000000000000000000000000000000000002"+
+					"0000000000000000000000000000000000000000000000000000000000000001"+
+					"0000000000000000000000000000000000000000000000000000000000000002"+
+					"0000000000000000000000000000000000000000000000000000000000000001"+
+					"0000000000000000000000000000000000000000000000000000000000000003"+
+					"0000000000000000000000000000000000000000000000000000000000000003"+
+					"0000000000000000000000000000000000000000000000000000000000000060"+
+					"00000000000000000000000000000000000000000000000000000000000000a0"+
+					"00000000000000000000000000000000000000000000000000000000000000e0"+
+					"0000000000000000000000000000000000000000000000000000000000000003"+
+					"6f6e650000000000000000000000000000000000000000000000000000000000"+
+					"0000000000000000000000000000000000000000000000000000000000000003"+
+					"74776f0000000000000000000000000000000000000000000000000000000000"+
+					"0000000000000000000000000000000000000000000000000000000000000005"+
+					"7468726565000000000000000000000000000000000000000000000000000000",
+			),
+			result,
+		)
+	})
+
+	t.Run("signed integers", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(`
+          pub fun main(): String {
+              let data = ABI.encode([-1 as Int8, -2])
+
+              let values = ABI.decode(data, types: [Type<Int8>(), Type<Int>()])
+              assert(values[0] as! Int8 == -1)
+              assert(values[1] as! Int == -2)
+
+              return String.encodeHex(data)
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.String(
+				strings.Repeat("ff", 32)+
+					strings.Repeat("ff", 31)+"fe",
+			),
+			result,
+		)
+	})
+
+	for name, code := range map[string]string{
+		"encode unsupported type": `
+          pub fun main() {
+              ABI.encode([0x1 as Address])
+          }
+        `,
+		"encode out of range": `
+          pub fun main() {
+              ABI.encode([UInt(1) << 256])
+          }
+        `,
+		"decode unsupported type": `
+          pub fun main() {
+              ABI.decode([], types: [Type<Address>()])
+          }
+        `,
+		"decode truncated data": `
+          pub fun main() {
+              ABI.decode([0, 1], types: [Type<UInt8>()])
+          }
+        `,
+		"decode out of range": `
+          pub fun main() {
+              ABI.decode(ABI.encode([256 as UInt16]), types: [Type<UInt8>()])
+          }
+        `,
+		"decode invalid bool": `
+          pub fun main() {
+              ABI.decode(ABI.encode([2 as UInt8]), types: [Type<Bool>()])
+          }
+        `,
+		"decode invalid offset": `
+          pub fun main() {
+              ABI.decode(ABI.encode([1000 as UInt256]), types: [Type<String>()])
+          }
+        `,
+		"decode overlapping offsets": `
+          pub fun main() {
+              // Both elements of the array point to the same bytes
+              let data = ABI.encode([
+                  0x20 as UInt256,
+                  2 as UInt256,
+                  0x40 as UInt256,
+                  0x40 as UInt256,
+                  1 as UInt256,
+                  (0x61 as UInt256) << 248
+              ])
+              ABI.decode(data, types: [Type<[[UInt8]]>()])
+          }
+        `,
+		"decode huge constant-sized array": `
+          pub fun main() {
+              ABI.decode(
+                  ABI.encode([0x20 as UInt256, 0 as UInt256]),
+                  types: [Type<[String; 10000000000000]>()]
+              )
+          }
+        `,
+		"decode huge nested constant-sized array": `
+          pub fun main() {
+              ABI.decode(
+                  ABI.encode([0x20 as UInt256, 0 as UInt256]),
+                  types: [Type<[[[[String; 10000000000000]]; 10000000000000]; 10000000000000]>()]
+              )
+          }
+        `,
+		"decode overflowing constant-sized array": `
+          pub fun main() {
+              ABI.decode(
+                  ABI.encode([0x20 as UInt256, 0 as UInt256]),
+                  types: [Type<[[UInt256; 10000000000000]; 10000000000000]>()]
+              )
+          }
+        `,
+		"decode empty constant-sized array": `
+          pub fun main() {
+              ABI.decode(ABI.encode([]), types: [Type<[UInt256; 0]>()])
+          }
+        `,
+	} {
+		code := code
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := executeScript(code)
+			require.Error(t, err)
+
+			require.ErrorAs(t, err, &stdlib.ABIError{})
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
 	"github.com/onflow/cadence/runtime/tests/utils"
@@ -1764,4 +1765,156 @@ func TestGetAuthAccount(t *testing.T) {
 
 		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
 	})
+
+	t.Run("not read-only by default", func(t *testing.T) {
+		t.Parallel()
+
+		rt := newTestInterpreterRuntime()
+
+		script := []byte(`
+            pub fun main(): Int {
+                let acc = getAuthAccount(0x02)
+                acc.save(1, to: /storage/one)
+                return acc.load<Int>(from: /storage/one)!
+            }
+        `)
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+		}
+
+		result, err := rt.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{0x1},
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(1), result)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		t.Parallel()
+
+		type testCase struct {
+			functionName string
+			code         string
+		}
+
+		for name, testCase := range map[string]testCase{
+			"save":            {"save", `acc.save(1, to: /storage/one)`},
+			"load":            {"load", `acc.load<Int>(from: /storage/one)`},
+			"replace":         {"replace", `acc.replace(1, at: /storage/one)`},
+			"link":            {"link", `acc.link<&Int>(/public/one, target: /storage/one)`},
+			"unlink":          {"unlink", `acc.unlink(/public/one)`},
+			"addPublicKey":    {"addPublicKey", `acc.addPublicKey([])`},
+			"removePublicKey": {"removePublicKey", `acc.removePublicKey(0)`},
+			"keys.add": {
+				"add",
+				`
+                  acc.keys.add(
+                      publicKey: PublicKey(
+                          publicKey: [],
+                          signatureAlgorithm: SignatureAlgorithm.ECDSA_P256
+                      ),
+                      hashAlgorithm: HashAlgorithm.SHA3_256,
+                      weight: 1.0
+                  )
+                `,
+			},
+			"keys.revoke":                    {"revoke", `acc.keys.revoke(keyIndex: 0)`},
+			"contracts.add":                  {"add", `acc.contracts.add(name: "C", code: [])`},
+			"contracts.update__experimental": {"update__experimental", `acc.contracts.update__experimental(name: "C", code: [])`},
+			"contracts.remove":               {"remove", `acc.contracts.remove(name: "C")`},
+		} {
+
+			script := []byte(fmt.Sprintf(
+				`
+                  pub fun main() {
+                      let acc = getAuthAccount(0x02)
+                      %s
+                  }
+                `,
+				testCase.code,
+			))
+
+			rt := newTestInterpreterRuntime(WithReadOnlyScriptAuthAccounts(true))
+
+			runtimeInterface := &testRuntimeInterface{
+				storage: newTestLedger(nil, nil),
+				validatePublicKey: func(_ *PublicKey) (bool, error) {
+					return true, nil
+				},
+			}
+
+			_, err := rt.ExecuteScript(
+				Script{
+					Source: script,
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  common.ScriptLocation{0x1},
+				},
+			)
+
+			require.Error(t, err, name)
+
+			var readOnlyErr interpreter.ReadOnlyAccountError
+			require.ErrorAs(t, err, &readOnlyErr, name)
+			assert.Equal(t, testCase.functionName, readOnlyErr.FunctionName, name)
+		}
+	})
+
+	t.Run("read storage", func(t *testing.T) {
+		t.Parallel()
+
+		rt := newTestInterpreterRuntime(WithReadOnlyScriptAuthAccounts(true))
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+			getSigningAccounts: func() ([]Address, error) {
+				return []Address{common.MustBytesToAddress([]byte{0x2})}, nil
+			},
+		}
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		err := rt.ExecuteTransaction(
+			Script{
+				Source: []byte(`
+                  transaction {
+                      prepare(signer: AuthAccount) {
+                          signer.save("private", to: /storage/secret)
+                      }
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		result, err := rt.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  pub fun main(): String {
+                      let acc = getAuthAccount(0x02)
+                      return acc.copy<String>(from: /storage/secret)!
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{0x1},
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, cadence.String("private"), result)
+	})
 }
@@ -391,6 +391,71 @@ func (e *ArrayExpression) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// TupleExpression
+
+type TupleExpression struct {
+	Elements []Expression
+	Range
+}
+
+var _ Expression = &TupleExpression{}
+
+func (*TupleExpression) isExpression() {}
+
+func (*TupleExpression) isIfStatementTest() {}
+
+func (e *TupleExpression) Accept(visitor Visitor) Repr {
+	return e.AcceptExp(visitor)
+}
+
+func (e *TupleExpression) Walk(walkChild func(Element)) {
+	walkExpressions(walkChild, e.Elements)
+}
+
+func (e *TupleExpression) AcceptExp(visitor ExpressionVisitor) Repr {
+	return visitor.VisitTupleExpression(e)
+}
+
+func (e *TupleExpression) String() string {
+	var builder strings.Builder
+	builder.WriteString("(")
+	for i, element := range e.Elements {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(element.String())
+	}
+	builder.WriteString(")")
+	return builder.String()
+}
+
+var tupleExpressionSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (e *TupleExpression) Doc() prettier.Doc {
+	elementDocs := make([]prettier.Doc, len(e.Elements))
+	for i, element := range e.Elements {
+		elementDocs[i] = element.Doc()
+	}
+	return prettier.WrapParentheses(
+		prettier.Join(tupleExpressionSeparatorDoc, elementDocs...),
+		prettier.SoftLine{},
+	)
+}
+
+func (e *TupleExpression) MarshalJSON() ([]byte, error) {
+	type Alias TupleExpression
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "TupleExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
 // DictionaryExpression
 
 type DictionaryExpression struct {
@@ -1092,6 +1157,7 @@ func (e *BinaryExpression) MarshalJSON() ([]byte, error) {
 // FunctionExpression
 
 type FunctionExpression struct {
+	Purity               FunctionPurity
 	ParameterList        *ParameterList
 	ReturnTypeAnnotation *TypeAnnotation
 	FunctionBlock        *FunctionBlock
@@ -1124,12 +1190,14 @@ func (e *FunctionExpression) String() string {
 }
 
 var functionExpressionFunKeywordDoc prettier.Doc = prettier.Text("fun ")
+var functionExpressionViewKeywordDoc prettier.Doc = prettier.Text("view ")
 var functionExpressionParameterSeparatorDoc prettier.Doc = prettier.Concat{
 	prettier.Text(","),
 	prettier.Line{},
 }
 
 var typeSeparatorDoc prettier.Doc = prettier.Text(": ")
+var defaultArgumentSeparatorDoc prettier.Doc = prettier.Text(" = ")
 var functionExpressionEmptyBlockDoc prettier.Doc = prettier.Text(" {}")
 
 func (e *FunctionExpression) Doc() prettier.Doc {
@@ -1146,12 +1214,19 @@ func (e *FunctionExpression) Doc() prettier.Doc {
 		}
 	}
 
-	doc := prettier.Concat{
+	var doc prettier.Concat
+
+	if e.Purity == FunctionPurityView {
+		doc = append(doc, functionExpressionViewKeywordDoc)
+	}
+
+	doc = append(
+		doc,
 		functionExpressionFunKeywordDoc,
 		prettier.Group{
 			Doc: signatureDoc,
 		},
-	}
+	)
 
 	if e.FunctionBlock.IsEmpty() {
 		return append(doc, functionExpressionEmptyBlockDoc)
@@ -1196,6 +1271,14 @@ func (e *FunctionExpression) parametersDoc() prettier.Doc {
 			parameter.TypeAnnotation.Doc(),
 		)
 
+		if parameter.DefaultArgument != nil {
+			parameterDoc = append(
+				parameterDoc,
+				defaultArgumentSeparatorDoc,
+				parameter.DefaultArgument.Doc(),
+			)
+		}
+
 		parameterDocs = append(parameterDocs, parameterDoc)
 	}
 
@@ -48,6 +48,10 @@ type ArrayExtractor interface {
 	ExtractArray(extractor *ExpressionExtractor, expression *ArrayExpression) ExpressionExtraction
 }
 
+type TupleExtractor interface {
+	ExtractTuple(extractor *ExpressionExtractor, expression *TupleExpression) ExpressionExtraction
+}
+
 type DictionaryExtractor interface {
 	ExtractDictionary(extractor *ExpressionExtractor, expression *DictionaryExpression) ExpressionExtraction
 }
@@ -116,6 +120,7 @@ type ExpressionExtractor struct {
 	FixedPointExtractor  FixedPointExtractor
 	StringExtractor      StringExtractor
 	ArrayExtractor       ArrayExtractor
+	TupleExtractor       TupleExtractor
 	DictionaryExtractor  DictionaryExtractor
 	IdentifierExtractor  IdentifierExtractor
 	InvocationExtractor  InvocationExtractor
@@ -297,6 +302,35 @@ func (extractor *ExpressionExtractor) ExtractArray(expression *ArrayExpression)
 	}
 }
 
+func (extractor *ExpressionExtractor) VisitTupleExpression(expression *TupleExpression) Repr {
+
+	// delegate to child extractor, if any,
+	// or call default implementation
+
+	if extractor.TupleExtractor != nil {
+		return extractor.TupleExtractor.ExtractTuple(extractor, expression)
+	}
+	return extractor.ExtractTuple(expression)
+}
+
+func (extractor *ExpressionExtractor) ExtractTuple(expression *TupleExpression) ExpressionExtraction {
+
+	// copy the expression
+	newExpression := *expression
+
+	// rewrite all element expressions
+
+	rewrittenExpressions, extractedExpressions :=
+		extractor.VisitExpressions(expression.Elements)
+
+	newExpression.Elements = rewrittenExpressions
+
+	return ExpressionExtraction{
+		RewrittenExpression:  &newExpression,
+		ExtractedExpressions: extractedExpressions,
+	}
+}
+
 func (extractor *ExpressionExtractor) VisitExpressions(
 	expressions []Expression,
 ) (
@@ -1899,6 +1899,7 @@ func TestFunctionExpression_MarshalJSON(t *testing.T) {
                 "StartPos": {"Offset": 16, "Line": 17, "Column": 18},
                 "EndPos": {"Offset": 19, "Line": 20, "Column": 21}
             },
+            "Purity": "FunctionPurityUnspecified",
             "ReturnTypeAnnotation": {
                 "IsResource": true,
                 "AnnotatedType": {
@@ -26,6 +26,7 @@ import (
 
 type FunctionDeclaration struct {
 	Access               Access
+	Purity               FunctionPurity
 	Identifier           Identifier
 	ParameterList        *ParameterList
 	ReturnTypeAnnotation *TypeAnnotation
@@ -77,6 +78,7 @@ func (d *FunctionDeclaration) DeclarationAccess() Access {
 
 func (d *FunctionDeclaration) ToExpression() *FunctionExpression {
 	return &FunctionExpression{
+		Purity:               d.Purity,
 		ParameterList:        d.ParameterList,
 		ReturnTypeAnnotation: d.ReturnTypeAnnotation,
 		FunctionBlock:        d.FunctionBlock,
@@ -133,6 +133,7 @@ func TestFunctionDeclaration_MarshalJSON(t *testing.T) {
                 "StartPos": {"Offset": 16, "Line": 17, "Column": 18},
                 "EndPos": {"Offset": 19, "Line": 20, "Column": 21}
             },
+            "Purity": "FunctionPurityUnspecified",
             "ReturnTypeAnnotation": {
                 "IsResource": true,
                 "AnnotatedType": {
@@ -279,6 +280,7 @@ func TestSpecialFunctionDeclaration_MarshalJSON(t *testing.T) {
                     "StartPos": {"Offset": 16, "Line": 17, "Column": 18},
                     "EndPos": {"Offset": 19, "Line": 20, "Column": 21}
                 },
+                "Purity": "FunctionPurityUnspecified",
                 "ReturnTypeAnnotation": {
                     "IsResource": true,
                     "AnnotatedType": {
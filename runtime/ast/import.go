@@ -19,6 +19,7 @@
 package ast
 
 import (
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -29,7 +30,10 @@ import (
 type ImportDeclaration struct {
 	Identifiers []Identifier
 	Location    common.Location
+	// Hash is the optional expected hash of the imported code
+	Hash        []byte
 	LocationPos Position
+	HashPos     Position
 	Range
 }
 
@@ -67,11 +71,23 @@ func (d *ImportDeclaration) DeclarationDocString() string {
 
 func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias ImportDeclaration
+
+	var hash string
+	var hashPos *Position
+	if d.Hash != nil {
+		hash = hex.EncodeToString(d.Hash)
+		hashPos = &d.HashPos
+	}
+
 	return json.Marshal(&struct {
-		Type string
+		Type    string
+		Hash    string    `json:",omitempty"`
+		HashPos *Position `json:",omitempty"`
 		*Alias
 	}{
-		Type:  "ImportDeclaration",
-		Alias: (*Alias)(d),
+		Type:    "ImportDeclaration",
+		Hash:    hash,
+		HashPos: hashPos,
+		Alias:   (*Alias)(d),
 	})
 }
@@ -73,3 +73,41 @@ func TestImportDeclaration_MarshalJSON(t *testing.T) {
 		string(actual),
 	)
 }
+
+func TestImportDeclaration_MarshalJSON_Hash(t *testing.T) {
+
+	t.Parallel()
+
+	ty := &ImportDeclaration{
+		Location:    common.StringLocation("test"),
+		Hash:        []byte{0xca, 0xfe},
+		LocationPos: Position{Offset: 1, Line: 2, Column: 3},
+		HashPos:     Position{Offset: 4, Line: 5, Column: 6},
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 8, Column: 9},
+			EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+		},
+	}
+
+	actual, err := json.Marshal(ty)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "ImportDeclaration", 
+            "Identifiers": null,
+            "Location": {
+                "Type": "StringLocation",
+                "String": "test"
+            },
+            "Hash": "cafe",
+            "LocationPos": {"Offset": 1, "Line": 2, "Column": 3},
+            "HashPos": {"Offset": 4, "Line": 5, "Column": 6},
+            "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+            "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+        }
+        `,
+		string(actual),
+	)
+}
@@ -22,6 +22,9 @@ type Parameter struct {
 	Label          string
 	Identifier     Identifier
 	TypeAnnotation *TypeAnnotation
+	// DefaultArgument is the optional expression
+	// which is used when no argument is passed for the parameter
+	DefaultArgument Expression `json:",omitempty"`
 	Range
 }
 
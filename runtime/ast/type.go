@@ -346,6 +346,7 @@ func (t *DictionaryType) CheckEqual(other Type, checker TypeEqualityChecker) err
 // FunctionType
 
 type FunctionType struct {
+	Purity                   FunctionPurity
 	ParameterTypeAnnotations []*TypeAnnotation `json:",omitempty"`
 	ReturnTypeAnnotation     *TypeAnnotation
 	Range
@@ -364,13 +365,19 @@ func (t *FunctionType) String() string {
 		parameters.WriteString(parameterTypeAnnotation.String())
 	}
 
-	return fmt.Sprintf("((%s): %s)", parameters.String(), t.ReturnTypeAnnotation.String())
+	var purity string
+	if t.Purity == FunctionPurityView {
+		purity = t.Purity.Keyword() + " "
+	}
+
+	return fmt.Sprintf("(%s(%s): %s)", purity, parameters.String(), t.ReturnTypeAnnotation.String())
 }
 
 const functionTypeStartDoc = prettier.Text("(")
 const functionTypeEndDoc = prettier.Text(")")
 const functionTypeTypeSeparatorSpaceDoc = prettier.Text(": ")
 const functionTypeParameterSeparatorDoc = prettier.Text(",")
+const functionTypeViewKeywordDoc = prettier.Text("view ")
 
 func (t *FunctionType) Doc() prettier.Doc {
 	parametersDoc := prettier.Concat{
@@ -391,8 +398,16 @@ func (t *FunctionType) Doc() prettier.Doc {
 		)
 	}
 
-	return prettier.Concat{
+	doc := prettier.Concat{
 		functionTypeStartDoc,
+	}
+
+	if t.Purity == FunctionPurityView {
+		doc = append(doc, functionTypeViewKeywordDoc)
+	}
+
+	return append(
+		doc,
 		prettier.Group{
 			Doc: prettier.Concat{
 				functionTypeStartDoc,
@@ -406,7 +421,7 @@ func (t *FunctionType) Doc() prettier.Doc {
 		functionTypeTypeSeparatorSpaceDoc,
 		t.ReturnTypeAnnotation.Doc(),
 		functionTypeEndDoc,
-	}
+	)
 }
 
 func (t *FunctionType) MarshalJSON() ([]byte, error) {
@@ -424,6 +439,79 @@ func (t *FunctionType) CheckEqual(other Type, checker TypeEqualityChecker) error
 	return checker.CheckFunctionTypeEquality(t, other)
 }
 
+// TupleType
+
+type TupleType struct {
+	ElementTypeAnnotations []*TypeAnnotation `json:",omitempty"`
+	Range
+}
+
+var _ Type = &TupleType{}
+
+func (*TupleType) isType() {}
+
+func (t *TupleType) String() string {
+	var elements strings.Builder
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		if i > 0 {
+			elements.WriteString(", ")
+		}
+		elements.WriteString(elementTypeAnnotation.String())
+	}
+
+	return fmt.Sprintf("(%s)", elements.String())
+}
+
+const tupleTypeStartDoc = prettier.Text("(")
+const tupleTypeEndDoc = prettier.Text(")")
+const tupleTypeElementSeparatorDoc = prettier.Text(",")
+
+func (t *TupleType) Doc() prettier.Doc {
+	elementsDoc := prettier.Concat{
+		prettier.SoftLine{},
+	}
+
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		if i > 0 {
+			elementsDoc = append(
+				elementsDoc,
+				tupleTypeElementSeparatorDoc,
+				prettier.Line{},
+			)
+		}
+		elementsDoc = append(
+			elementsDoc,
+			elementTypeAnnotation.Doc(),
+		)
+	}
+
+	return prettier.Group{
+		Doc: prettier.Concat{
+			tupleTypeStartDoc,
+			prettier.Indent{
+				Doc: elementsDoc,
+			},
+			prettier.SoftLine{},
+			tupleTypeEndDoc,
+		},
+	}
+}
+
+func (t *TupleType) MarshalJSON() ([]byte, error) {
+	type Alias TupleType
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "TupleType",
+		Alias: (*Alias)(t),
+	})
+}
+
+func (t *TupleType) CheckEqual(other Type, checker TypeEqualityChecker) error {
+	return checker.CheckTupleTypeEquality(t, other)
+}
+
 // ReferenceType
 
 type ReferenceType struct {
@@ -670,6 +758,7 @@ type TypeEqualityChecker interface {
 	CheckConstantSizedTypeEquality(*ConstantSizedType, Type) error
 	CheckDictionaryTypeEquality(*DictionaryType, Type) error
 	CheckFunctionTypeEquality(*FunctionType, Type) error
+	CheckTupleTypeEquality(*TupleType, Type) error
 	CheckReferenceTypeEquality(*ReferenceType, Type) error
 	CheckRestrictedTypeEquality(*RestrictedType, Type) error
 	CheckInstantiationTypeEquality(*InstantiationType, Type) error
@@ -589,6 +589,7 @@ func TestFunctionType_MarshalJSON(t *testing.T) {
                     "EndPos": {"Offset": 2, "Line": 2, "Column": 4}
                 }
            ],
+           "Purity": "FunctionPurityUnspecified",
            "ReturnTypeAnnotation": {
                "IsResource": true,
                "AnnotatedType": {
@@ -140,3 +140,102 @@ func (d *VariableDeclaration) MarshalJSON() ([]byte, error) {
 		Alias: (*Alias)(d),
 	})
 }
+
+// TupleVariableDeclaration declares a variable for each element of a tuple,
+// e.g. `let (a, b) = f()`
+
+type TupleVariableDeclaration struct {
+	IsConstant     bool
+	Identifiers    []Identifier
+	TypeAnnotation *TypeAnnotation
+	Value          Expression
+	Transfer       *Transfer
+	StartPos       Position `json:"-"`
+}
+
+var _ Statement = &TupleVariableDeclaration{}
+
+func (d *TupleVariableDeclaration) StartPosition() Position {
+	return d.StartPos
+}
+
+func (d *TupleVariableDeclaration) EndPosition() Position {
+	return d.Value.EndPosition()
+}
+
+func (*TupleVariableDeclaration) isStatement() {}
+
+func (d *TupleVariableDeclaration) Accept(visitor Visitor) Repr {
+	return visitor.VisitTupleVariableDeclaration(d)
+}
+
+func (d *TupleVariableDeclaration) Walk(walkChild func(Element)) {
+	// TODO: walk type
+	walkChild(d.Value)
+}
+
+// DeclarationKind returns the declaration kind of the declared variables
+//
+func (d *TupleVariableDeclaration) DeclarationKind() common.DeclarationKind {
+	if d.IsConstant {
+		return common.DeclarationKindConstant
+	}
+	return common.DeclarationKindVariable
+}
+
+var tupleVariableDeclarationSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (d *TupleVariableDeclaration) Doc() prettier.Doc {
+	keywordDoc := varKeywordDoc
+	if d.IsConstant {
+		keywordDoc = letKeywordDoc
+	}
+
+	identifierDocs := make([]prettier.Doc, len(d.Identifiers))
+	for i, identifier := range d.Identifiers {
+		identifierDocs[i] = prettier.Text(identifier.Identifier)
+	}
+
+	// TODO: potentially parenthesize
+	valueDoc := d.Value.Doc()
+
+	return prettier.Group{
+		Doc: prettier.Concat{
+			keywordDoc,
+			prettier.Space,
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.WrapParentheses(
+						prettier.Join(tupleVariableDeclarationSeparatorDoc, identifierDocs...),
+						prettier.SoftLine{},
+					),
+					prettier.Space,
+					// TODO: type annotation, if any
+					d.Transfer.Doc(),
+					prettier.Space,
+					prettier.Group{
+						Doc: prettier.Indent{
+							Doc: valueDoc,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TupleVariableDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias TupleVariableDeclaration
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "TupleVariableDeclaration",
+		Range: NewRangeFromPositioned(d),
+		Alias: (*Alias)(d),
+	})
+}
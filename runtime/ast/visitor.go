@@ -55,6 +55,7 @@ type StatementVisitor interface {
 	VisitForStatement(*ForStatement) Repr
 	VisitEmitStatement(*EmitStatement) Repr
 	VisitVariableDeclaration(*VariableDeclaration) Repr
+	VisitTupleVariableDeclaration(*TupleVariableDeclaration) Repr
 	VisitAssignmentStatement(*AssignmentStatement) Repr
 	VisitSwapStatement(*SwapStatement) Repr
 	VisitExpressionStatement(*ExpressionStatement) Repr
@@ -66,6 +67,7 @@ type ExpressionVisitor interface {
 	VisitIntegerExpression(*IntegerExpression) Repr
 	VisitFixedPointExpression(*FixedPointExpression) Repr
 	VisitArrayExpression(*ArrayExpression) Repr
+	VisitTupleExpression(*TupleExpression) Repr
 	VisitDictionaryExpression(*DictionaryExpression) Repr
 	VisitIdentifierExpression(*IdentifierExpression) Repr
 	VisitInvocationExpression(*InvocationExpression) Repr
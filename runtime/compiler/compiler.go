@@ -116,6 +116,11 @@ func (compiler *Compiler) VisitVariableDeclaration(declaration *ast.VariableDecl
 	}
 }
 
+func (compiler *Compiler) VisitTupleVariableDeclaration(_ *ast.TupleVariableDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitAssignmentStatement(_ *ast.AssignmentStatement) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -260,6 +265,11 @@ func (compiler *Compiler) VisitForceExpression(_ *ast.ForceExpression) ast.Repr
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTupleExpression(_ *ast.TupleExpression) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitPathExpression(_ *ast.PathExpression) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -63,6 +63,8 @@ func ExportType(t sema.Type, results map[sema.TypeID]cadence.Type) cadence.Type
 			return exportRestrictedType(t, results)
 		case *sema.CapabilityType:
 			return exportCapabilityType(t, results)
+		case *sema.TupleType:
+			return exportTupleType(t, results)
 		}
 
 		switch t {
@@ -118,6 +120,10 @@ func ExportType(t sema.Type, results map[sema.TypeID]cadence.Type) cadence.Type
 			return cadence.Fix64Type{}
 		case sema.UFix64Type:
 			return cadence.UFix64Type{}
+		case sema.Fix128Type:
+			return cadence.Fix128Type{}
+		case sema.UFix128Type:
+			return cadence.UFix128Type{}
 		case sema.PathType:
 			return cadence.PathType{}
 		case sema.StoragePathType:
@@ -409,6 +415,18 @@ func exportCapabilityType(t *sema.CapabilityType, results map[sema.TypeID]cadenc
 	}
 }
 
+func exportTupleType(t *sema.TupleType, results map[sema.TypeID]cadence.Type) cadence.TupleType {
+	elementTypes := make([]cadence.Type, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		elementTypes[i] = ExportType(elementType, results)
+	}
+
+	return cadence.TupleType{
+		ElementTypes: elementTypes,
+	}
+}
+
 func importInterfaceType(t cadence.InterfaceType) interpreter.InterfaceStaticType {
 	return interpreter.InterfaceStaticType{
 		Location:            t.InterfaceTypeLocation(),
@@ -501,6 +519,10 @@ func ImportType(t cadence.Type) interpreter.StaticType {
 		return interpreter.PrimitiveStaticTypeFix64
 	case cadence.UFix64Type:
 		return interpreter.PrimitiveStaticTypeUFix64
+	case cadence.Fix128Type:
+		return interpreter.PrimitiveStaticTypeFix128
+	case cadence.UFix128Type:
+		return interpreter.PrimitiveStaticTypeUFix128
 	case cadence.VariableSizedArrayType:
 		return interpreter.VariableSizedStaticType{
 			Type: ImportType(t.ElementType),
@@ -515,6 +537,14 @@ func ImportType(t cadence.Type) interpreter.StaticType {
 			KeyType:   ImportType(t.KeyType),
 			ValueType: ImportType(t.ElementType),
 		}
+	case cadence.TupleType:
+		elementTypes := make([]interpreter.StaticType, len(t.ElementTypes))
+		for i, elementType := range t.ElementTypes {
+			elementTypes[i] = ImportType(elementType)
+		}
+		return &interpreter.TupleStaticType{
+			ElementTypes: elementTypes,
+		}
 	case *cadence.StructType,
 		*cadence.ResourceType,
 		*cadence.EventType,
@@ -70,6 +70,8 @@ func exportValueWithInterpreter(
 		return cadence.NewString(v.Str)
 	case *interpreter.ArrayValue:
 		return exportArrayValue(v, inter, seenReferences)
+	case *interpreter.TupleValue:
+		return exportTupleValue(v, inter, seenReferences)
 	case interpreter.IntValue:
 		return cadence.NewIntFromBig(v.ToBigInt()), nil
 	case interpreter.Int8Value:
@@ -110,6 +112,10 @@ func exportValueWithInterpreter(
 		return cadence.Fix64(v), nil
 	case interpreter.UFix64Value:
 		return cadence.UFix64(v), nil
+	case interpreter.Fix128Value:
+		return cadence.NewFix128FromBig(v.BigInt)
+	case interpreter.UFix128Value:
+		return cadence.NewUFix128FromBig(v.BigInt)
 	case *interpreter.CompositeValue:
 		return exportCompositeValue(v, inter, seenReferences)
 	case *interpreter.SimpleCompositeValue:
@@ -196,6 +202,27 @@ func exportArrayValue(
 	return cadence.NewArray(values), nil
 }
 
+func exportTupleValue(
+	v *interpreter.TupleValue,
+	inter *interpreter.Interpreter,
+	seenReferences seenReferences,
+) (
+	cadence.Tuple,
+	error,
+) {
+	elements := make([]cadence.Value, len(v.Elements))
+
+	for i, element := range v.Elements {
+		exportedElement, err := exportValueWithInterpreter(element, inter, seenReferences)
+		if err != nil {
+			return cadence.Tuple{}, err
+		}
+		elements[i] = exportedElement
+	}
+
+	return cadence.NewTuple(elements), nil
+}
+
 func exportCompositeValue(
 	v *interpreter.CompositeValue,
 	inter *interpreter.Interpreter,
@@ -491,10 +518,16 @@ func importValue(inter *interpreter.Interpreter, value cadence.Value, expectedTy
 		return interpreter.Fix64Value(v), nil
 	case cadence.UFix64:
 		return interpreter.UFix64Value(v), nil
+	case cadence.Fix128:
+		return interpreter.NewFix128ValueFromBigInt(v.Value), nil
+	case cadence.UFix128:
+		return interpreter.NewUFix128ValueFromBigInt(v.Value), nil
 	case cadence.Path:
 		return importPathValue(v), nil
 	case cadence.Array:
 		return importArrayValue(inter, v, expectedType)
+	case cadence.Tuple:
+		return importTupleValue(inter, v, expectedType)
 	case cadence.Dictionary:
 		return importDictionaryValue(inter, v, expectedType)
 	case cadence.Struct:
@@ -687,6 +720,41 @@ func importArrayValue(
 	), nil
 }
 
+func importTupleValue(
+	inter *interpreter.Interpreter,
+	v cadence.Tuple,
+	expectedType sema.Type,
+) (
+	*interpreter.TupleValue,
+	error,
+) {
+	elements := make([]interpreter.Value, len(v.Elements))
+
+	tupleType, ok := expectedType.(*sema.TupleType)
+	if ok && len(tupleType.ElementTypes) != len(v.Elements) {
+		return nil, fmt.Errorf(
+			"cannot import tuple: expected %d elements, got %d",
+			len(tupleType.ElementTypes),
+			len(v.Elements),
+		)
+	}
+
+	for i, element := range v.Elements {
+		var elementType sema.Type
+		if tupleType != nil {
+			elementType = tupleType.ElementTypes[i]
+		}
+
+		value, err := importValue(inter, element, elementType)
+		if err != nil {
+			return nil, err
+		}
+		elements[i] = value
+	}
+
+	return interpreter.NewTupleValueNonCopying(elements...), nil
+}
+
 func importDictionaryValue(
 	inter *interpreter.Interpreter,
 	v cadence.Dictionary,
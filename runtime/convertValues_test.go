@@ -20,6 +20,7 @@ package runtime
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 	"unicode/utf8"
 
@@ -330,6 +331,16 @@ func TestExportValue(t *testing.T) {
 			value:    interpreter.UFix64Value(123000000),
 			expected: cadence.UFix64(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    interpreter.NewFix128ValueFromBigInt(big.NewInt(-123000000)),
+			expected: cadence.Fix128{Value: big.NewInt(-123000000)},
+		},
+		{
+			label:    "UFix128",
+			value:    interpreter.NewUFix128ValueFromBigInt(big.NewInt(123000000)),
+			expected: cadence.UFix128{Value: big.NewInt(123000000)},
+		},
 		{
 			label: "Path",
 			value: interpreter.PathValue{
@@ -707,6 +718,16 @@ func TestImportValue(t *testing.T) {
 			value:    cadence.UFix64(123000000),
 			expected: interpreter.UFix64Value(123000000),
 		},
+		{
+			label:    "Fix128",
+			value:    cadence.Fix128{Value: big.NewInt(-123000000)},
+			expected: interpreter.NewFix128ValueFromBigInt(big.NewInt(-123000000)),
+		},
+		{
+			label:    "UFix128",
+			value:    cadence.UFix128{Value: big.NewInt(123000000)},
+			expected: interpreter.NewUFix128ValueFromBigInt(big.NewInt(123000000)),
+		},
 		{
 			label: "Path",
 			value: cadence.Path{
@@ -3061,6 +3082,123 @@ func TestRuntimeImportExportArrayValue(t *testing.T) {
 	})
 }
 
+func TestRuntimeImportExportTupleValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("export", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := interpreter.NewTupleValueNonCopying(
+			interpreter.NewIntValueFromInt64(42),
+			interpreter.NewStringValue("foo"),
+		)
+
+		actual, err := exportValueWithInterpreter(value, nil, seenReferences{})
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewTuple([]cadence.Value{
+				cadence.NewInt(42),
+				cadence.String("foo"),
+			}),
+			actual,
+		)
+	})
+
+	t.Run("import", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := cadence.NewTuple([]cadence.Value{
+			cadence.NewInt(42),
+			cadence.NewOptional(cadence.String("foo")),
+		})
+
+		inter := newTestInterpreter(t)
+
+		actual, err := importValue(
+			inter,
+			value,
+			&sema.TupleType{
+				ElementTypes: []sema.Type{
+					sema.IntType,
+					&sema.OptionalType{
+						Type: sema.StringType,
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewTupleValueNonCopying(
+				interpreter.NewIntValueFromInt64(42),
+				interpreter.NewSomeValueNonCopying(
+					interpreter.NewStringValue("foo"),
+				),
+			),
+			actual,
+		)
+	})
+
+	t.Run("import, invalid element count", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := cadence.NewTuple([]cadence.Value{
+			cadence.NewInt(42),
+		})
+
+		inter := newTestInterpreter(t)
+
+		_, err := importValue(
+			inter,
+			value,
+			&sema.TupleType{
+				ElementTypes: []sema.Type{
+					sema.IntType,
+					sema.IntType,
+				},
+			},
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("script argument and return value", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          pub fun main(pair: (Int, String)): (String, Int) {
+              let (number, string) = pair
+              return (string, number + 1)
+          }
+        `
+
+		actual, err := executeTestScript(
+			t,
+			script,
+			cadence.NewTuple([]cadence.Value{
+				cadence.NewInt(41),
+				cadence.String("foo"),
+			}),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewTuple([]cadence.Value{
+				cadence.String("foo"),
+				cadence.NewInt(42),
+			}),
+			actual,
+		)
+	})
+}
+
 func TestRuntimeImportExportDictionaryValue(t *testing.T) {
 
 	t.Parallel()
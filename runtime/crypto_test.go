@@ -19,6 +19,7 @@
 package runtime
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -29,6 +30,7 @@ import (
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
 	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
@@ -231,6 +233,69 @@ func TestRuntimeHashAlgorithm_hash(t *testing.T) {
 		assert.True(t, called)
 		assert.Equal(t, "some-tag", hashTag)
 	})
+
+	t.Run("hash - KECCAK_256 and BLAKE2B_256", func(t *testing.T) {
+		script := `
+            pub fun main() {
+                log(String.encodeHex(HashAlgorithm.KECCAK_256.hash([])))
+                log(String.encodeHex(HashAlgorithm.BLAKE2B_256.hash("abc".utf8)))
+            }
+        `
+
+		var loggedMessages []string
+
+		storage := newTestLedger(nil, nil)
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: storage,
+			log: func(message string) {
+				loggedMessages = append(loggedMessages, message)
+			},
+		}
+
+		_, err := executeScript(script, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				`"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"`,
+				`"bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"`,
+			},
+			loggedMessages,
+		)
+	})
+
+	t.Run("KECCAK_256 from raw value", func(t *testing.T) {
+		script := `
+            pub fun main() {
+                let algo = HashAlgorithm(rawValue: HashAlgorithm.KECCAK_256.rawValue)!
+                log(algo == HashAlgorithm.KECCAK_256)
+                log(String.encodeHex(algo.hash([])))
+            }
+        `
+
+		var loggedMessages []string
+
+		storage := newTestLedger(nil, nil)
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: storage,
+			log: func(message string) {
+				loggedMessages = append(loggedMessages, message)
+			},
+		}
+
+		_, err := executeScript(script, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"true",
+				`"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"`,
+			},
+			loggedMessages,
+		)
+	})
 }
 
 func TestRuntimeHashingAlgorithmExport(t *testing.T) {
@@ -635,3 +700,186 @@ func TestAggregateBLSPublicKeys(t *testing.T) {
 
 	assert.True(t, called)
 }
+
+func TestRuntimeRecoverSecp256k1PublicKey(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	executeScript := func(code string, inter Interface) (cadence.Value, error) {
+		return runtime.ExecuteScript(
+			Script{
+				Source: []byte(code),
+			},
+			Context{
+				Interface: inter,
+				Location:  utils.TestLocation,
+			},
+		)
+	}
+
+	t.Run("recover and derive EVM address", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          pub fun main(): String {
+              let hash = "1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655".decodeHex()
+              let signature = "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c".decodeHex()
+              let publicKey = RecoverSecp256k1PublicKey(signature, hash: hash)!
+              assert(publicKey.signatureAlgorithm == SignatureAlgorithm.ECDSA_secp256k1)
+              assert(publicKey.isValid)
+              return String.encodeHex(EVMAddress(publicKey))
+          }
+        `
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+		}
+
+		result, err := executeScript(script, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.String("2c7536e3605d9c16a7a3d7b1898e529396a65c23"),
+			result,
+		)
+	})
+
+	t.Run("host", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          pub fun main(): PublicKey? {
+              let signature: [UInt8] = []
+              while signature.length < 65 {
+                  signature.append(1)
+              }
+
+              let hash: [UInt8] = []
+              while hash.length < 32 {
+                  hash.append(2)
+              }
+
+              return RecoverSecp256k1PublicKey(signature, hash: hash)
+          }
+        `
+
+		called := false
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+			recoverSecp256k1PublicKey: func(signature []byte, hash []byte) ([]byte, error) {
+				called = true
+				assert.Equal(t, bytes.Repeat([]byte{1}, stdlib.Secp256k1SignatureLength), signature)
+				assert.Equal(t, bytes.Repeat([]byte{2}, stdlib.Secp256k1HashLength), hash)
+				return nil, nil
+			},
+		}
+
+		result, err := executeScript(script, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.True(t, called)
+		assert.Equal(t, cadence.NewOptional(nil), result)
+	})
+
+	for name, code := range map[string]string{
+		"invalid signature length": `
+          pub fun main(): PublicKey? {
+              let hash: [UInt8] = []
+              while hash.length < 32 {
+                  hash.append(2)
+              }
+
+              return RecoverSecp256k1PublicKey([1, 2], hash: hash)
+          }
+        `,
+		"invalid hash length": `
+          pub fun main(): PublicKey? {
+              let signature: [UInt8] = []
+              while signature.length < 65 {
+                  signature.append(1)
+              }
+
+              return RecoverSecp256k1PublicKey(signature, hash: [3, 4])
+          }
+        `,
+	} {
+		code := code
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			called := false
+
+			runtimeInterface := &testRuntimeInterface{
+				storage: newTestLedger(nil, nil),
+				recoverSecp256k1PublicKey: func(signature []byte, hash []byte) ([]byte, error) {
+					called = true
+					return nil, nil
+				},
+			}
+
+			_, err := executeScript(code, runtimeInterface)
+			require.Error(t, err)
+
+			require.ErrorAs(t, err, &stdlib.InvalidSecp256k1RecoveryInputError{})
+
+			assert.False(t, called)
+		})
+	}
+
+	t.Run("EVMAddress of non-secp256k1 key", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          pub fun main(): [UInt8] {
+              let publicKey = PublicKey(
+                  publicKey: "0102".decodeHex(),
+                  signatureAlgorithm: SignatureAlgorithm.ECDSA_P256
+              )
+              return EVMAddress(publicKey)
+          }
+        `
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+		}
+
+		_, err := executeScript(script, runtimeInterface)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &stdlib.InvalidEVMPublicKeyError{})
+	})
+
+	t.Run("EVMAddress of invalid secp256k1 key", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          pub fun main(): [UInt8] {
+              let publicKey = PublicKey(
+                  publicKey: "0102".decodeHex(),
+                  signatureAlgorithm: SignatureAlgorithm.ECDSA_secp256k1
+              )
+              return EVMAddress(publicKey)
+          }
+        `
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+		}
+
+		_, err := executeScript(script, runtimeInterface)
+		require.Error(t, err)
+
+		var evmPublicKeyError stdlib.InvalidEVMPublicKeyError
+		require.ErrorAs(t, err, &evmPublicKeyError)
+		require.Error(t, evmPublicKeyError.Err)
+	})
+}
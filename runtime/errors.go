@@ -269,6 +269,25 @@ func (e *ParsingCheckingError) ImportLocation() common.Location {
 	return e.Location
 }
 
+// ImportHashMismatchError is reported when the code of an imported location
+// does not have the hash that the import declaration is pinned to
+//
+type ImportHashMismatchError struct {
+	ImportedLocation common.Location
+	ExpectedHash     []byte
+	ActualHash       []byte
+	ast.Range
+}
+
+func (e *ImportHashMismatchError) Error() string {
+	return fmt.Sprintf(
+		"hash of imported code %s does not match: expected %x, got %x",
+		e.ImportedLocation,
+		e.ExpectedHash,
+		e.ActualHash,
+	)
+}
+
 // InvalidContractDeploymentError
 //
 type InvalidContractDeploymentError struct {
@@ -20,6 +20,7 @@ package format
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -53,3 +54,27 @@ func UFix64(v uint64) string {
 		PadLeft(strconv.Itoa(int(fraction)), '0', sema.Fix64Scale),
 	)
 }
+
+func Fix128(v *big.Int) string {
+	integer, fraction := new(big.Int).QuoRem(v, sema.Fix128FactorBig, new(big.Int))
+	var builder strings.Builder
+	if fraction.Sign() < 0 {
+		fraction.Neg(fraction)
+		if integer.Sign() == 0 {
+			builder.WriteRune('-')
+		}
+	}
+	builder.WriteString(integer.String())
+	builder.WriteRune('.')
+	builder.WriteString(PadLeft(fraction.String(), '0', sema.Fix128Scale))
+	return builder.String()
+}
+
+func UFix128(v *big.Int) string {
+	integer, fraction := new(big.Int).QuoRem(v, sema.Fix128FactorBig, new(big.Int))
+	return fmt.Sprintf(
+		"%s.%s",
+		integer,
+		PadLeft(fraction.String(), '0', sema.Fix128Scale),
+	)
+}
@@ -19,9 +19,12 @@
 package format
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func TestUFix64(t *testing.T) {
@@ -30,3 +33,29 @@ func TestUFix64(t *testing.T) {
 
 	require.Equal(t, "99999999999.70000000", UFix64(9999999999970000000))
 }
+
+func TestFix128(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(value string, expected string) {
+		v, ok := new(big.Int).SetString(value, 10)
+		require.True(t, ok)
+		require.Equal(t, expected, Fix128(v))
+	}
+
+	test("1500000000000000000000000", "1.500000000000000000000000")
+	test("-1", "-0.000000000000000000000001")
+	test("-2000000000000000000000001", "-2.000000000000000000000001")
+}
+
+func TestUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	require.Equal(t, "0.000000000000000000000001", UFix128(big.NewInt(1)))
+	require.Equal(t,
+		"340282366920938.463463374607431768211455",
+		UFix128(sema.UFix128TypeMaxBig),
+	)
+}
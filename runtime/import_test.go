@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
@@ -146,3 +147,80 @@ func TestRuntimeExport(t *testing.T) {
 		require.IsType(t, Error{}, err)
 	})
 }
+
+func TestRuntimeImportHash(t *testing.T) {
+
+	t.Parallel()
+
+	imported := []byte(`
+      pub fun answer(): Int {
+          return 42
+      }
+    `)
+
+	newRuntimeInterface := func() *testRuntimeInterface {
+		return &testRuntimeInterface{
+			getCode: func(location Location) (bytes []byte, err error) {
+				switch location {
+				case common.IdentifierLocation("imported"):
+					return imported, nil
+				default:
+					return nil, fmt.Errorf("unknown import location: %s", location)
+				}
+			},
+		}
+	}
+
+	executeScript := func(hash []byte) (cadence.Value, error) {
+		runtime := newTestInterpreterRuntime()
+
+		script := []byte(fmt.Sprintf(
+			`
+              import answer from imported hash "%x"
+
+              pub fun main(): Int {
+                  return answer()
+              }
+            `,
+			hash,
+		))
+
+		nextTransactionLocation := newTransactionLocationGenerator()
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: newRuntimeInterface(),
+				Location:  nextTransactionLocation(),
+			},
+		)
+	}
+
+	t.Run("matching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(ImportHash(imported))
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(42), result)
+	})
+
+	t.Run("mismatching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		hash := ImportHash([]byte("other"))
+
+		_, err := executeScript(hash)
+		require.Error(t, err)
+
+		var mismatchErr *ImportHashMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+
+		require.Equal(t, common.IdentifierLocation("imported"), mismatchErr.ImportedLocation)
+		require.Equal(t, hash, mismatchErr.ExpectedHash)
+		require.Equal(t, ImportHash(imported), mismatchErr.ActualHash)
+	})
+}
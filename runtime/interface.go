@@ -107,7 +107,9 @@ type Interface interface {
 		signatureAlgorithm SignatureAlgorithm,
 		hashAlgorithm HashAlgorithm,
 	) (bool, error)
-	// Hash returns the digest of hashing the given data with using the given hash algorithm
+	// Hash returns the digest of hashing the given data with using the given hash algorithm.
+	// Hosts should support all algorithms in sema.HashAlgorithms,
+	// see stdlib.DefaultHash for a reference implementation.
 	Hash(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error)
 	// GetAccountBalance gets accounts default flow token balance.
 	GetAccountBalance(address common.Address) (value uint64, err error)
@@ -131,6 +133,12 @@ type Interface interface {
 	AggregateBLSSignatures(sigs [][]byte) ([]byte, error)
 	// AggregateBLSPublicKeys aggregates multiple BLS public keys into one.
 	AggregateBLSPublicKeys(keys []*PublicKey) (*PublicKey, error)
+	// RecoverSecp256k1PublicKey recovers the uncompressed ECDSA_secp256k1 public key
+	// (64 bytes, without the SEC 1 prefix byte) from the given 65-byte signature and 32-byte hash.
+	// It returns nil if no public key can be recovered,
+	// see stdlib.DefaultRecoverSecp256k1PublicKey for a reference implementation.
+	// The runtime validates the lengths of the signature and hash before calling this function.
+	RecoverSecp256k1PublicKey(signature []byte, hash []byte) ([]byte, error)
 	// ResourceOwnerChanged gets called when a resource's owner changed (if enabled)
 	ResourceOwnerChanged(resource *interpreter.CompositeValue, oldOwner common.Address, newOwner common.Address)
 }
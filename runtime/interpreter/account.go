@@ -21,6 +21,7 @@ package interpreter
 import (
 	"fmt"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -37,6 +38,18 @@ var authAccountFieldNames = []string{
 	sema.AuthAccountKeysField,
 }
 
+// authAccountMutatingFunctionTypes are the functions of an auth account
+// which modify the account's storage.
+// They abort when called on a read-only auth account
+//
+var authAccountMutatingFunctionTypes = map[string]*sema.FunctionType{
+	sema.AuthAccountSaveField:    sema.AuthAccountTypeSaveFunctionType,
+	sema.AuthAccountLoadField:    sema.AuthAccountTypeLoadFunctionType,
+	sema.AuthAccountReplaceField: sema.AuthAccountTypeReplaceFunctionType,
+	sema.AuthAccountLinkField:    sema.AuthAccountTypeLinkFunctionType,
+	sema.AuthAccountUnlinkField:  sema.AuthAccountTypeUnlinkFunctionType,
+}
+
 // NewAuthAccountValue constructs an auth account value.
 func NewAuthAccountValue(
 	address AddressValue,
@@ -49,6 +62,90 @@ func NewAuthAccountValue(
 	contractsConstructor func() Value,
 	keysConstructor func() Value,
 ) Value {
+	return newAuthAccountValue(
+		address,
+		accountBalanceGet,
+		accountAvailableBalanceGet,
+		storageUsedGet,
+		storageCapacityGet,
+		addPublicKeyFunction,
+		removePublicKeyFunction,
+		contractsConstructor,
+		keysConstructor,
+		false,
+	)
+}
+
+// NewReadOnlyAuthAccountValue constructs an auth account value,
+// which only allows reading the account.
+//
+// The functions which modify the account's storage abort.
+// The given contracts and keys constructors must construct values
+// which abort on modification, e.g. using NewReadOnlyAccountFunction.
+//
+func NewReadOnlyAuthAccountValue(
+	address AddressValue,
+	accountBalanceGet func() UFix64Value,
+	accountAvailableBalanceGet func() UFix64Value,
+	storageUsedGet func(interpreter *Interpreter) UInt64Value,
+	storageCapacityGet func() UInt64Value,
+	contractsConstructor func() Value,
+	keysConstructor func() Value,
+) Value {
+	return newAuthAccountValue(
+		address,
+		accountBalanceGet,
+		accountAvailableBalanceGet,
+		storageUsedGet,
+		storageCapacityGet,
+		NewReadOnlyAccountFunction(
+			address,
+			sema.AuthAccountAddPublicKeyField,
+			sema.AuthAccountTypeAddPublicKeyFunctionType,
+		),
+		NewReadOnlyAccountFunction(
+			address,
+			sema.AuthAccountRemovePublicKeyField,
+			sema.AuthAccountTypeRemovePublicKeyFunctionType,
+		),
+		contractsConstructor,
+		keysConstructor,
+		true,
+	)
+}
+
+// NewReadOnlyAccountFunction returns a function of the given type,
+// which aborts with a ReadOnlyAccountError when called.
+//
+func NewReadOnlyAccountFunction(
+	address AddressValue,
+	functionName string,
+	functionType *sema.FunctionType,
+) *HostFunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+			panic(ReadOnlyAccountError{
+				Address:       address,
+				FunctionName:  functionName,
+				LocationRange: invocation.GetLocationRange(),
+			})
+		},
+		functionType,
+	)
+}
+
+func newAuthAccountValue(
+	address AddressValue,
+	accountBalanceGet func() UFix64Value,
+	accountAvailableBalanceGet func() UFix64Value,
+	storageUsedGet func(interpreter *Interpreter) UInt64Value,
+	storageCapacityGet func() UInt64Value,
+	addPublicKeyFunction FunctionValue,
+	removePublicKeyFunction FunctionValue,
+	contractsConstructor func() Value,
+	keysConstructor func() Value,
+	readOnly bool,
+) Value {
 
 	fields := map[string]Value{
 		sema.AuthAccountAddressField:         address,
@@ -98,6 +195,12 @@ func NewAuthAccountValue(
 		sema.AuthAccountCopyField: func(inter *Interpreter, _ func() LocationRange) Value {
 			return inter.authAccountCopyFunction(address)
 		},
+		sema.AuthAccountCheckField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountCheckFunction(address)
+		},
+		sema.AuthAccountReplaceField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountReplaceFunction(address)
+		},
 		sema.AuthAccountSaveField: func(inter *Interpreter, _ func() LocationRange) Value {
 			return inter.authAccountSaveFunction(address)
 		},
@@ -113,6 +216,30 @@ func NewAuthAccountValue(
 		sema.AuthAccountGetLinkTargetField: func(inter *Interpreter, _ func() LocationRange) Value {
 			return inter.accountGetLinkTargetFunction(address)
 		},
+		sema.AuthAccountStoragePathsField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountPaths(address, common.PathDomainStorage)
+		},
+		sema.AuthAccountPublicPathsField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountPaths(address, common.PathDomainPublic)
+		},
+		sema.AuthAccountPrivatePathsField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.accountPaths(address, common.PathDomainPrivate)
+		},
+		sema.AuthAccountForEachStoredField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountForEachStoredFunction(address)
+		},
+		sema.AuthAccountForEachPublicField: func(inter *Interpreter, _ func() LocationRange) Value {
+			return inter.authAccountForEachPublicFunction(address)
+		},
+	}
+
+	if readOnly {
+		for functionName, functionType := range authAccountMutatingFunctionTypes {
+			function := NewReadOnlyAccountFunction(address, functionName, functionType)
+			computedFields[functionName] = func(_ *Interpreter, _ func() LocationRange) Value {
+				return function
+			}
+		}
 	}
 
 	var str string
@@ -131,6 +131,9 @@ func (d Decoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagSomeValue:
 			storable, err = d.decodeSome()
 
+		case CBORTagTupleValue:
+			storable, err = d.decodeTuple()
+
 		case CBORTagAddressValue:
 			storable, err = d.decodeAddress()
 
@@ -199,11 +202,17 @@ func (d Decoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagFix64Value:
 			storable, err = d.decodeFix64()
 
+		case CBORTagFix128Value:
+			storable, err = d.decodeFix128()
+
 		// UFix*
 
 		case CBORTagUFix64Value:
 			storable, err = d.decodeUFix64()
 
+		case CBORTagUFix128Value:
+			storable, err = d.decodeUFix128()
+
 		// Storage
 
 		case CBORTagPathValue:
@@ -742,6 +751,49 @@ func (d Decoder) decodeUFix64() (UFix64Value, error) {
 	return UFix64Value(value), nil
 }
 
+func (d Decoder) decodeFix128() (Fix128Value, error) {
+	bigInt, err := d.decoder.DecodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Fix128Value{}, fmt.Errorf("invalid Fix128 encoding: %s", e.ActualType.String())
+		}
+		return Fix128Value{}, err
+	}
+
+	min := sema.Fix128TypeMinBig
+	if bigInt.Cmp(min) < 0 {
+		return Fix128Value{}, fmt.Errorf("invalid Fix128: got %s, expected min %s", bigInt, min)
+	}
+
+	max := sema.Fix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return Fix128Value{}, fmt.Errorf("invalid Fix128: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewFix128ValueFromBigInt(bigInt), nil
+}
+
+func (d Decoder) decodeUFix128() (UFix128Value, error) {
+	bigInt, err := d.decoder.DecodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return UFix128Value{}, fmt.Errorf("invalid UFix128 encoding: %s", e.ActualType.String())
+		}
+		return UFix128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return UFix128Value{}, fmt.Errorf("invalid UFix128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.UFix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return UFix128Value{}, fmt.Errorf("invalid UFix128: got %s, expected max %s", bigInt, max)
+	}
+
+	return NewUFix128ValueFromBigInt(bigInt), nil
+}
+
 func (d Decoder) decodeSome() (SomeStorable, error) {
 	storable, err := d.decodeStorable()
 	if err != nil {
@@ -756,6 +808,34 @@ func (d Decoder) decodeSome() (SomeStorable, error) {
 	}, nil
 }
 
+func (d Decoder) decodeTuple() (TupleStorable, error) {
+	size, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return TupleStorable{}, fmt.Errorf(
+				"invalid tuple value encoding: expected []interface{}, got %s",
+				e.ActualType.String(),
+			)
+		}
+		return TupleStorable{}, err
+	}
+
+	storables := make([]atree.Storable, size)
+	for i := 0; i < int(size); i++ {
+		storables[i], err = d.decodeStorable()
+		if err != nil {
+			return TupleStorable{}, fmt.Errorf(
+				"invalid tuple value element encoding: %w",
+				err,
+			)
+		}
+	}
+
+	return TupleStorable{
+		Storables: storables,
+	}, nil
+}
+
 func checkEncodedAddressLength(addressBytes []byte) error {
 	actualLength := len(addressBytes)
 	const expectedLength = common.AddressLength
@@ -1068,6 +1148,9 @@ func decodeStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
 	case CBORTagCapabilityStaticType:
 		return decodeCapabilityStaticType(dec)
 
+	case CBORTagTupleStaticType:
+		return decodeTupleStaticType(dec)
+
 	default:
 		return nil, fmt.Errorf("invalid static type encoding tag: %d", number)
 	}
@@ -1457,6 +1540,34 @@ func decodeRestrictedStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
 	}, nil
 }
 
+func decodeTupleStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
+	size, err := dec.DecodeArrayHead()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return nil, fmt.Errorf(
+				"invalid tuple static type encoding: expected []interface{}, got %s",
+				e.ActualType.String(),
+			)
+		}
+		return nil, err
+	}
+
+	elementTypes := make([]StaticType, size)
+	for i := 0; i < int(size); i++ {
+		elementTypes[i], err = decodeStaticType(dec)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid tuple static type element type encoding: %w",
+				err,
+			)
+		}
+	}
+
+	return &TupleStaticType{
+		ElementTypes: elementTypes,
+	}, nil
+}
+
 func decodeCapabilityStaticType(dec *cbor.StreamDecoder) (StaticType, error) {
 	var borrowStaticType StaticType
 
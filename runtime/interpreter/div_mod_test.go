@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -3342,6 +3343,11 @@ func TestNegativeMod(t *testing.T) {
 				NewFix64ValueWithInteger(5),
 				NewFix64ValueWithInteger(-1),
 			},
+			"Fix128": {
+				NewFix128ValueWithInteger(big.NewInt(-1)),
+				NewFix128ValueWithInteger(big.NewInt(5)),
+				NewFix128ValueWithInteger(big.NewInt(-1)),
+			},
 		}
 
 		for _, integerType := range sema.AllSignedFixedPointTypes {
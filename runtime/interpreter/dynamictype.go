@@ -166,6 +166,24 @@ func (t SomeDynamicType) IsImportable() bool {
 	return t.InnerType.IsImportable()
 }
 
+// TupleDynamicType
+
+type TupleDynamicType struct {
+	ElementTypes []DynamicType
+}
+
+func (*TupleDynamicType) IsDynamicType() {}
+
+func (t *TupleDynamicType) IsImportable() bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsImportable() {
+			return false
+		}
+	}
+
+	return true
+}
+
 // StorageReferenceDynamicType
 
 type StorageReferenceDynamicType struct {
@@ -115,7 +115,7 @@ const (
 	CBORTagTypeValue
 	_ // DO *NOT* REPLACE. Previously used for array values
 	CBORTagStringValue
-	_
+	CBORTagTupleValue
 	_
 	_
 	_
@@ -168,7 +168,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	CBORTagFix64Value
-	_ // future: Fix128
+	CBORTagFix128Value
 	_ // future: Fix256
 	_
 
@@ -178,7 +178,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	CBORTagUFix64Value
-	_ // future: UFix128
+	CBORTagUFix128Value
 	_ // future: UFix256
 	_
 
@@ -218,6 +218,7 @@ const (
 	CBORTagReferenceStaticType
 	CBORTagRestrictedStaticType
 	CBORTagCapabilityStaticType
+	CBORTagTupleStaticType
 )
 
 // CBOREncMode
@@ -612,6 +613,38 @@ func (v UFix64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes Fix128Value as
+// cbor.Tag{
+//		Number:  CBORTagFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Fix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes UFix128Value as
+// cbor.Tag{
+//		Number:  CBORTagUFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v UFix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagUFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
 // Encode encodes SomeStorable as
 // cbor.Tag{
 //		Number: CBORTagSomeValue,
@@ -629,6 +662,33 @@ func (s SomeStorable) Encode(e *atree.Encoder) error {
 	return s.Storable.Encode(e)
 }
 
+// Encode encodes TupleStorable as
+// cbor.Tag{
+//		Number:  CBORTagTupleValue,
+//		Content: []interface{}(v.Storables),
+// }
+func (s TupleStorable) Encode(e *atree.Encoder) error {
+	// NOTE: when updating, also update TupleStorable.ByteSize
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagTupleValue,
+	})
+	if err != nil {
+		return err
+	}
+	err = e.CBOR.EncodeArrayHead(uint64(len(s.Storables)))
+	if err != nil {
+		return err
+	}
+	for _, storable := range s.Storables {
+		err = storable.Encode(e)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Encode encodes AddressValue as
 // cbor.Tag{
 //		Number:  CBORTagAddressValue,
@@ -1279,6 +1339,32 @@ func (t CapabilityStaticType) Encode(e *cbor.StreamEncoder) error {
 	return EncodeStaticType(e, t.BorrowType)
 }
 
+// Encode encodes TupleStaticType as
+// cbor.Tag{
+//		Number:  CBORTagTupleStaticType,
+//		Content: []interface{}(v.ElementTypes),
+// }
+func (t *TupleStaticType) Encode(e *cbor.StreamEncoder) error {
+	err := e.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagTupleStaticType,
+	})
+	if err != nil {
+		return err
+	}
+	err = e.EncodeArrayHead(uint64(len(t.ElementTypes)))
+	if err != nil {
+		return err
+	}
+	for _, elementType := range t.ElementTypes {
+		err = EncodeStaticType(e, elementType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t FunctionStaticType) Encode(_ *cbor.StreamEncoder) error {
 	return NonStorableStaticTypeError{
 		Type: t,
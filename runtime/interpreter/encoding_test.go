@@ -2350,6 +2350,74 @@ func TestEncodeDecodeWord64Value(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodeTupleValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("bool and string", func(t *testing.T) {
+
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewTupleValueNonCopying(
+					BoolValue(true),
+					NewStringValue("test"),
+				),
+				encoded: []byte{
+					// tag
+					0xd8, CBORTagTupleValue,
+					// array, 2 items follow
+					0x82,
+					// true
+					0xf5,
+					// tag
+					0xd8, CBORTagStringValue,
+					// UTF-8 string, length 4
+					0x64,
+					// t, e, s, t
+					0x74, 0x65, 0x73, 0x74,
+				},
+			},
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewTupleValueNonCopying(
+					NewTupleValueNonCopying(
+						BoolValue(false),
+						NilValue{},
+					),
+					NewSomeValueNonCopying(BoolValue(true)),
+				),
+				encoded: []byte{
+					// tag
+					0xd8, CBORTagTupleValue,
+					// array, 2 items follow
+					0x82,
+					// tag
+					0xd8, CBORTagTupleValue,
+					// array, 2 items follow
+					0x82,
+					// false
+					0xf4,
+					// null
+					0xf6,
+					// tag
+					0xd8, CBORTagSomeValue,
+					// true
+					0xf5,
+				},
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeSomeValue(t *testing.T) {
 
 	t.Parallel()
@@ -2678,6 +2746,237 @@ func TestEncodeDecodeUFix64Value(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodeFix128Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(big.NewInt(0)),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 0
+					0x40,
+				},
+			},
+		)
+	})
+
+	t.Run("positive", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(big.NewInt(42)),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(big.NewInt(-42)),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x29,
+				},
+			},
+		)
+	})
+
+	t.Run("min", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 16
+					0x50,
+					0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run("<min", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 16
+					0x50,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+				invalid: true,
+			},
+		)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run(">max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
+func TestEncodeDecodeUFix128Value(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUFix128ValueFromBigInt(big.NewInt(0)),
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 0
+					0x40,
+				},
+			},
+		)
+	})
+
+	t.Run("positive", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUFix128ValueFromBigInt(big.NewInt(42)),
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 1
+					0x41,
+					0x2a,
+				},
+			},
+		)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// negative bignum
+					0xc3,
+					// byte string, length 1
+					0x41,
+					0x29,
+				},
+				invalid: true,
+			},
+		)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 16
+					0x50,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				},
+			},
+		)
+	})
+
+	t.Run(">max", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					0xd8, CBORTagUFix128Value,
+					// positive bignum
+					0xc2,
+					// byte string, length 17
+					0x51,
+					0x01,
+					0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+					0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeAddressValue(t *testing.T) {
 
 	t.Parallel()
@@ -3732,6 +4031,46 @@ func TestEncodeDecodeTypeValue(t *testing.T) {
 		)
 	})
 
+	t.Run("tuple, Bool and Int", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := TypeValue{
+			Type: &TupleStaticType{
+				ElementTypes: []StaticType{
+					PrimitiveStaticTypeBool,
+					PrimitiveStaticTypeInt,
+				},
+			},
+		}
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagTypeValue,
+			// array, 1 items follow
+			0x81,
+			// tag
+			0xd8, CBORTagTupleStaticType,
+			// array, 2 items follow
+			0x82,
+			// tag
+			0xd8, CBORTagPrimitiveStaticType,
+			// positive integer 6
+			0x6,
+			// tag
+			0xd8, CBORTagPrimitiveStaticType,
+			// positive integer 36
+			0x18, 0x24,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
 	t.Run("without static type", func(t *testing.T) {
 
 		t.Parallel()
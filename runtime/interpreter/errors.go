@@ -308,6 +308,22 @@ func (e OverwriteError) Error() string {
 	)
 }
 
+// ReadOnlyAccountError
+//
+type ReadOnlyAccountError struct {
+	Address      AddressValue
+	FunctionName string
+	LocationRange
+}
+
+func (e ReadOnlyAccountError) Error() string {
+	return fmt.Sprintf(
+		"cannot call function `%s`: account %s is read-only",
+		e.FunctionName,
+		e.Address,
+	)
+}
+
 // CyclicLinkError
 //
 type CyclicLinkError struct {
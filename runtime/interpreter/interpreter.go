@@ -24,7 +24,10 @@ import (
 	"fmt"
 	"math"
 	goRuntime "runtime"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/onflow/atree"
 	"github.com/opentracing/opentracing-go"
@@ -116,6 +119,14 @@ type OnInvokedFunctionReturnFunc func(
 	line int,
 )
 
+// OnMeterComputationFunc is a function that is triggered when a built-in function
+// performs computation proportional to the size of its inputs, e.g. a string function.
+//
+type OnMeterComputationFunc func(
+	inter *Interpreter,
+	intensity uint64,
+)
+
 // OnRecordTraceFunc is a function thats records a trace.
 type OnRecordTraceFunc func(
 	inter *Interpreter,
@@ -217,6 +228,15 @@ type HashHandlerFunc func(
 	hashAlgorithm MemberAccessibleValue,
 ) *ArrayValue
 
+// RecoverSecp256k1PublicKeyHandlerFunc is a function that recovers a secp256k1 public key
+// from a signature and the hash of the signed data.
+type RecoverSecp256k1PublicKeyHandlerFunc func(
+	inter *Interpreter,
+	getLocationRange func() LocationRange,
+	signature *ArrayValue,
+	hash *ArrayValue,
+) OptionalValue
+
 // ExitHandlerFunc is a function that is called at the end of execution
 type ExitHandlerFunc func() error
 
@@ -282,41 +302,43 @@ type Storage interface {
 type ReferencedResourceKindedValues map[atree.StorageID]map[ReferenceTrackedResourceKindedValue]struct{}
 
 type Interpreter struct {
-	Program                        *Program
-	Location                       common.Location
-	PredeclaredValues              []ValueDeclaration
-	effectivePredeclaredValues     map[string]ValueDeclaration
-	activations                    *VariableActivations
-	Globals                        GlobalVariables
-	allInterpreters                map[common.LocationID]*Interpreter
-	typeCodes                      TypeCodes
-	Transactions                   []*HostFunctionValue
-	Storage                        Storage
-	onEventEmitted                 OnEventEmittedFunc
-	onStatement                    OnStatementFunc
-	onLoopIteration                OnLoopIterationFunc
-	onFunctionInvocation           OnFunctionInvocationFunc
-	onInvokedFunctionReturn        OnInvokedFunctionReturnFunc
-	onRecordTrace                  OnRecordTraceFunc
-	onResourceOwnerChange          OnResourceOwnerChangeFunc
-	injectedCompositeFieldsHandler InjectedCompositeFieldsHandlerFunc
-	contractValueHandler           ContractValueHandlerFunc
-	importLocationHandler          ImportLocationHandlerFunc
-	publicAccountHandler           PublicAccountHandlerFunc
-	uuidHandler                    UUIDHandlerFunc
-	PublicKeyValidationHandler     PublicKeyValidationHandlerFunc
-	SignatureVerificationHandler   SignatureVerificationHandlerFunc
-	BLSVerifyPoPHandler            VerifyBLSPoPHandlerFunc
-	AggregateBLSSignaturesHandler  AggregateBLSSignaturesHandlerFunc
-	AggregateBLSPublicKeysHandler  AggregateBLSPublicKeysHandlerFunc
-	HashHandler                    HashHandlerFunc
-	ExitHandler                    ExitHandlerFunc
-	interpreted                    bool
-	statement                      ast.Statement
-	debugger                       *Debugger
-	atreeValueValidationEnabled    bool
-	atreeStorageValidationEnabled  bool
-	tracingEnabled                 bool
+	Program                          *Program
+	Location                         common.Location
+	PredeclaredValues                []ValueDeclaration
+	effectivePredeclaredValues       map[string]ValueDeclaration
+	activations                      *VariableActivations
+	Globals                          GlobalVariables
+	allInterpreters                  map[common.LocationID]*Interpreter
+	typeCodes                        TypeCodes
+	Transactions                     []*HostFunctionValue
+	Storage                          Storage
+	onEventEmitted                   OnEventEmittedFunc
+	onStatement                      OnStatementFunc
+	onLoopIteration                  OnLoopIterationFunc
+	onFunctionInvocation             OnFunctionInvocationFunc
+	onInvokedFunctionReturn          OnInvokedFunctionReturnFunc
+	onMeterComputation               OnMeterComputationFunc
+	onRecordTrace                    OnRecordTraceFunc
+	onResourceOwnerChange            OnResourceOwnerChangeFunc
+	injectedCompositeFieldsHandler   InjectedCompositeFieldsHandlerFunc
+	contractValueHandler             ContractValueHandlerFunc
+	importLocationHandler            ImportLocationHandlerFunc
+	publicAccountHandler             PublicAccountHandlerFunc
+	uuidHandler                      UUIDHandlerFunc
+	PublicKeyValidationHandler       PublicKeyValidationHandlerFunc
+	SignatureVerificationHandler     SignatureVerificationHandlerFunc
+	BLSVerifyPoPHandler              VerifyBLSPoPHandlerFunc
+	AggregateBLSSignaturesHandler    AggregateBLSSignaturesHandlerFunc
+	AggregateBLSPublicKeysHandler    AggregateBLSPublicKeysHandlerFunc
+	HashHandler                      HashHandlerFunc
+	RecoverSecp256k1PublicKeyHandler RecoverSecp256k1PublicKeyHandlerFunc
+	ExitHandler                      ExitHandlerFunc
+	interpreted                      bool
+	statement                        ast.Statement
+	debugger                         *Debugger
+	atreeValueValidationEnabled      bool
+	atreeStorageValidationEnabled    bool
+	tracingEnabled                   bool
 	// TODO: ideally this would be a weak map, but Go has no weak references
 	referencedResourceKindedValues ReferencedResourceKindedValues
 }
@@ -373,6 +395,16 @@ func WithOnInvokedFunctionReturnHandler(handler OnInvokedFunctionReturnFunc) Opt
 	}
 }
 
+// WithOnMeterComputationHandler returns an interpreter option which sets
+// the given function as the computation metering handler.
+//
+func WithOnMeterComputationHandler(handler OnMeterComputationFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetOnMeterComputationHandler(handler)
+		return nil
+	}
+}
+
 // WithOnRecordTraceHandler returns an interpreter option which sets
 // the given function as the record trace handler.
 //
@@ -522,6 +554,16 @@ func WithHashHandler(handler HashHandlerFunc) Option {
 	}
 }
 
+// WithRecoverSecp256k1PublicKeyHandler returns an interpreter option which sets the given
+// function as the function that is used to recover secp256k1 public keys.
+//
+func WithRecoverSecp256k1PublicKeyHandler(handler RecoverSecp256k1PublicKeyHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetRecoverSecp256k1PublicKeyHandler(handler)
+		return nil
+	}
+}
+
 // WithExitHandler returns an interpreter option which sets the given
 // function as the function that is used when execution is complete.
 //
@@ -678,6 +720,13 @@ func (interpreter *Interpreter) SetOnInvokedFunctionReturnHandler(function OnInv
 	interpreter.onInvokedFunctionReturn = function
 }
 
+// SetOnMeterComputationHandler sets the function that is triggered when a built-in function
+// performs computation proportional to the size of its inputs.
+//
+func (interpreter *Interpreter) SetOnMeterComputationHandler(function OnMeterComputationFunc) {
+	interpreter.onMeterComputation = function
+}
+
 // SetOnRecordTraceHandler sets the function that is triggered when a trace is recorded.
 //
 func (interpreter *Interpreter) SetOnRecordTraceHandler(function OnRecordTraceFunc) {
@@ -756,6 +805,12 @@ func (interpreter *Interpreter) SetHashHandler(function HashHandlerFunc) {
 	interpreter.HashHandler = function
 }
 
+// SetRecoverSecp256k1PublicKeyHandler sets the function that is used to recover secp256k1 public keys.
+//
+func (interpreter *Interpreter) SetRecoverSecp256k1PublicKeyHandler(function RecoverSecp256k1PublicKeyHandlerFunc) {
+	interpreter.RecoverSecp256k1PublicKeyHandler = function
+}
+
 // SetExitHandler sets the function that is used to handle end of execution.
 //
 func (interpreter *Interpreter) SetExitHandler(function ExitHandlerFunc) {
@@ -952,8 +1007,15 @@ func (interpreter *Interpreter) prepareInvoke(
 		// if the function has defined optional parameters,
 		// then the provided arguments must be equal to or greater than
 		// the number of required parameters.
-		if functionType.RequiredArgumentCount == nil ||
-			argumentCount < *functionType.RequiredArgumentCount {
+		//
+		// arguments for trailing parameters with a default argument may be omitted.
+
+		defaultArgumentCount := sema.DefaultArgumentCount(parameters)
+
+		if (argumentCount > parameterCount ||
+			argumentCount < parameterCount-defaultArgumentCount) &&
+			(functionType.RequiredArgumentCount == nil ||
+				argumentCount < *functionType.RequiredArgumentCount) {
 
 			return nil, ArgumentCountError{
 				ParameterCount: parameterCount,
@@ -2160,10 +2222,20 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 			return ConvertFix64(value)
 		}
 
+	case sema.Fix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertFix128(value)
+		}
+
 	case sema.UFix64Type:
 		if !valueType.Equal(unwrappedTargetType) {
 			return ConvertUFix64(value)
 		}
+
+	case sema.UFix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertUFix128(value)
+		}
 	}
 
 	switch unwrappedTargetType.(type) {
@@ -2373,8 +2445,11 @@ func (interpreter *Interpreter) functionConditionsWrapper(
 				defer interpreter.activations.Pop()
 
 				if declaration.ParameterList != nil {
+					// NOTE: Interface functions and their implementations
+					//   may not declare default arguments, so all arguments are given
 					interpreter.bindParameterArguments(
 						declaration.ParameterList,
+						nil,
 						invocation.Arguments,
 					)
 				}
@@ -2512,6 +2587,7 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithOnLoopIterationHandler(interpreter.onLoopIteration),
 		WithOnFunctionInvocationHandler(interpreter.onFunctionInvocation),
 		WithOnInvokedFunctionReturnHandler(interpreter.onInvokedFunctionReturn),
+		WithOnMeterComputationHandler(interpreter.onMeterComputation),
 		WithInjectedCompositeFieldsHandler(interpreter.injectedCompositeFieldsHandler),
 		WithContractValueHandler(interpreter.contractValueHandler),
 		WithImportLocationHandler(interpreter.importLocationHandler),
@@ -2525,6 +2601,7 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithPublicKeyValidationHandler(interpreter.PublicKeyValidationHandler),
 		WithSignatureVerificationHandler(interpreter.SignatureVerificationHandler),
 		WithHashHandler(interpreter.HashHandler),
+		WithRecoverSecp256k1PublicKeyHandler(interpreter.RecoverSecp256k1PublicKeyHandler),
 		WithBLSCryptoFunctions(
 			interpreter.BLSVerifyPoPHandler,
 			interpreter.AggregateBLSSignaturesHandler,
@@ -2565,6 +2642,15 @@ func (interpreter *Interpreter) ReadStored(
 	return accountStorage.ReadValue(identifier)
 }
 
+func (interpreter *Interpreter) readStoredStaticType(
+	storageAddress common.Address,
+	domain string,
+	identifier string,
+) StaticType {
+	accountStorage := interpreter.Storage.GetStorageMap(storageAddress, domain)
+	return accountStorage.ReadStaticType(identifier)
+}
+
 func (interpreter *Interpreter) writeStored(
 	storageAddress common.Address,
 	domain string,
@@ -2742,6 +2828,22 @@ var converterDeclarations = []valueConverterDeclaration{
 		min: UFix64Value(0),
 		max: UFix64Value(math.MaxUint64),
 	},
+	{
+		name: sema.Fix128TypeName,
+		convert: func(value Value) Value {
+			return ConvertFix128(value)
+		},
+		min: NewFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+		max: NewFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+	},
+	{
+		name: sema.UFix128TypeName,
+		convert: func(value Value) Value {
+			return ConvertUFix128(value)
+		},
+		min: NewUFix128ValueFromBigInt(sema.UFix128TypeMinBig),
+		max: NewUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+	},
 	{
 		name: "Address",
 		convert: func(value Value) Value {
@@ -3206,6 +3308,99 @@ var stringFunction = func() Value {
 		),
 	)
 
+	addMember(
+		sema.StringTypeJoinFunctionName,
+		NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				strs, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				separator, ok := invocation.Arguments[1].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				var sb strings.Builder
+
+				first := true
+				strs.Iterate(func(element Value) (resume bool) {
+					str, ok := element.(*StringValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					invocation.Interpreter.MeterComputation(uint64(len(separator.Str) + len(str.Str)))
+
+					if !first {
+						sb.WriteString(separator.Str)
+					}
+					first = false
+
+					sb.WriteString(str.Str)
+
+					return true
+				})
+
+				return NewStringValue(sb.String())
+			},
+			sema.StringTypeJoinFunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeFromCharactersFunctionName,
+		NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				characters, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				var sb strings.Builder
+
+				characters.Iterate(func(element Value) (resume bool) {
+					character, ok := element.(*StringValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					invocation.Interpreter.MeterComputation(uint64(len(character.Str)))
+
+					sb.WriteString(character.Str)
+
+					return true
+				})
+
+				return NewStringValue(sb.String())
+			},
+			sema.StringTypeFromCharactersFunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeFromUTF8FunctionName,
+		NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				argument, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				invocation.Interpreter.MeterComputation(uint64(argument.Count()))
+
+				bytes, _ := ByteArrayValueToByteSlice(argument)
+				if !utf8.Valid(bytes) {
+					return NilValue{}
+				}
+
+				return NewSomeValueNonCopying(NewStringValue(string(bytes)))
+			},
+			sema.StringTypeFromUTF8FunctionType,
+		),
+	)
+
 	return functionValue
 }()
 
@@ -3355,6 +3550,27 @@ func (interpreter *Interpreter) IsSubType(subType DynamicType, superType sema.Ty
 			return true
 		}
 
+	case *TupleDynamicType:
+		if typedSuperType, ok := superType.(*sema.TupleType); ok {
+
+			if len(typedSubType.ElementTypes) != len(typedSuperType.ElementTypes) {
+				return false
+			}
+
+			for i, elementType := range typedSubType.ElementTypes {
+				if !interpreter.IsSubType(elementType, typedSuperType.ElementTypes[i]) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		switch superType {
+		case sema.AnyStructType, sema.AnyResourceType:
+			return true
+		}
+
 	case ReferenceDynamicType:
 		if typedSuperType, ok := superType.(*sema.ReferenceType); ok {
 
@@ -3522,15 +3738,15 @@ func (interpreter *Interpreter) authAccountTypeFunction(addressValue AddressValu
 			domain := path.Domain.Identifier()
 			identifier := path.Identifier
 
-			value := interpreter.ReadStored(address, domain, identifier)
+			staticType := interpreter.readStoredStaticType(address, domain, identifier)
 
-			if value == nil {
+			if staticType == nil {
 				return NilValue{}
 			}
 
 			return NewSomeValueNonCopying(
 				TypeValue{
-					Type: value.StaticType(),
+					Type: staticType,
 				},
 			)
 		},
@@ -3539,6 +3755,288 @@ func (interpreter *Interpreter) authAccountTypeFunction(addressValue AddressValu
 	)
 }
 
+func (interpreter *Interpreter) authAccountCheckFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+
+			path, pathOk := invocation.Arguments[0].(PathValue)
+
+			if !pathOk {
+				panic(errors.NewUnreachableError())
+			}
+
+			domain := path.Domain.Identifier()
+			identifier := path.Identifier
+
+			staticType := interpreter.readStoredStaticType(address, domain, identifier)
+
+			if staticType == nil {
+				return BoolValue(false)
+			}
+
+			typeParameterPair := invocation.TypeParameterTypes.Oldest()
+			if typeParameterPair == nil {
+				panic(errors.NewUnreachableError())
+			}
+
+			ty := typeParameterPair.Value
+
+			// Only the static type of the stored value is needed,
+			// so the stored value does not have to be loaded completely
+
+			semaType := interpreter.MustConvertStaticToSemaType(staticType)
+
+			return BoolValue(sema.IsSubType(semaType, ty))
+		},
+
+		sema.AuthAccountTypeCheckFunctionType,
+	)
+}
+
+func (interpreter *Interpreter) authAccountReplaceFunction(addressValue AddressValue) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+
+			value := invocation.Arguments[0]
+			path, pathOk := invocation.Arguments[1].(PathValue)
+
+			if !pathOk {
+				panic(errors.NewUnreachableError())
+			}
+
+			domain := path.Domain.Identifier()
+			identifier := path.Identifier
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			var result Value = NilValue{}
+
+			existingValue := interpreter.ReadStored(address, domain, identifier)
+
+			if existingValue != nil {
+
+				// If there is value stored for the given path,
+				// check that it satisfies the type given as the type argument,
+				// before the storage is modified
+
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
+
+				ty := typeParameterPair.Value
+
+				dynamicType := existingValue.DynamicType(interpreter, SeenReferences{})
+				if !interpreter.IsSubType(dynamicType, ty) {
+					panic(ForceCastTypeMismatchError{
+						ExpectedType:  ty,
+						LocationRange: getLocationRange(),
+					})
+				}
+
+				// Move the existing value out of storage.
+				// The stored value is removed when the new value is written below
+
+				result = NewSomeValueNonCopying(
+					existingValue.Transfer(
+						inter,
+						getLocationRange,
+						atree.Address{},
+						false,
+						nil,
+					),
+				)
+			}
+
+			value = value.Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address(address),
+				true,
+				nil,
+			)
+
+			// Write new value
+
+			interpreter.writeStored(address, domain, identifier, value)
+
+			return result
+		},
+
+		sema.AuthAccountTypeReplaceFunctionType,
+	)
+}
+
+// storedIdentifiers returns the identifiers of all values stored
+// in the given domain of the given account, in lexicographical order,
+// so that the result does not depend on the storage layout.
+//
+func (interpreter *Interpreter) storedIdentifiers(address common.Address, domain common.PathDomain) []string {
+
+	storageMap := interpreter.Storage.GetStorageMap(address, domain.Identifier())
+
+	var identifiers []string
+
+	iterator := storageMap.Iterator()
+	for {
+		identifier := iterator.NextKey()
+		if identifier == "" {
+			break
+		}
+
+		interpreter.MeterComputation(1)
+
+		identifiers = append(identifiers, identifier)
+	}
+
+	sort.Strings(identifiers)
+
+	return identifiers
+}
+
+func (interpreter *Interpreter) accountPaths(addressValue AddressValue, domain common.PathDomain) *ArrayValue {
+
+	address := addressValue.ToAddress()
+
+	var pathType StaticType
+	switch domain {
+	case common.PathDomainStorage:
+		pathType = PrimitiveStaticTypeStoragePath
+	case common.PathDomainPublic:
+		pathType = PrimitiveStaticTypePublicPath
+	case common.PathDomainPrivate:
+		pathType = PrimitiveStaticTypePrivatePath
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	identifiers := interpreter.storedIdentifiers(address, domain)
+
+	paths := make([]Value, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		paths = append(
+			paths,
+			PathValue{
+				Domain:     domain,
+				Identifier: identifier,
+			},
+		)
+	}
+
+	return NewArrayValue(
+		interpreter,
+		VariableSizedStaticType{
+			Type: pathType,
+		},
+		common.Address{},
+		paths...,
+	)
+}
+
+func (interpreter *Interpreter) authAccountForEachStoredFunction(addressValue AddressValue) *HostFunctionValue {
+	return interpreter.authAccountForEachFunction(
+		addressValue,
+		common.PathDomainStorage,
+		sema.StoragePathType,
+		sema.AuthAccountTypeForEachStoredFunctionType,
+	)
+}
+
+func (interpreter *Interpreter) authAccountForEachPublicFunction(addressValue AddressValue) *HostFunctionValue {
+	return interpreter.authAccountForEachFunction(
+		addressValue,
+		common.PathDomainPublic,
+		sema.PublicPathType,
+		sema.AuthAccountTypeForEachPublicFunctionType,
+	)
+}
+
+func (interpreter *Interpreter) authAccountForEachFunction(
+	addressValue AddressValue,
+	domain common.PathDomain,
+	pathType sema.Type,
+	functionType *sema.FunctionType,
+) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	argumentTypes := []sema.Type{
+		pathType,
+		sema.MetaType,
+	}
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Value {
+
+			function, ok := invocation.Arguments[0].(FunctionValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			// Iterate over a snapshot of the identifiers,
+			// so the function may modify the account's storage.
+			// Values which are removed during the iteration are skipped
+
+			identifiers := interpreter.storedIdentifiers(address, domain)
+
+			for _, identifier := range identifiers {
+
+				interpreter.MeterComputation(1)
+
+				value := interpreter.ReadStored(address, domain.Identifier(), identifier)
+				if value == nil {
+					continue
+				}
+
+				var staticType StaticType
+				if domain == common.PathDomainStorage {
+					staticType = value.StaticType()
+				} else {
+					link, ok := value.(LinkValue)
+					if !ok {
+						continue
+					}
+					staticType = CapabilityStaticType{
+						BorrowType: link.Type,
+					}
+				}
+
+				result := interpreter.invokeFunctionArgument(
+					function,
+					[]Value{
+						PathValue{
+							Domain:     domain,
+							Identifier: identifier,
+						},
+						TypeValue{
+							Type: staticType,
+						},
+					},
+					argumentTypes,
+					invocation.GetLocationRange,
+				)
+
+				if !bool(result.(BoolValue)) {
+					break
+				}
+			}
+
+			return VoidValue{}
+		},
+		functionType,
+	)
+}
+
 func (interpreter *Interpreter) authAccountLoadFunction(addressValue AddressValue) *HostFunctionValue {
 	return interpreter.authAccountReadFunction(addressValue, true)
 }
@@ -4122,6 +4620,17 @@ func (interpreter *Interpreter) reportLoopIteration(pos ast.HasPosition) {
 	interpreter.onLoopIteration(interpreter, line)
 }
 
+// MeterComputation reports computation proportional to the given intensity,
+// e.g. the size of the inputs of a built-in function, to the computation metering handler
+//
+func (interpreter *Interpreter) MeterComputation(intensity uint64) {
+	if interpreter.onMeterComputation == nil {
+		return
+	}
+
+	interpreter.onMeterComputation(interpreter, intensity)
+}
+
 func (interpreter *Interpreter) reportFunctionInvocation(line int) {
 	if interpreter.onFunctionInvocation == nil {
 		return
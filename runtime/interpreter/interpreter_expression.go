@@ -485,10 +485,31 @@ func NewIntValue(value *big.Int, intSubType sema.Type) Value {
 }
 
 func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedPointExpression) ast.Repr {
-	// TODO: adjust once/if we support more fixed point types
-
 	fixedPointSubType := interpreter.Program.Elaboration.FixedPointExpression[expression]
 
+	switch fixedPointSubType {
+	case sema.Fix128Type:
+		return NewFix128ValueFromBigInt(
+			fixedpoint.ConvertToFixedPointBigInt(
+				expression.Negative,
+				expression.UnsignedInteger,
+				expression.Fractional,
+				expression.Scale,
+				sema.Fix128Scale,
+			),
+		)
+	case sema.UFix128Type:
+		return NewUFix128ValueFromBigInt(
+			fixedpoint.ConvertToFixedPointBigInt(
+				expression.Negative,
+				expression.UnsignedInteger,
+				expression.Fractional,
+				expression.Scale,
+				sema.Fix128Scale,
+			),
+		)
+	}
+
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		expression.Negative,
 		expression.UnsignedInteger,
@@ -542,6 +563,27 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	)
 }
 
+func (interpreter *Interpreter) VisitTupleExpression(expression *ast.TupleExpression) ast.Repr {
+	values := interpreter.visitExpressionsNonCopying(expression.Elements)
+
+	elementTypes := interpreter.Program.Elaboration.TupleExpressionElementTypes[expression]
+	tupleType := interpreter.Program.Elaboration.TupleExpressionTupleType[expression]
+
+	copies := make([]Value, len(values))
+	for i, element := range values {
+		elementExpression := expression.Elements[i]
+		getLocationRange := locationRangeGetter(interpreter.Location, elementExpression)
+		copies[i] = interpreter.transferAndConvert(
+			element,
+			elementTypes[i],
+			tupleType.ElementTypes[i],
+			getLocationRange,
+		)
+	}
+
+	return NewTupleValueNonCopying(copies...)
+}
+
 func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.DictionaryExpression) ast.Repr {
 	values := interpreter.visitEntries(expression.Entries)
 
@@ -761,12 +803,23 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 	value := interpreter.evalExpression(expression.Expression)
 
 	expectedType := interpreter.Program.Elaboration.CastingTargetTypes[expression]
+	staticValueType := interpreter.Program.Elaboration.CastingStaticValueTypes[expression]
 
 	switch expression.Operation {
 	case ast.OperationFailableCast, ast.OperationForceCast:
 		dynamicType := value.DynamicType(interpreter, SeenReferences{})
 		isSubType := interpreter.IsSubType(dynamicType, expectedType)
 
+		// If the cast succeeds, the value is boxed like in a static cast,
+		// i.e. into an optional if the target type is optional.
+		//
+		// No further conversion is necessary, as the dynamic type
+		// of the value is a subtype of the target type
+
+		if isSubType {
+			value = interpreter.BoxOptional(value, staticValueType, expectedType)
+		}
+
 		switch expression.Operation {
 		case ast.OperationFailableCast:
 			if !isSubType {
@@ -791,7 +844,6 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 		}
 
 	case ast.OperationCast:
-		staticValueType := interpreter.Program.Elaboration.CastingStaticValueTypes[expression]
 		return interpreter.ConvertAndBox(value, staticValueType, expectedType)
 
 	default:
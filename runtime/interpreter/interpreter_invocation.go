@@ -22,6 +22,7 @@ import (
 	"github.com/onflow/atree"
 
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -109,6 +110,28 @@ func (interpreter *Interpreter) invokeFunctionValue(
 	return function.invoke(invocation)
 }
 
+// invokeFunctionArgument invokes a function value which was passed as an argument
+// to a built-in function, e.g. the transform function passed to an array's `map` function.
+//
+// The arguments are transferred like the arguments of a function invocation.
+//
+func (interpreter *Interpreter) invokeFunctionArgument(
+	function FunctionValue,
+	arguments []Value,
+	argumentTypes []sema.Type,
+	getLocationRange func() LocationRange,
+) Value {
+	return interpreter.invokeFunctionValue(
+		function,
+		arguments,
+		nil,
+		argumentTypes,
+		argumentTypes,
+		nil,
+		getLocationRange(),
+	)
+}
+
 func (interpreter *Interpreter) invokeInterpretedFunction(
 	function *InterpretedFunctionValue,
 	invocation Invocation,
@@ -137,7 +160,11 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 	defer interpreter.activations.Pop()
 
 	if function.ParameterList != nil {
-		interpreter.bindParameterArguments(function.ParameterList, arguments)
+		interpreter.bindParameterArguments(
+			function.ParameterList,
+			function.Type.Parameters,
+			arguments,
+		)
 	}
 
 	return interpreter.visitFunctionBody(
@@ -151,14 +178,51 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 	)
 }
 
-// bindParameterArguments binds the argument values to the given parameters
+// bindParameterArguments binds the argument values to the given parameters.
+//
+// If fewer arguments than parameters are given, the remaining parameters
+// are bound to their default arguments, which the checker ensured exist.
 //
 func (interpreter *Interpreter) bindParameterArguments(
 	parameterList *ast.ParameterList,
+	parameters []*sema.Parameter,
 	arguments []Value,
 ) {
+	argumentCount := len(arguments)
+
 	for parameterIndex, parameter := range parameterList.Parameters {
-		argument := arguments[parameterIndex]
+		// Only omitted arguments for parameters with a default argument are filled in.
+		// A missing argument for a parameter without a default argument
+		// is an invalid invocation, e.g. by an embedder
+
+		var argument Value
+		if parameterIndex < argumentCount {
+			argument = arguments[parameterIndex]
+		} else if parameter.DefaultArgument == nil {
+			panic(ArgumentCountError{
+				ParameterCount: len(parameterList.Parameters),
+				ArgumentCount:  argumentCount,
+			})
+		} else {
+			argument = interpreter.evalDefaultArgument(
+				parameter.DefaultArgument,
+				parameters[parameterIndex].TypeAnnotation.Type,
+			)
+		}
 		interpreter.declareVariable(parameter.Identifier.Identifier, argument)
 	}
 }
+
+// evalDefaultArgument evaluates the default argument of a parameter
+// and boxes it to the parameter type, like an argument passed in an invocation.
+//
+// Default arguments are constant, so the scope they are evaluated in does not matter.
+//
+func (interpreter *Interpreter) evalDefaultArgument(defaultArgument ast.Expression, parameterType sema.Type) Value {
+	if defaultArgument == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	value := interpreter.evalExpression(defaultArgument)
+	return interpreter.BoxOptional(value, nil, parameterType)
+}
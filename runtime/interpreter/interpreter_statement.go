@@ -23,6 +23,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func (interpreter *Interpreter) evalStatement(statement ast.Statement) interface{} {
@@ -447,6 +448,57 @@ func (interpreter *Interpreter) visitVariableDeclaration(
 	)
 }
 
+func (interpreter *Interpreter) VisitTupleVariableDeclaration(declaration *ast.TupleVariableDeclaration) ast.Repr {
+
+	tupleType := interpreter.Program.Elaboration.TupleVariableDeclarationTypes[declaration]
+	valueType := interpreter.Program.Elaboration.TupleVariableDeclarationValueTypes[declaration]
+
+	// NOTE: It is *REQUIRED* that the getter for the value is used
+	// instead of just evaluating value expression,
+	// as the value may be an access expression (member access, index access),
+	// which implicitly removes a resource.
+
+	const allowMissing = false
+	result := interpreter.assignmentGetterSetter(declaration.Value).get(allowMissing)
+	if result == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	getLocationRange := locationRangeGetter(interpreter.Location, declaration.Value)
+
+	transferredValue := interpreter.transferAndConvert(result, valueType, tupleType, getLocationRange)
+
+	tuple, ok := transferredValue.(*TupleValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	valueTupleType, ok := valueType.(*sema.TupleType)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	// Destructure the tuple: declare a new variable for each element.
+	//
+	// NOTE: lexical scope, always declare new variables.
+	// Do not find an existing variable and assign the value!
+
+	for i, identifier := range declaration.Identifiers {
+		element := interpreter.ConvertAndBox(
+			tuple.Elements[i],
+			valueTupleType.ElementTypes[i],
+			tupleType.ElementTypes[i],
+		)
+
+		_ = interpreter.declareVariable(
+			identifier.Identifier,
+			element,
+		)
+	}
+
+	return nil
+}
+
 func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.AssignmentStatement) ast.Repr {
 	targetType := interpreter.Program.Elaboration.AssignmentStatementTargetTypes[assignment]
 	valueType := interpreter.Program.Elaboration.AssignmentStatementValueTypes[assignment]
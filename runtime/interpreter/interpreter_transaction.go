@@ -73,15 +73,26 @@ func (interpreter *Interpreter) declareTransactionEntryPoint(declaration *ast.Tr
 
 			if declaration.ParameterList != nil {
 				// If the transaction has a parameter list of N parameters,
-				// bind the first N arguments of the invocation to the transaction parameters,
-				// then leave the remaining arguments for the prepare function
-
-				transactionParameterCount := len(declaration.ParameterList.Parameters)
+				// bind the first arguments of the invocation to the transaction parameters,
+				// then leave the remaining arguments for the prepare function.
+				//
+				// Trailing transaction parameters which have a default argument
+				// may have been omitted, so determine the number of transaction arguments
+				// from the number of arguments for the prepare function
+
+				transactionArgumentCount := len(invocation.Arguments)
+				if prepareFunctionType != nil {
+					transactionArgumentCount -= len(prepareFunctionType.Parameters)
+				}
 
-				transactionArguments := invocation.Arguments[:transactionParameterCount]
-				prepareArguments := invocation.Arguments[transactionParameterCount:]
+				transactionArguments := invocation.Arguments[:transactionArgumentCount]
+				prepareArguments := invocation.Arguments[transactionArgumentCount:]
 
-				interpreter.bindParameterArguments(declaration.ParameterList, transactionArguments)
+				interpreter.bindParameterArguments(
+					declaration.ParameterList,
+					transactionType.Parameters,
+					transactionArguments,
+				)
 				invocation.Arguments = prepareArguments
 			}
 
@@ -45,7 +45,9 @@ func _() {
 	_ = x[PrimitiveStaticTypeWord32-55]
 	_ = x[PrimitiveStaticTypeWord64-56]
 	_ = x[PrimitiveStaticTypeFix64-64]
+	_ = x[PrimitiveStaticTypeFix128-65]
 	_ = x[PrimitiveStaticTypeUFix64-72]
+	_ = x[PrimitiveStaticTypeUFix128-73]
 	_ = x[PrimitiveStaticTypePath-76]
 	_ = x[PrimitiveStaticTypeCapability-77]
 	_ = x[PrimitiveStaticTypeStoragePath-78]
@@ -62,7 +64,7 @@ func _() {
 	_ = x[PrimitiveStaticTypeAccountKey-97]
 }
 
-const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockNumberSignedNumberIntegerSignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Fix64UFix64PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKey"
+const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockNumberSignedNumberIntegerSignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Fix64Fix128UFix64UFix128PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKey"
 
 var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	0:  _PrimitiveStaticType_name[0:7],
@@ -102,21 +104,23 @@ var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	55: _PrimitiveStaticType_name[228:234],
 	56: _PrimitiveStaticType_name[234:240],
 	64: _PrimitiveStaticType_name[240:245],
-	72: _PrimitiveStaticType_name[245:251],
-	76: _PrimitiveStaticType_name[251:255],
-	77: _PrimitiveStaticType_name[255:265],
-	78: _PrimitiveStaticType_name[265:276],
-	79: _PrimitiveStaticType_name[276:290],
-	80: _PrimitiveStaticType_name[290:300],
-	81: _PrimitiveStaticType_name[300:311],
-	90: _PrimitiveStaticType_name[311:322],
-	91: _PrimitiveStaticType_name[322:335],
-	92: _PrimitiveStaticType_name[335:351],
-	93: _PrimitiveStaticType_name[351:371],
-	94: _PrimitiveStaticType_name[371:393],
-	95: _PrimitiveStaticType_name[393:408],
-	96: _PrimitiveStaticType_name[408:425],
-	97: _PrimitiveStaticType_name[425:435],
+	65: _PrimitiveStaticType_name[245:251],
+	72: _PrimitiveStaticType_name[251:257],
+	73: _PrimitiveStaticType_name[257:264],
+	76: _PrimitiveStaticType_name[264:268],
+	77: _PrimitiveStaticType_name[268:278],
+	78: _PrimitiveStaticType_name[278:289],
+	79: _PrimitiveStaticType_name[289:303],
+	80: _PrimitiveStaticType_name[303:313],
+	81: _PrimitiveStaticType_name[313:324],
+	90: _PrimitiveStaticType_name[324:335],
+	91: _PrimitiveStaticType_name[335:348],
+	92: _PrimitiveStaticType_name[348:364],
+	93: _PrimitiveStaticType_name[364:384],
+	94: _PrimitiveStaticType_name[384:406],
+	95: _PrimitiveStaticType_name[406:421],
+	96: _PrimitiveStaticType_name[421:438],
+	97: _PrimitiveStaticType_name[438:448],
 }
 
 func (i PrimitiveStaticType) String() string {
@@ -339,6 +339,46 @@ func (t CapabilityStaticType) Equal(other StaticType) bool {
 	return t.BorrowType.Equal(otherCapabilityType.BorrowType)
 }
 
+// TupleStaticType
+
+type TupleStaticType struct {
+	ElementTypes []StaticType
+}
+
+var _ StaticType = &TupleStaticType{}
+
+// NOTE: must be pointer receiver, as static types get used in type values,
+// which are used as keys in maps when exporting.
+// Key types in Go maps must be (transitively) hashable types,
+// and slices are not, but `ElementTypes` is one.
+//
+func (*TupleStaticType) isStaticType() {}
+
+func (t *TupleStaticType) String() string {
+	elementTypes := make([]string, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		elementTypes[i] = elementType.String()
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(elementTypes, ", "))
+}
+
+func (t *TupleStaticType) Equal(other StaticType) bool {
+	otherTupleType, ok := other.(*TupleStaticType)
+	if !ok || len(t.ElementTypes) != len(otherTupleType.ElementTypes) {
+		return false
+	}
+
+	for i, elementType := range t.ElementTypes {
+		if !elementType.Equal(otherTupleType.ElementTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Conversion
 
 func ConvertSemaToStaticType(t sema.Type) StaticType {
@@ -379,6 +419,9 @@ func ConvertSemaToStaticType(t sema.Type) StaticType {
 	case *sema.ReferenceType:
 		return ConvertSemaReferenceTyoeToStaticReferenceType(t)
 
+	case *sema.TupleType:
+		return ConvertSemaTupleTypeToStaticTupleType(t)
+
 	case *sema.CapabilityType:
 		result := CapabilityStaticType{}
 		if t.BorrowType != nil {
@@ -431,6 +474,18 @@ func ConvertSemaReferenceTyoeToStaticReferenceType(t *sema.ReferenceType) Refere
 	}
 }
 
+func ConvertSemaTupleTypeToStaticTupleType(t *sema.TupleType) *TupleStaticType {
+	elementTypes := make([]StaticType, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		elementTypes[i] = ConvertSemaToStaticType(elementType)
+	}
+
+	return &TupleStaticType{
+		ElementTypes: elementTypes,
+	}
+}
+
 func ConvertSemaInterfaceTypeToStaticInterfaceType(t *sema.InterfaceType) InterfaceStaticType {
 	return InterfaceStaticType{
 		Location:            t.Location,
@@ -503,6 +558,20 @@ func ConvertStaticToSemaType(
 			Type:       ty,
 		}, err
 
+	case *TupleStaticType:
+		elementTypes := make([]sema.Type, len(t.ElementTypes))
+
+		for i, elementType := range t.ElementTypes {
+			elementTypes[i], err = ConvertStaticToSemaType(elementType, getInterface, getComposite)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &sema.TupleType{
+			ElementTypes: elementTypes,
+		}, nil
+
 	case CapabilityStaticType:
 		var borrowType sema.Type
 		if t.BorrowType != nil {
@@ -96,6 +96,21 @@ func (s StorageMap) ReadValue(key string) Value {
 	return StoredValue(storable, s.orderedMap.Storage)
 }
 
+// ReadStaticType returns the static type of the value for the given key,
+// or nil if the key does not exist.
+//
+// Only the root of the stored value is read,
+// i.e. nested values, like the elements of an array, are not loaded.
+//
+func (s StorageMap) ReadStaticType(key string) StaticType {
+	value := s.ReadValue(key)
+	if value == nil {
+		return nil
+	}
+
+	return value.StaticType()
+}
+
 // WriteValue sets or removes a value in the storage map.
 // If the given value is a SomeValue, the key is updated.
 // If the given value is NilValue, the key is removed.
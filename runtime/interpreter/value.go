@@ -24,8 +24,10 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/onflow/atree"
 	"github.com/rivo/uniseg"
@@ -816,6 +818,133 @@ func (v *StringValue) GetMember(interpreter *Interpreter, _ func() LocationRange
 			},
 			sema.StringTypeToLowerFunctionType,
 		)
+
+	case "toUpper":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str)))
+
+				return v.ToUpper()
+			},
+			sema.StringTypeToUpperFunctionType,
+		)
+
+	case "trim":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str)))
+
+				return v.Trim()
+			},
+			sema.StringTypeTrimFunctionType,
+		)
+
+	case "contains":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str) + len(other.Str)))
+
+				return BoolValue(v.IndexOf(other) >= 0)
+			},
+			sema.StringTypeContainsFunctionType,
+		)
+
+	case "index":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str) + len(other.Str)))
+
+				return NewIntValueFromInt64(int64(v.IndexOf(other)))
+			},
+			sema.StringTypeIndexFunctionType,
+		)
+
+	case "hasPrefix":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				prefix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str)))
+
+				return BoolValue(v.HasPrefix(prefix))
+			},
+			sema.StringTypeHasPrefixFunctionType,
+		)
+
+	case "hasSuffix":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				suffix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str)))
+
+				return BoolValue(v.HasSuffix(suffix))
+			},
+			sema.StringTypeHasSuffixFunctionType,
+		)
+
+	case "split":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				separator, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str) + len(separator.Str)))
+
+				return v.Split(invocation.Interpreter, separator)
+			},
+			sema.StringTypeSplitFunctionType,
+		)
+
+	case "replaceAll":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				original, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				replacement, ok := invocation.Arguments[1].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				invocation.Interpreter.MeterComputation(
+					uint64(len(v.Str) + len(original.Str) + len(replacement.Str)),
+				)
+
+				return v.ReplaceAll(original, replacement)
+			},
+			sema.StringTypeReplaceAllFunctionType,
+		)
+
+	case "toCharacters":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				invocation.Interpreter.MeterComputation(uint64(len(v.Str)))
+
+				return v.ToCharacters(invocation.Interpreter)
+			},
+			sema.StringTypeToCharactersFunctionType,
+		)
 	}
 
 	return nil
@@ -849,6 +978,247 @@ func (v *StringValue) ToLower() *StringValue {
 	return NewStringValue(strings.ToLower(v.Str))
 }
 
+func (v *StringValue) ToUpper() *StringValue {
+	return NewStringValue(strings.ToUpper(v.Str))
+}
+
+// graphemeBoundaries returns the byte offsets of the boundaries
+// between the characters (grapheme clusters) of the string,
+// including the start and the end of the string.
+//
+// The index of a boundary in the result is the index of the character starting at it.
+//
+func (v *StringValue) graphemeBoundaries() []int {
+	boundaries := []int{0}
+
+	v.prepareGraphemes()
+	for v.graphemes.Next() {
+		_, end := v.graphemes.Positions()
+		boundaries = append(boundaries, end)
+	}
+
+	v.length = len(boundaries) - 1
+
+	return boundaries
+}
+
+// isGraphemeBoundary returns true if the given byte offset is a boundary
+// in the given boundaries, as returned by graphemeBoundaries
+//
+func isGraphemeBoundary(boundaries []int, offset int) bool {
+	index := sort.SearchInts(boundaries, offset)
+	return index < len(boundaries) && boundaries[index] == offset
+}
+
+// findGrapheme finds the first occurrence of the given string,
+// starting at the given boundary index.
+//
+// Only occurrences which start and end at character boundaries are considered,
+// e.g. the string "e" does not occur in the string "e\u{301}" (é).
+//
+// Returns the boundary indices of the start and the end of the occurrence,
+// or -1 if there is no occurrence.
+//
+func (v *StringValue) findGrapheme(other string, boundaries []int, from int) (start int, end int) {
+	for from < len(boundaries) {
+		offset := boundaries[from]
+
+		index := strings.Index(v.Str[offset:], other)
+		if index < 0 {
+			break
+		}
+
+		startOffset := offset + index
+		start = from + sort.SearchInts(boundaries[from:], startOffset)
+
+		if start < len(boundaries) && boundaries[start] == startOffset {
+			endOffset := startOffset + len(other)
+			end = start + sort.SearchInts(boundaries[start:], endOffset)
+
+			if end < len(boundaries) && boundaries[end] == endOffset {
+				return start, end
+			}
+
+			from = start + 1
+		} else {
+			// start is the first boundary after the occurrence
+			from = start
+		}
+	}
+
+	return -1, -1
+}
+
+// IndexOf returns the character index of the first occurrence of the given string,
+// or -1 if the string does not contain it
+//
+func (v *StringValue) IndexOf(other *StringValue) int {
+	boundaries := v.graphemeBoundaries()
+	start, _ := v.findGrapheme(other.Str, boundaries, 0)
+	return start
+}
+
+func (v *StringValue) HasPrefix(prefix *StringValue) bool {
+	if !strings.HasPrefix(v.Str, prefix.Str) {
+		return false
+	}
+	return isGraphemeBoundary(v.graphemeBoundaries(), len(prefix.Str))
+}
+
+func (v *StringValue) HasSuffix(suffix *StringValue) bool {
+	if !strings.HasSuffix(v.Str, suffix.Str) {
+		return false
+	}
+	return isGraphemeBoundary(v.graphemeBoundaries(), len(v.Str)-len(suffix.Str))
+}
+
+// Trim returns the string with all leading and trailing whitespace removed.
+//
+// Only whole characters are removed, i.e. a whitespace scalar
+// which is combined with a following non-whitespace scalar is retained
+//
+func (v *StringValue) Trim() *StringValue {
+	start := -1
+	end := 0
+
+	v.prepareGraphemes()
+	for v.graphemes.Next() {
+		if isWhitespace(v.graphemes.Str()) {
+			continue
+		}
+
+		graphemeStart, graphemeEnd := v.graphemes.Positions()
+		if start < 0 {
+			start = graphemeStart
+		}
+		end = graphemeEnd
+	}
+
+	if start < 0 {
+		return NewStringValue("")
+	}
+
+	return NewStringValue(v.Str[start:end])
+}
+
+func isWhitespace(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Split returns the substrings of the string which are separated by the given separator.
+// If the separator is empty, the string is split into its characters
+//
+func (v *StringValue) Split(interpreter *Interpreter, separator *StringValue) *ArrayValue {
+	var parts []string
+
+	if len(separator.Str) == 0 {
+		v.prepareGraphemes()
+		for v.graphemes.Next() {
+			parts = append(parts, v.graphemes.Str())
+		}
+	} else {
+		boundaries := v.graphemeBoundaries()
+
+		position := 0
+		for {
+			start, end := v.findGrapheme(separator.Str, boundaries, position)
+			if start < 0 {
+				break
+			}
+
+			parts = append(parts, v.Str[boundaries[position]:boundaries[start]])
+			position = end
+		}
+
+		parts = append(parts, v.Str[boundaries[position]:])
+	}
+
+	i := 0
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		StringArrayStaticType,
+		common.Address{},
+		func() Value {
+			if i >= len(parts) {
+				return nil
+			}
+
+			value := NewStringValue(parts[i])
+
+			i++
+
+			return value
+		},
+	)
+}
+
+// ReplaceAll returns a new string with all occurrences of the given original string
+// replaced with the given replacement.
+// If the original string is empty, the replacement is inserted
+// at the beginning of the string and after each character
+//
+func (v *StringValue) ReplaceAll(original *StringValue, replacement *StringValue) *StringValue {
+	boundaries := v.graphemeBoundaries()
+	last := len(boundaries) - 1
+
+	var sb strings.Builder
+
+	position := 0
+	for position <= last {
+		start, end := v.findGrapheme(original.Str, boundaries, position)
+		if start < 0 {
+			break
+		}
+
+		sb.WriteString(v.Str[boundaries[position]:boundaries[start]])
+		sb.WriteString(replacement.Str)
+
+		if start == end {
+			// The original string is empty:
+			// Retain the following character, if any, and continue after it
+
+			if start == last {
+				position = last
+				break
+			}
+
+			sb.WriteString(v.Str[boundaries[start]:boundaries[start+1]])
+			position = start + 1
+		} else {
+			position = end
+		}
+	}
+
+	sb.WriteString(v.Str[boundaries[position]:])
+
+	return NewStringValue(sb.String())
+}
+
+// ToCharacters returns an array containing the characters of the string
+//
+func (v *StringValue) ToCharacters(interpreter *Interpreter) *ArrayValue {
+	v.prepareGraphemes()
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		CharacterArrayStaticType,
+		common.Address{},
+		func() Value {
+			if !v.graphemes.Next() {
+				return nil
+			}
+
+			return NewStringValue(v.graphemes.Str())
+		},
+	)
+}
+
 func (v *StringValue) Storable(storage atree.SlabStorage, address atree.Address, maxInlineSize uint64) (atree.Storable, error) {
 	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
 }
@@ -896,6 +1266,10 @@ func (*StringValue) ChildStorables() []atree.Storable {
 
 var ByteArrayStaticType = ConvertSemaArrayTypeToStaticArrayType(sema.ByteArrayType)
 
+var StringArrayStaticType = ConvertSemaArrayTypeToStaticArrayType(sema.StringArrayType)
+
+var CharacterArrayStaticType = ConvertSemaArrayTypeToStaticArrayType(sema.CharacterArrayType)
+
 // DecodeHex hex-decodes this string and returns an array of UInt8 values
 //
 func (v *StringValue) DecodeHex(interpreter *Interpreter) *ArrayValue {
@@ -1369,49 +1743,312 @@ func (v *ArrayValue) Contains(interpreter *Interpreter, getLocationRange func()
 	return BoolValue(result)
 }
 
-func (v *ArrayValue) GetMember(inter *Interpreter, _ func() LocationRange, name string) Value {
-	switch name {
-	case "length":
-		return NewIntValueFromInt64(int64(v.Count()))
-
-	case "append":
-		return NewHostFunctionValue(
-			func(invocation Invocation) Value {
-				v.Append(
-					invocation.Interpreter,
-					invocation.GetLocationRange,
-					invocation.Arguments[0],
-				)
-				return VoidValue{}
-			},
-			sema.ArrayAppendFunctionType(
-				v.SemaType(inter).ElementType(false),
-			),
-		)
+// FirstIndex returns the index of the first element which is equal to the given value as an optional,
+// or nil if the array does not contain the value
+//
+func (v *ArrayValue) FirstIndex(interpreter *Interpreter, getLocationRange func() LocationRange, needleValue Value) OptionalValue {
 
-	case "appendAll":
-		return NewHostFunctionValue(
-			func(invocation Invocation) Value {
-				otherArray, ok := invocation.Arguments[0].(*ArrayValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
-				}
-				v.AppendAll(
-					invocation.Interpreter,
-					invocation.GetLocationRange,
-					otherArray,
-				)
-				return VoidValue{}
-			},
-			sema.ArrayAppendAllFunctionType(
-				v.SemaType(inter),
-			),
-		)
+	needleEquatable, ok := needleValue.(EquatableValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
 
-	case "concat":
-		return NewHostFunctionValue(
-			func(invocation Invocation) Value {
-				otherArray, ok := invocation.Arguments[0].(*ArrayValue)
+	result := -1
+	index := 0
+	v.Iterate(func(element Value) (resume bool) {
+		if needleEquatable.Equal(interpreter, getLocationRange, element) {
+			result = index
+			// stop iteration
+			return false
+		}
+		index++
+		// continue iteration
+		return true
+	})
+
+	if result < 0 {
+		return NilValue{}
+	}
+
+	return NewSomeValueNonCopying(NewIntValueFromInt64(int64(result)))
+}
+
+// Reverse returns a new array containing the elements of the array in reverse order
+//
+func (v *ArrayValue) Reverse(interpreter *Interpreter, getLocationRange func() LocationRange) Value {
+
+	index := v.Count() - 1
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		v.Type,
+		common.Address{},
+		func() Value {
+			if index < 0 {
+				return nil
+			}
+
+			value := v.Get(interpreter, getLocationRange, index)
+
+			index--
+
+			return value.Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			)
+		},
+	)
+}
+
+// The functions below call the given function values for the elements of the array.
+//
+// The elements are accessed by index, instead of iterating over the underlying atree array,
+// as the called functions may modify the array. Elements which are appended
+// by the called functions are not visited, and removals result in an index out of bounds error.
+
+// Map returns a new variable-sized array containing the results
+// of calling the given function for each element of the array
+//
+func (v *ArrayValue) Map(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	transform FunctionValue,
+	resultType sema.Type,
+) Value {
+
+	argumentTypes := []sema.Type{
+		v.SemaType(interpreter).ElementType(false),
+	}
+
+	count := v.Count()
+	results := make([]Value, 0, count)
+
+	for index := 0; index < count; index++ {
+		interpreter.MeterComputation(1)
+
+		element := v.Get(interpreter, getLocationRange, index)
+
+		result := interpreter.invokeFunctionArgument(
+			transform,
+			[]Value{element},
+			argumentTypes,
+			getLocationRange,
+		)
+
+		results = append(results, result)
+	}
+
+	return NewArrayValue(
+		interpreter,
+		VariableSizedStaticType{
+			Type: ConvertSemaToStaticType(resultType),
+		},
+		common.Address{},
+		results...,
+	)
+}
+
+// Filter returns a new variable-sized array containing the elements of the array
+// for which the given function returns true
+//
+func (v *ArrayValue) Filter(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	isIncluded FunctionValue,
+) Value {
+
+	argumentTypes := []sema.Type{
+		v.SemaType(interpreter).ElementType(false),
+	}
+
+	var results []Value
+
+	count := v.Count()
+
+	for index := 0; index < count; index++ {
+		interpreter.MeterComputation(1)
+
+		element := v.Get(interpreter, getLocationRange, index)
+
+		result := interpreter.invokeFunctionArgument(
+			isIncluded,
+			[]Value{element},
+			argumentTypes,
+			getLocationRange,
+		)
+
+		if !bool(result.(BoolValue)) {
+			continue
+		}
+
+		results = append(
+			results,
+			element.Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			),
+		)
+	}
+
+	return NewArrayValue(
+		interpreter,
+		VariableSizedStaticType{
+			Type: v.Type.ElementType(),
+		},
+		common.Address{},
+		results...,
+	)
+}
+
+// Reduce combines the elements of the array, starting with the given initial value
+//
+func (v *ArrayValue) Reduce(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	initial Value,
+	combine FunctionValue,
+	resultType sema.Type,
+) Value {
+
+	argumentTypes := []sema.Type{
+		resultType,
+		v.SemaType(interpreter).ElementType(false),
+	}
+
+	result := initial
+
+	count := v.Count()
+
+	for index := 0; index < count; index++ {
+		interpreter.MeterComputation(1)
+
+		element := v.Get(interpreter, getLocationRange, index)
+
+		result = interpreter.invokeFunctionArgument(
+			combine,
+			[]Value{result, element},
+			argumentTypes,
+			getLocationRange,
+		)
+	}
+
+	return result
+}
+
+// Sort returns a new array containing the elements of the array,
+// stably sorted using the given function
+//
+func (v *ArrayValue) Sort(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	less FunctionValue,
+) Value {
+
+	elementType := v.SemaType(interpreter).ElementType(false)
+
+	argumentTypes := []sema.Type{
+		elementType,
+		elementType,
+	}
+
+	count := v.Count()
+	elements := make([]Value, 0, count)
+
+	for index := 0; index < count; index++ {
+		elements = append(
+			elements,
+			v.Get(interpreter, getLocationRange, index),
+		)
+	}
+
+	sort.SliceStable(elements, func(i, j int) bool {
+		interpreter.MeterComputation(1)
+
+		result := interpreter.invokeFunctionArgument(
+			less,
+			[]Value{elements[i], elements[j]},
+			argumentTypes,
+			getLocationRange,
+		)
+
+		return bool(result.(BoolValue))
+	})
+
+	index := 0
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		v.Type,
+		common.Address{},
+		func() Value {
+			if index >= len(elements) {
+				return nil
+			}
+
+			value := elements[index]
+
+			index++
+
+			return value.Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			)
+		},
+	)
+}
+
+func (v *ArrayValue) GetMember(inter *Interpreter, _ func() LocationRange, name string) Value {
+	switch name {
+	case "length":
+		return NewIntValueFromInt64(int64(v.Count()))
+
+	case "append":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				v.Append(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+				return VoidValue{}
+			},
+			sema.ArrayAppendFunctionType(
+				v.SemaType(inter).ElementType(false),
+			),
+		)
+
+	case "appendAll":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				otherArray, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				v.AppendAll(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					otherArray,
+				)
+				return VoidValue{}
+			},
+			sema.ArrayAppendAllFunctionType(
+				v.SemaType(inter),
+			),
+		)
+
+	case "concat":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				otherArray, ok := invocation.Arguments[0].(*ArrayValue)
 				if !ok {
 					panic(errors.NewUnreachableError())
 				}
@@ -1515,35 +2152,153 @@ func (v *ArrayValue) GetMember(inter *Interpreter, _ func() LocationRange, name
 				v.SemaType(inter).ElementType(false),
 			),
 		)
-	}
-
-	return nil
-}
-
-func (*ArrayValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
-	// Arrays have no removable members (fields / functions)
-	panic(errors.NewUnreachableError())
-}
 
-func (*ArrayValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
-	// Arrays have no settable members (fields / functions)
-	panic(errors.NewUnreachableError())
-}
+	case "firstIndex":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.FirstIndex(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.ArrayFirstIndexFunctionType(
+				v.SemaType(inter).ElementType(false),
+			),
+		)
 
-func (v *ArrayValue) Count() int {
-	return int(v.array.Count())
-}
+	case "reverse":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.Reverse(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+				)
+			},
+			sema.ArrayReverseFunctionType(
+				v.SemaType(inter),
+			),
+		)
 
-func (v *ArrayValue) ConformsToDynamicType(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	dynamicType DynamicType,
-	results TypeConformanceResults,
-) bool {
+	case "map":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				transform, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
-	arrayType, ok := dynamicType.(*ArrayDynamicType)
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
 
-	if !ok || v.Count() != len(arrayType.ElementTypes) {
+				return v.Map(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					transform,
+					typeParameterPair.Value,
+				)
+			},
+			sema.ArrayMapFunctionType(
+				v.SemaType(inter).ElementType(false),
+			),
+		)
+
+	case "filter":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				isIncluded, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Filter(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					isIncluded,
+				)
+			},
+			sema.ArrayFilterFunctionType(
+				v.SemaType(inter).ElementType(false),
+			),
+		)
+
+	case "reduce":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				initial := invocation.Arguments[0]
+
+				combine, ok := invocation.Arguments[1].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Reduce(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					initial,
+					combine,
+					typeParameterPair.Value,
+				)
+			},
+			sema.ArrayReduceFunctionType(
+				v.SemaType(inter).ElementType(false),
+			),
+		)
+
+	case "sort":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				less, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Sort(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					less,
+				)
+			},
+			sema.ArraySortFunctionType(
+				v.SemaType(inter),
+			),
+		)
+	}
+
+	return nil
+}
+
+func (*ArrayValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Arrays have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (*ArrayValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Arrays have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *ArrayValue) Count() int {
+	return int(v.array.Count())
+}
+
+func (v *ArrayValue) ConformsToDynamicType(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	dynamicType DynamicType,
+	results TypeConformanceResults,
+) bool {
+
+	arrayType, ok := dynamicType.(*ArrayDynamicType)
+
+	if !ok || v.Count() != len(arrayType.ElementTypes) {
 		return false
 	}
 
@@ -2250,8 +3005,1011 @@ func (v IntValue) LessEqual(other NumberValue) BoolValue {
 	return cmp <= 0
 }
 
-func (v IntValue) Greater(other NumberValue) BoolValue {
-	o, ok := other.(IntValue)
+func (v IntValue) Greater(other NumberValue) BoolValue {
+	o, ok := other.(IntValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == 1
+}
+
+func (v IntValue) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(IntValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp >= 0
+}
+
+func (v IntValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt, ok := other.(IntValue)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeInt (1 byte)
+// - big int encoded in big-endian (n bytes)
+func (v IntValue) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeInt)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func (v IntValue) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(IntValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseOr,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	res := new(big.Int)
+	res.Or(v.BigInt, o.BigInt)
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(IntValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseXor,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	res := new(big.Int)
+	res.Xor(v.BigInt, o.BigInt)
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(IntValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseAnd,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	res := new(big.Int)
+	res.And(v.BigInt, o.BigInt)
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(IntValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseLeftShift,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return IntValue{res}
+}
+
+func (v IntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(IntValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseRightShift,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return IntValue{res}
+}
+
+func (v IntValue) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.IntType)
+}
+
+func (IntValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (IntValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v IntValue) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
+}
+
+func (v IntValue) ConformsToDynamicType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	dynamicType DynamicType,
+	_ TypeConformanceResults,
+) bool {
+	numberType, ok := dynamicType.(NumberDynamicType)
+	return ok && sema.IntType.Equal(numberType.StaticType)
+}
+
+func (v IntValue) Storable(storage atree.SlabStorage, address atree.Address, maxInlineSize uint64) (atree.Storable, error) {
+	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
+}
+
+func (IntValue) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (IntValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v IntValue) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v IntValue) Clone(_ *Interpreter) Value {
+	return NewIntValueFromBigInt(v.BigInt)
+}
+
+func (IntValue) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v IntValue) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v IntValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (IntValue) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// Int8Value
+
+type Int8Value int8
+
+var _ Value = Int8Value(0)
+var _ atree.Storable = Int8Value(0)
+var _ NumberValue = Int8Value(0)
+var _ IntegerValue = Int8Value(0)
+var _ EquatableValue = Int8Value(0)
+var _ HashableValue = Int8Value(0)
+
+func (Int8Value) IsValue() {}
+
+func (v Int8Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitInt8Value(interpreter, v)
+}
+
+func (Int8Value) Walk(_ func(Value)) {
+	// NO-OP
+}
+
+var int8DynamicType DynamicType = NumberDynamicType{sema.Int8Type}
+
+func (Int8Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return int8DynamicType
+}
+
+func (Int8Value) StaticType() StaticType {
+	return PrimitiveStaticTypeInt8
+}
+
+func (v Int8Value) String() string {
+	return format.Int(int64(v))
+}
+
+func (v Int8Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Int8Value) ToInt() int {
+	return int(v)
+}
+
+func (v Int8Value) Negate() NumberValue {
+	// INT32-C
+	if v == math.MinInt8 {
+		panic(OverflowError{})
+	}
+	return -v
+}
+
+func (v Int8Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt8 - o)) {
+		panic(OverflowError{})
+	} else if (o < 0) && (v < (math.MinInt8 - o)) {
+		panic(UnderflowError{})
+	}
+	return v + o
+}
+
+func (v Int8Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt8 - o)) {
+		return Int8Value(math.MaxInt8)
+	} else if (o < 0) && (v < (math.MinInt8 - o)) {
+		return Int8Value(math.MinInt8)
+	}
+	return v + o
+}
+
+func (v Int8Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt8 + o)) {
+		panic(OverflowError{})
+	} else if (o < 0) && (v > (math.MaxInt8 + o)) {
+		panic(UnderflowError{})
+	}
+	return v - o
+}
+
+func (v Int8Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt8 + o)) {
+		return Int8Value(math.MinInt8)
+	} else if (o < 0) && (v > (math.MaxInt8 + o)) {
+		return Int8Value(math.MaxInt8)
+	}
+	return v - o
+}
+
+func (v Int8Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT33-C
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+	return v % o
+}
+
+func (v Int8Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			// positive * positive = positive. overflow?
+			if v > (math.MaxInt8 / o) {
+				panic(OverflowError{})
+			}
+		} else {
+			// positive * negative = negative. underflow?
+			if o < (math.MinInt8 / v) {
+				panic(UnderflowError{})
+			}
+		}
+	} else {
+		if o > 0 {
+			// negative * positive = negative. underflow?
+			if v < (math.MinInt8 / o) {
+				panic(UnderflowError{})
+			}
+		} else {
+			// negative * negative = positive. overflow?
+			if (v != 0) && (o < (math.MaxInt8 / v)) {
+				panic(OverflowError{})
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int8Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			// positive * positive = positive. overflow?
+			if v > (math.MaxInt8 / o) {
+				return Int8Value(math.MaxInt8)
+			}
+		} else {
+			// positive * negative = negative. underflow?
+			if o < (math.MinInt8 / v) {
+				return Int8Value(math.MinInt8)
+			}
+		}
+	} else {
+		if o > 0 {
+			// negative * positive = negative. underflow?
+			if v < (math.MinInt8 / o) {
+				return Int8Value(math.MinInt8)
+			}
+		} else {
+			// negative * negative = positive. overflow?
+			if (v != 0) && (o < (math.MaxInt8 / v)) {
+				return Int8Value(math.MaxInt8)
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int8Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt8) && (o == -1) {
+		panic(OverflowError{})
+	}
+	return v / o
+}
+
+func (v Int8Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt8) && (o == -1) {
+		return Int8Value(math.MaxInt8)
+	}
+	return v / o
+}
+
+func (v Int8Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v < o
+}
+
+func (v Int8Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v <= o
+}
+
+func (v Int8Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v > o
+}
+
+func (v Int8Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v >= o
+}
+
+func (v Int8Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt8, ok := other.(Int8Value)
+	if !ok {
+		return false
+	}
+	return v == otherInt8
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeInt8 (1 byte)
+// - int8 value (1 byte)
+func (v Int8Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeInt8)
+	scratch[1] = byte(v)
+	return scratch[:2]
+}
+
+func ConvertInt8(value Value) Int8Value {
+	var res int8
+
+	switch value := value.(type) {
+	case BigNumberValue:
+		v := value.ToBigInt()
+		if v.Cmp(sema.Int8TypeMaxInt) > 0 {
+			panic(OverflowError{})
+		} else if v.Cmp(sema.Int8TypeMinInt) < 0 {
+			panic(UnderflowError{})
+		}
+		res = int8(v.Int64())
+
+	case NumberValue:
+		v := value.ToInt()
+		if v > math.MaxInt8 {
+			panic(OverflowError{})
+		} else if v < math.MinInt8 {
+			panic(UnderflowError{})
+		}
+		res = int8(v)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	return Int8Value(res)
+}
+
+func (v Int8Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseOr,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v | o
+}
+
+func (v Int8Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseXor,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v ^ o
+}
+
+func (v Int8Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseAnd,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v & o
+}
+
+func (v Int8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseLeftShift,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v << o
+}
+
+func (v Int8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int8Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseRightShift,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v >> o
+}
+
+func (v Int8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Int8Type)
+}
+
+func (Int8Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Int8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Int8Value) ToBigEndianBytes() []byte {
+	return []byte{byte(v)}
+}
+
+func (v Int8Value) ConformsToDynamicType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	dynamicType DynamicType,
+	_ TypeConformanceResults,
+) bool {
+	numberType, ok := dynamicType.(NumberDynamicType)
+	return ok && sema.Int8Type.Equal(numberType.StaticType)
+}
+
+func (v Int8Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (Int8Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Int8Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v Int8Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Int8Value) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (Int8Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v Int8Value) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
+}
+
+func (v Int8Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Int8Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// Int16Value
+
+type Int16Value int16
+
+var _ Value = Int16Value(0)
+var _ atree.Storable = Int16Value(0)
+var _ NumberValue = Int16Value(0)
+var _ IntegerValue = Int16Value(0)
+var _ EquatableValue = Int16Value(0)
+var _ HashableValue = Int16Value(0)
+var _ MemberAccessibleValue = Int16Value(0)
+
+func (Int16Value) IsValue() {}
+
+func (v Int16Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitInt16Value(interpreter, v)
+}
+
+func (Int16Value) Walk(_ func(Value)) {
+	// NO-OP
+}
+
+var int16DynamicType DynamicType = NumberDynamicType{sema.Int16Type}
+
+func (Int16Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return int16DynamicType
+}
+
+func (Int16Value) StaticType() StaticType {
+	return PrimitiveStaticTypeInt16
+}
+
+func (v Int16Value) String() string {
+	return format.Int(int64(v))
+}
+
+func (v Int16Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Int16Value) ToInt() int {
+	return int(v)
+}
+
+func (v Int16Value) Negate() NumberValue {
+	// INT32-C
+	if v == math.MinInt16 {
+		panic(OverflowError{})
+	}
+	return -v
+}
+
+func (v Int16Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt16 - o)) {
+		panic(OverflowError{})
+	} else if (o < 0) && (v < (math.MinInt16 - o)) {
+		panic(UnderflowError{})
+	}
+	return v + o
+}
+
+func (v Int16Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt16 - o)) {
+		return Int16Value(math.MaxInt16)
+	} else if (o < 0) && (v < (math.MinInt16 - o)) {
+		return Int16Value(math.MinInt16)
+	}
+	return v + o
+}
+
+func (v Int16Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt16 + o)) {
+		panic(OverflowError{})
+	} else if (o < 0) && (v > (math.MaxInt16 + o)) {
+		panic(UnderflowError{})
+	}
+	return v - o
+}
+
+func (v Int16Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt16 + o)) {
+		return Int16Value(math.MinInt16)
+	} else if (o < 0) && (v > (math.MaxInt16 + o)) {
+		return Int16Value(math.MaxInt16)
+	}
+	return v - o
+}
+
+func (v Int16Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT33-C
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+	return v % o
+}
+
+func (v Int16Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			// positive * positive = positive. overflow?
+			if v > (math.MaxInt16 / o) {
+				panic(OverflowError{})
+			}
+		} else {
+			// positive * negative = negative. underflow?
+			if o < (math.MinInt16 / v) {
+				panic(UnderflowError{})
+			}
+		}
+	} else {
+		if o > 0 {
+			// negative * positive = negative. underflow?
+			if v < (math.MinInt16 / o) {
+				panic(UnderflowError{})
+			}
+		} else {
+			// negative * negative = positive. overflow?
+			if (v != 0) && (o < (math.MaxInt16 / v)) {
+				panic(OverflowError{})
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int16Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if v > 0 {
+		if o > 0 {
+			// positive * positive = positive. overflow?
+			if v > (math.MaxInt16 / o) {
+				return Int16Value(math.MaxInt16)
+			}
+		} else {
+			// positive * negative = negative. underflow?
+			if o < (math.MinInt16 / v) {
+				return Int16Value(math.MinInt16)
+			}
+		}
+	} else {
+		if o > 0 {
+			// negative * positive = negative. underflow?
+			if v < (math.MinInt16 / o) {
+				return Int16Value(math.MinInt16)
+			}
+		} else {
+			// negative * negative = positive. overflow?
+			if (v != 0) && (o < (math.MaxInt16 / v)) {
+				return Int16Value(math.MaxInt16)
+			}
+		}
+	}
+	return v * o
+}
+
+func (v Int16Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt16) && (o == -1) {
+		panic(OverflowError{})
+	}
+	return v / o
+}
+
+func (v Int16Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT33-C
+	// https://golang.org/ref/spec#Integer_operators
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt16) && (o == -1) {
+		return Int16Value(math.MaxInt16)
+	}
+	return v / o
+}
+
+func (v Int16Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v < o
+}
+
+func (v Int16Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	return v <= o
+}
+
+func (v Int16Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Int16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -2260,12 +4018,11 @@ func (v IntValue) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == 1
+	return v > o
 }
 
-func (v IntValue) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(IntValue)
+func (v Int16Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -2274,40 +4031,57 @@ func (v IntValue) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp >= 0
+	return v >= o
 }
 
-func (v IntValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt, ok := other.(IntValue)
+func (v Int16Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt16, ok := other.(Int16Value)
 	if !ok {
 		return false
 	}
-	cmp := v.BigInt.Cmp(otherInt.BigInt)
-	return cmp == 0
+	return v == otherInt16
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeInt (1 byte)
-// - big int encoded in big-endian (n bytes)
-func (v IntValue) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	b := SignedBigIntToBigEndianBytes(v.BigInt)
+// - HashInputTypeInt16 (1 byte)
+// - int16 value encoded in big-endian (2 bytes)
+func (v Int16Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeInt16)
+	binary.BigEndian.PutUint16(scratch[1:], uint16(v))
+	return scratch[:3]
+}
 
-	length := 1 + len(b)
-	var buffer []byte
-	if length <= len(scratch) {
-		buffer = scratch[:length]
-	} else {
-		buffer = make([]byte, length)
+func ConvertInt16(value Value) Int16Value {
+	var res int16
+
+	switch value := value.(type) {
+	case BigNumberValue:
+		v := value.ToBigInt()
+		if v.Cmp(sema.Int16TypeMaxInt) > 0 {
+			panic(OverflowError{})
+		} else if v.Cmp(sema.Int16TypeMinInt) < 0 {
+			panic(UnderflowError{})
+		}
+		res = int16(v.Int64())
+
+	case NumberValue:
+		v := value.ToInt()
+		if v > math.MaxInt16 {
+			panic(OverflowError{})
+		} else if v < math.MinInt16 {
+			panic(UnderflowError{})
+		}
+		res = int16(v)
+
+	default:
+		panic(errors.NewUnreachableError())
 	}
 
-	buffer[0] = byte(HashInputTypeInt)
-	copy(buffer[1:], b)
-	return buffer
+	return Int16Value(res)
 }
 
-func (v IntValue) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(IntValue)
+func (v Int16Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Int16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -2316,13 +4090,11 @@ func (v IntValue) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Or(v.BigInt, o.BigInt)
-	return IntValue{res}
+	return v | o
 }
 
-func (v IntValue) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(IntValue)
+func (v Int16Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Int16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -2331,13 +4103,11 @@ func (v IntValue) BitwiseXor(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Xor(v.BigInt, o.BigInt)
-	return IntValue{res}
+	return v ^ o
 }
 
-func (v IntValue) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(IntValue)
+func (v Int16Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Int16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -2346,13 +4116,11 @@ func (v IntValue) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.And(v.BigInt, o.BigInt)
-	return IntValue{res}
+	return v & o
 }
 
-func (v IntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(IntValue)
+func (v Int16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -2361,19 +4129,11 @@ func (v IntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return IntValue{res}
+	return v << o
 }
 
-func (v IntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(IntValue)
+func (v Int16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -2382,58 +4142,52 @@ func (v IntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return IntValue{res}
+	return v >> o
 }
 
-func (v IntValue) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.IntType)
+func (v Int16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Int16Type)
 }
 
-func (IntValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Int16Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (IntValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Int16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v IntValue) ToBigEndianBytes() []byte {
-	return SignedBigIntToBigEndianBytes(v.BigInt)
+func (v Int16Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(v))
+	return b
 }
 
-func (v IntValue) ConformsToDynamicType(
+func (v Int16Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.IntType.Equal(numberType.StaticType)
+	return ok && sema.Int16Type.Equal(numberType.StaticType)
 }
 
-func (v IntValue) Storable(storage atree.SlabStorage, address atree.Address, maxInlineSize uint64) (atree.Storable, error) {
-	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
+func (v Int16Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
 }
 
-func (IntValue) NeedsStoreTo(_ atree.Address) bool {
+func (Int16Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (IntValue) IsResourceKinded(_ *Interpreter) bool {
+func (Int16Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v IntValue) Transfer(
+func (v Int16Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -2446,79 +4200,80 @@ func (v IntValue) Transfer(
 	return v
 }
 
-func (v IntValue) Clone(_ *Interpreter) Value {
-	return NewIntValueFromBigInt(v.BigInt)
+func (v Int16Value) Clone(_ *Interpreter) Value {
+	return v
 }
 
-func (IntValue) DeepRemove(_ *Interpreter) {
+func (Int16Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v IntValue) ByteSize() uint32 {
-	return cborTagSize + getBigIntCBORSize(v.BigInt)
+func (v Int16Value) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
 }
 
-func (v IntValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Int16Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (IntValue) ChildStorables() []atree.Storable {
+func (Int16Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Int8Value
+// Int32Value
 
-type Int8Value int8
+type Int32Value int32
 
-var _ Value = Int8Value(0)
-var _ atree.Storable = Int8Value(0)
-var _ NumberValue = Int8Value(0)
-var _ IntegerValue = Int8Value(0)
-var _ EquatableValue = Int8Value(0)
-var _ HashableValue = Int8Value(0)
+var _ Value = Int32Value(0)
+var _ atree.Storable = Int32Value(0)
+var _ NumberValue = Int32Value(0)
+var _ IntegerValue = Int32Value(0)
+var _ EquatableValue = Int32Value(0)
+var _ HashableValue = Int32Value(0)
+var _ MemberAccessibleValue = Int32Value(0)
 
-func (Int8Value) IsValue() {}
+func (Int32Value) IsValue() {}
 
-func (v Int8Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitInt8Value(interpreter, v)
+func (v Int32Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitInt32Value(interpreter, v)
 }
 
-func (Int8Value) Walk(_ func(Value)) {
+func (Int32Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var int8DynamicType DynamicType = NumberDynamicType{sema.Int8Type}
+var int32DynamicType DynamicType = NumberDynamicType{sema.Int32Type}
 
-func (Int8Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return int8DynamicType
+func (Int32Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return int32DynamicType
 }
 
-func (Int8Value) StaticType() StaticType {
-	return PrimitiveStaticTypeInt8
+func (Int32Value) StaticType() StaticType {
+	return PrimitiveStaticTypeInt32
 }
 
-func (v Int8Value) String() string {
+func (v Int32Value) String() string {
 	return format.Int(int64(v))
 }
 
-func (v Int8Value) RecursiveString(_ SeenReferences) string {
+func (v Int32Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Int8Value) ToInt() int {
+func (v Int32Value) ToInt() int {
 	return int(v)
 }
 
-func (v Int8Value) Negate() NumberValue {
+func (v Int32Value) Negate() NumberValue {
 	// INT32-C
-	if v == math.MinInt8 {
+	if v == math.MinInt32 {
 		panic(OverflowError{})
 	}
 	return -v
 }
 
-func (v Int8Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -2528,16 +4283,16 @@ func (v Int8Value) Plus(other NumberValue) NumberValue {
 	}
 
 	// INT32-C
-	if (o > 0) && (v > (math.MaxInt8 - o)) {
+	if (o > 0) && (v > (math.MaxInt32 - o)) {
 		panic(OverflowError{})
-	} else if (o < 0) && (v < (math.MinInt8 - o)) {
+	} else if (o < 0) && (v < (math.MinInt32 - o)) {
 		panic(UnderflowError{})
 	}
 	return v + o
 }
 
-func (v Int8Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -2547,16 +4302,16 @@ func (v Int8Value) SaturatingPlus(other NumberValue) NumberValue {
 	}
 
 	// INT32-C
-	if (o > 0) && (v > (math.MaxInt8 - o)) {
-		return Int8Value(math.MaxInt8)
-	} else if (o < 0) && (v < (math.MinInt8 - o)) {
-		return Int8Value(math.MinInt8)
+	if (o > 0) && (v > (math.MaxInt32 - o)) {
+		return Int32Value(math.MaxInt32)
+	} else if (o < 0) && (v < (math.MinInt32 - o)) {
+		return Int32Value(math.MinInt32)
 	}
 	return v + o
 }
 
-func (v Int8Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -2566,16 +4321,16 @@ func (v Int8Value) Minus(other NumberValue) NumberValue {
 	}
 
 	// INT32-C
-	if (o > 0) && (v < (math.MinInt8 + o)) {
+	if (o > 0) && (v < (math.MinInt32 + o)) {
 		panic(OverflowError{})
-	} else if (o < 0) && (v > (math.MaxInt8 + o)) {
+	} else if (o < 0) && (v > (math.MaxInt32 + o)) {
 		panic(UnderflowError{})
 	}
 	return v - o
 }
 
-func (v Int8Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -2585,16 +4340,16 @@ func (v Int8Value) SaturatingMinus(other NumberValue) NumberValue {
 	}
 
 	// INT32-C
-	if (o > 0) && (v < (math.MinInt8 + o)) {
-		return Int8Value(math.MinInt8)
-	} else if (o < 0) && (v > (math.MaxInt8 + o)) {
-		return Int8Value(math.MaxInt8)
+	if (o > 0) && (v < (math.MinInt32 + o)) {
+		return Int32Value(math.MinInt32)
+	} else if (o < 0) && (v > (math.MaxInt32 + o)) {
+		return Int32Value(math.MaxInt32)
 	}
 	return v - o
 }
 
-func (v Int8Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -2610,8 +4365,8 @@ func (v Int8Value) Mod(other NumberValue) NumberValue {
 	return v % o
 }
 
-func (v Int8Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -2624,24 +4379,24 @@ func (v Int8Value) Mul(other NumberValue) NumberValue {
 	if v > 0 {
 		if o > 0 {
 			// positive * positive = positive. overflow?
-			if v > (math.MaxInt8 / o) {
+			if v > (math.MaxInt32 / o) {
 				panic(OverflowError{})
 			}
 		} else {
 			// positive * negative = negative. underflow?
-			if o < (math.MinInt8 / v) {
+			if o < (math.MinInt32 / v) {
 				panic(UnderflowError{})
 			}
 		}
 	} else {
 		if o > 0 {
 			// negative * positive = negative. underflow?
-			if v < (math.MinInt8 / o) {
+			if v < (math.MinInt32 / o) {
 				panic(UnderflowError{})
 			}
 		} else {
 			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt8 / v)) {
+			if (v != 0) && (o < (math.MaxInt32 / v)) {
 				panic(OverflowError{})
 			}
 		}
@@ -2649,8 +4404,8 @@ func (v Int8Value) Mul(other NumberValue) NumberValue {
 	return v * o
 }
 
-func (v Int8Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -2663,33 +4418,33 @@ func (v Int8Value) SaturatingMul(other NumberValue) NumberValue {
 	if v > 0 {
 		if o > 0 {
 			// positive * positive = positive. overflow?
-			if v > (math.MaxInt8 / o) {
-				return Int8Value(math.MaxInt8)
+			if v > (math.MaxInt32 / o) {
+				return Int32Value(math.MaxInt32)
 			}
 		} else {
 			// positive * negative = negative. underflow?
-			if o < (math.MinInt8 / v) {
-				return Int8Value(math.MinInt8)
+			if o < (math.MinInt32 / v) {
+				return Int32Value(math.MinInt32)
 			}
 		}
 	} else {
 		if o > 0 {
 			// negative * positive = negative. underflow?
-			if v < (math.MinInt8 / o) {
-				return Int8Value(math.MinInt8)
+			if v < (math.MinInt32 / o) {
+				return Int32Value(math.MinInt32)
 			}
 		} else {
 			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt8 / v)) {
-				return Int8Value(math.MaxInt8)
+			if (v != 0) && (o < (math.MaxInt32 / v)) {
+				return Int32Value(math.MaxInt32)
 			}
 		}
 	}
 	return v * o
 }
 
-func (v Int8Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -2702,14 +4457,14 @@ func (v Int8Value) Div(other NumberValue) NumberValue {
 	// https://golang.org/ref/spec#Integer_operators
 	if o == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt8) && (o == -1) {
+	} else if (v == math.MinInt32) && (o == -1) {
 		panic(OverflowError{})
 	}
 	return v / o
 }
 
-func (v Int8Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
@@ -2722,14 +4477,14 @@ func (v Int8Value) SaturatingDiv(other NumberValue) NumberValue {
 	// https://golang.org/ref/spec#Integer_operators
 	if o == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt8) && (o == -1) {
-		return Int8Value(math.MaxInt8)
+	} else if (v == math.MinInt32) && (o == -1) {
+		return Int32Value(math.MaxInt32)
 	}
 	return v / o
 }
 
-func (v Int8Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -2741,8 +4496,8 @@ func (v Int8Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v Int8Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -2754,8 +4509,8 @@ func (v Int8Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v Int8Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -2767,8 +4522,8 @@ func (v Int8Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v Int8Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -2780,54 +4535,54 @@ func (v Int8Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v Int8Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt8, ok := other.(Int8Value)
+func (v Int32Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt32, ok := other.(Int32Value)
 	if !ok {
 		return false
 	}
-	return v == otherInt8
+	return v == otherInt32
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeInt8 (1 byte)
-// - int8 value (1 byte)
-func (v Int8Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeInt8)
-	scratch[1] = byte(v)
-	return scratch[:2]
+// - HashInputTypeInt32 (1 byte)
+// - int32 value encoded in big-endian (4 bytes)
+func (v Int32Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeInt32)
+	binary.BigEndian.PutUint32(scratch[1:], uint32(v))
+	return scratch[:5]
 }
 
-func ConvertInt8(value Value) Int8Value {
-	var res int8
+func ConvertInt32(value Value) Int32Value {
+	var res int32
 
 	switch value := value.(type) {
 	case BigNumberValue:
 		v := value.ToBigInt()
-		if v.Cmp(sema.Int8TypeMaxInt) > 0 {
+		if v.Cmp(sema.Int32TypeMaxInt) > 0 {
 			panic(OverflowError{})
-		} else if v.Cmp(sema.Int8TypeMinInt) < 0 {
+		} else if v.Cmp(sema.Int32TypeMinInt) < 0 {
 			panic(UnderflowError{})
 		}
-		res = int8(v.Int64())
+		res = int32(v.Int64())
 
 	case NumberValue:
 		v := value.ToInt()
-		if v > math.MaxInt8 {
+		if v > math.MaxInt32 {
 			panic(OverflowError{})
-		} else if v < math.MinInt8 {
+		} else if v < math.MinInt32 {
 			panic(UnderflowError{})
 		}
-		res = int8(v)
+		res = int32(v)
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return Int8Value(res)
+	return Int32Value(res)
 }
 
-func (v Int8Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -2839,8 +4594,8 @@ func (v Int8Value) BitwiseOr(other IntegerValue) IntegerValue {
 	return v | o
 }
 
-func (v Int8Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -2852,8 +4607,8 @@ func (v Int8Value) BitwiseXor(other IntegerValue) IntegerValue {
 	return v ^ o
 }
 
-func (v Int8Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -2865,8 +4620,8 @@ func (v Int8Value) BitwiseAnd(other IntegerValue) IntegerValue {
 	return v & o
 }
 
-func (v Int8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -2878,8 +4633,8 @@ func (v Int8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 	return v << o
 }
 
-func (v Int8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int8Value)
+func (v Int32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -2891,47 +4646,49 @@ func (v Int8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Int8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Int8Type)
+func (v Int32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Int32Type)
 }
 
-func (Int8Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Int32Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Int8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Int32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Int8Value) ToBigEndianBytes() []byte {
-	return []byte{byte(v)}
+func (v Int32Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(v))
+	return b
 }
 
-func (v Int8Value) ConformsToDynamicType(
+func (v Int32Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Int8Type.Equal(numberType.StaticType)
+	return ok && sema.Int32Type.Equal(numberType.StaticType)
 }
 
-func (v Int8Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Int32Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Int8Value) NeedsStoreTo(_ atree.Address) bool {
+func (Int32Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Int8Value) IsResourceKinded(_ *Interpreter) bool {
+func (Int32Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Int8Value) Transfer(
+func (v Int32Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -2944,80 +4701,90 @@ func (v Int8Value) Transfer(
 	return v
 }
 
-func (v Int8Value) Clone(_ *Interpreter) Value {
+func (v Int32Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (Int8Value) DeepRemove(_ *Interpreter) {
+func (Int32Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Int8Value) ByteSize() uint32 {
+func (v Int32Value) ByteSize() uint32 {
 	return cborTagSize + getIntCBORSize(int64(v))
 }
 
-func (v Int8Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Int32Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Int8Value) ChildStorables() []atree.Storable {
+func (Int32Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Int16Value
+// Int64Value
 
-type Int16Value int16
+type Int64Value int64
 
-var _ Value = Int16Value(0)
-var _ atree.Storable = Int16Value(0)
-var _ NumberValue = Int16Value(0)
-var _ IntegerValue = Int16Value(0)
-var _ EquatableValue = Int16Value(0)
-var _ HashableValue = Int16Value(0)
-var _ MemberAccessibleValue = Int16Value(0)
+var _ Value = Int64Value(0)
+var _ atree.Storable = Int64Value(0)
+var _ NumberValue = Int64Value(0)
+var _ IntegerValue = Int64Value(0)
+var _ EquatableValue = Int64Value(0)
+var _ HashableValue = Int64Value(0)
+var _ MemberAccessibleValue = Int64Value(0)
 
-func (Int16Value) IsValue() {}
+func (Int64Value) IsValue() {}
 
-func (v Int16Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitInt16Value(interpreter, v)
+func (v Int64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitInt64Value(interpreter, v)
 }
 
-func (Int16Value) Walk(_ func(Value)) {
+func (Int64Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var int16DynamicType DynamicType = NumberDynamicType{sema.Int16Type}
+var int64DynamicType DynamicType = NumberDynamicType{sema.Int64Type}
 
-func (Int16Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return int16DynamicType
+func (Int64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return int64DynamicType
 }
 
-func (Int16Value) StaticType() StaticType {
-	return PrimitiveStaticTypeInt16
+func (Int64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeInt64
 }
 
-func (v Int16Value) String() string {
+func (v Int64Value) String() string {
 	return format.Int(int64(v))
 }
 
-func (v Int16Value) RecursiveString(_ SeenReferences) string {
+func (v Int64Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Int16Value) ToInt() int {
+func (v Int64Value) ToInt() int {
 	return int(v)
 }
 
-func (v Int16Value) Negate() NumberValue {
+func (v Int64Value) Negate() NumberValue {
 	// INT32-C
-	if v == math.MinInt16 {
+	if v == math.MinInt64 {
 		panic(OverflowError{})
 	}
 	return -v
 }
 
-func (v Int16Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func safeAddInt64(a, b int64) int64 {
+	// INT32-C
+	if (b > 0) && (a > (math.MaxInt64 - b)) {
+		panic(OverflowError{})
+	} else if (b < 0) && (a < (math.MinInt64 - b)) {
+		panic(UnderflowError{})
+	}
+	return a + b
+}
+
+func (v Int64Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -3026,17 +4793,11 @@ func (v Int16Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v > (math.MaxInt16 - o)) {
-		panic(OverflowError{})
-	} else if (o < 0) && (v < (math.MinInt16 - o)) {
-		panic(UnderflowError{})
-	}
-	return v + o
+	return Int64Value(safeAddInt64(int64(v), int64(o)))
 }
 
-func (v Int16Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -3046,16 +4807,16 @@ func (v Int16Value) SaturatingPlus(other NumberValue) NumberValue {
 	}
 
 	// INT32-C
-	if (o > 0) && (v > (math.MaxInt16 - o)) {
-		return Int16Value(math.MaxInt16)
-	} else if (o < 0) && (v < (math.MinInt16 - o)) {
-		return Int16Value(math.MinInt16)
+	if (o > 0) && (v > (math.MaxInt64 - o)) {
+		return Int64Value(math.MaxInt64)
+	} else if (o < 0) && (v < (math.MinInt64 - o)) {
+		return Int64Value(math.MinInt64)
 	}
 	return v + o
 }
 
-func (v Int16Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -3065,16 +4826,16 @@ func (v Int16Value) Minus(other NumberValue) NumberValue {
 	}
 
 	// INT32-C
-	if (o > 0) && (v < (math.MinInt16 + o)) {
+	if (o > 0) && (v < (math.MinInt64 + o)) {
 		panic(OverflowError{})
-	} else if (o < 0) && (v > (math.MaxInt16 + o)) {
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
 		panic(UnderflowError{})
 	}
 	return v - o
 }
 
-func (v Int16Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -3084,16 +4845,16 @@ func (v Int16Value) SaturatingMinus(other NumberValue) NumberValue {
 	}
 
 	// INT32-C
-	if (o > 0) && (v < (math.MinInt16 + o)) {
-		return Int16Value(math.MinInt16)
-	} else if (o < 0) && (v > (math.MaxInt16 + o)) {
-		return Int16Value(math.MaxInt16)
+	if (o > 0) && (v < (math.MinInt64 + o)) {
+		return Int64Value(math.MinInt64)
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+		return Int64Value(math.MaxInt64)
 	}
 	return v - o
 }
 
-func (v Int16Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -3109,8 +4870,8 @@ func (v Int16Value) Mod(other NumberValue) NumberValue {
 	return v % o
 }
 
-func (v Int16Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -3123,24 +4884,24 @@ func (v Int16Value) Mul(other NumberValue) NumberValue {
 	if v > 0 {
 		if o > 0 {
 			// positive * positive = positive. overflow?
-			if v > (math.MaxInt16 / o) {
+			if v > (math.MaxInt64 / o) {
 				panic(OverflowError{})
 			}
 		} else {
 			// positive * negative = negative. underflow?
-			if o < (math.MinInt16 / v) {
+			if o < (math.MinInt64 / v) {
 				panic(UnderflowError{})
 			}
 		}
 	} else {
 		if o > 0 {
 			// negative * positive = negative. underflow?
-			if v < (math.MinInt16 / o) {
+			if v < (math.MinInt64 / o) {
 				panic(UnderflowError{})
 			}
 		} else {
 			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt16 / v)) {
+			if (v != 0) && (o < (math.MaxInt64 / v)) {
 				panic(OverflowError{})
 			}
 		}
@@ -3148,8 +4909,8 @@ func (v Int16Value) Mul(other NumberValue) NumberValue {
 	return v * o
 }
 
-func (v Int16Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -3162,33 +4923,33 @@ func (v Int16Value) SaturatingMul(other NumberValue) NumberValue {
 	if v > 0 {
 		if o > 0 {
 			// positive * positive = positive. overflow?
-			if v > (math.MaxInt16 / o) {
-				return Int16Value(math.MaxInt16)
+			if v > (math.MaxInt64 / o) {
+				return Int64Value(math.MaxInt64)
 			}
 		} else {
 			// positive * negative = negative. underflow?
-			if o < (math.MinInt16 / v) {
-				return Int16Value(math.MinInt16)
+			if o < (math.MinInt64 / v) {
+				return Int64Value(math.MinInt64)
 			}
 		}
 	} else {
 		if o > 0 {
 			// negative * positive = negative. underflow?
-			if v < (math.MinInt16 / o) {
-				return Int16Value(math.MinInt16)
+			if v < (math.MinInt64 / o) {
+				return Int64Value(math.MinInt64)
 			}
 		} else {
 			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt16 / v)) {
-				return Int16Value(math.MaxInt16)
+			if (v != 0) && (o < (math.MaxInt64 / v)) {
+				return Int64Value(math.MaxInt64)
 			}
 		}
 	}
 	return v * o
 }
 
-func (v Int16Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -3201,14 +4962,14 @@ func (v Int16Value) Div(other NumberValue) NumberValue {
 	// https://golang.org/ref/spec#Integer_operators
 	if o == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt16) && (o == -1) {
+	} else if (v == math.MinInt64) && (o == -1) {
 		panic(OverflowError{})
 	}
 	return v / o
 }
 
-func (v Int16Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
@@ -3221,14 +4982,14 @@ func (v Int16Value) SaturatingDiv(other NumberValue) NumberValue {
 	// https://golang.org/ref/spec#Integer_operators
 	if o == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt16) && (o == -1) {
-		return Int16Value(math.MaxInt16)
+	} else if (v == math.MinInt64) && (o == -1) {
+		return Int64Value(math.MaxInt64)
 	}
 	return v / o
 }
 
-func (v Int16Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -3240,8 +5001,8 @@ func (v Int16Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v Int16Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -3253,8 +5014,8 @@ func (v Int16Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v Int16Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -3266,8 +5027,8 @@ func (v Int16Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v Int16Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -3279,54 +5040,49 @@ func (v Int16Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v Int16Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt16, ok := other.(Int16Value)
+func (v Int64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt64, ok := other.(Int64Value)
 	if !ok {
 		return false
 	}
-	return v == otherInt16
+	return v == otherInt64
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeInt16 (1 byte)
-// - int16 value encoded in big-endian (2 bytes)
-func (v Int16Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeInt16)
-	binary.BigEndian.PutUint16(scratch[1:], uint16(v))
-	return scratch[:3]
+// - HashInputTypeInt64 (1 byte)
+// - int64 value encoded in big-endian (8 bytes)
+func (v Int64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeInt64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
 }
 
-func ConvertInt16(value Value) Int16Value {
-	var res int16
+func ConvertInt64(value Value) Int64Value {
+	var res int64
 
 	switch value := value.(type) {
 	case BigNumberValue:
 		v := value.ToBigInt()
-		if v.Cmp(sema.Int16TypeMaxInt) > 0 {
+		if v.Cmp(sema.Int64TypeMaxInt) > 0 {
 			panic(OverflowError{})
-		} else if v.Cmp(sema.Int16TypeMinInt) < 0 {
+		} else if v.Cmp(sema.Int64TypeMinInt) < 0 {
 			panic(UnderflowError{})
 		}
-		res = int16(v.Int64())
+		res = v.Int64()
 
 	case NumberValue:
 		v := value.ToInt()
-		if v > math.MaxInt16 {
-			panic(OverflowError{})
-		} else if v < math.MinInt16 {
-			panic(UnderflowError{})
-		}
-		res = int16(v)
+		res = int64(v)
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return Int16Value(res)
+	return Int64Value(res)
 }
 
-func (v Int16Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -3338,8 +5094,8 @@ func (v Int16Value) BitwiseOr(other IntegerValue) IntegerValue {
 	return v | o
 }
 
-func (v Int16Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -3351,8 +5107,8 @@ func (v Int16Value) BitwiseXor(other IntegerValue) IntegerValue {
 	return v ^ o
 }
 
-func (v Int16Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -3364,8 +5120,8 @@ func (v Int16Value) BitwiseAnd(other IntegerValue) IntegerValue {
 	return v & o
 }
 
-func (v Int16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -3377,8 +5133,8 @@ func (v Int16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 	return v << o
 }
 
-func (v Int16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int16Value)
+func (v Int64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -3390,49 +5146,49 @@ func (v Int16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Int16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Int16Type)
+func (v Int64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Int64Type)
 }
 
-func (Int16Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Int64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Int16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Int64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Int16Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 2)
-	binary.BigEndian.PutUint16(b, uint16(v))
+func (v Int64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
 	return b
 }
 
-func (v Int16Value) ConformsToDynamicType(
+func (v Int64Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Int16Type.Equal(numberType.StaticType)
+	return ok && sema.Int64Type.Equal(numberType.StaticType)
 }
 
-func (v Int16Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Int64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Int16Value) NeedsStoreTo(_ atree.Address) bool {
+func (Int64Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Int16Value) IsResourceKinded(_ *Interpreter) bool {
+func (Int64Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Int16Value) Transfer(
+func (v Int64Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -3445,80 +5201,100 @@ func (v Int16Value) Transfer(
 	return v
 }
 
-func (v Int16Value) Clone(_ *Interpreter) Value {
+func (v Int64Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (Int16Value) DeepRemove(_ *Interpreter) {
+func (Int64Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Int16Value) ByteSize() uint32 {
+func (v Int64Value) ByteSize() uint32 {
 	return cborTagSize + getIntCBORSize(int64(v))
 }
 
-func (v Int16Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Int64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Int16Value) ChildStorables() []atree.Storable {
+func (Int64Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Int32Value
+// Int128Value
 
-type Int32Value int32
+type Int128Value struct {
+	BigInt *big.Int
+}
 
-var _ Value = Int32Value(0)
-var _ atree.Storable = Int32Value(0)
-var _ NumberValue = Int32Value(0)
-var _ IntegerValue = Int32Value(0)
-var _ EquatableValue = Int32Value(0)
-var _ HashableValue = Int32Value(0)
-var _ MemberAccessibleValue = Int32Value(0)
+func NewInt128ValueFromInt64(value int64) Int128Value {
+	return NewInt128ValueFromBigInt(big.NewInt(value))
+}
 
-func (Int32Value) IsValue() {}
+func NewInt128ValueFromBigInt(value *big.Int) Int128Value {
+	return Int128Value{BigInt: value}
+}
 
-func (v Int32Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitInt32Value(interpreter, v)
+var _ Value = Int128Value{}
+var _ atree.Storable = Int128Value{}
+var _ NumberValue = Int128Value{}
+var _ IntegerValue = Int128Value{}
+var _ EquatableValue = Int128Value{}
+var _ HashableValue = Int128Value{}
+var _ MemberAccessibleValue = Int128Value{}
+
+func (Int128Value) IsValue() {}
+
+func (v Int128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitInt128Value(interpreter, v)
 }
 
-func (Int32Value) Walk(_ func(Value)) {
+func (Int128Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var int32DynamicType DynamicType = NumberDynamicType{sema.Int32Type}
+var int128DynamicType DynamicType = NumberDynamicType{sema.Int128Type}
 
-func (Int32Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return int32DynamicType
+func (Int128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return int128DynamicType
 }
 
-func (Int32Value) StaticType() StaticType {
-	return PrimitiveStaticTypeInt32
+func (Int128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeInt128
 }
 
-func (v Int32Value) String() string {
-	return format.Int(int64(v))
+func (v Int128Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(v.BigInt.Int64())
 }
 
-func (v Int32Value) RecursiveString(_ SeenReferences) string {
-	return v.String()
+func (v Int128Value) ToBigInt() *big.Int {
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v Int32Value) ToInt() int {
-	return int(v)
+func (v Int128Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func (v Int32Value) Negate() NumberValue {
+func (v Int128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Int128Value) Negate() NumberValue {
 	// INT32-C
-	if v == math.MinInt32 {
+	//   if v == Int128TypeMinIntBig {
+	//       ...
+	//   }
+	if v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0 {
 		panic(OverflowError{})
 	}
-	return -v
+	return Int128Value{new(big.Int).Neg(v.BigInt)}
 }
 
-func (v Int32Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -3527,17 +5303,30 @@ func (v Int32Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v > (math.MaxInt32 - o)) {
-		panic(OverflowError{})
-	} else if (o < 0) && (v < (math.MinInt32 - o)) {
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native int128 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v > (Int128TypeMaxIntBig - o)) {
+	//       ...
+	//   } else if (o < 0) && (v < (Int128TypeMinIntBig - o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
 		panic(UnderflowError{})
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
 	}
-	return v + o
+	return Int128Value{res}
 }
 
-func (v Int32Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -3546,17 +5335,30 @@ func (v Int32Value) SaturatingPlus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v > (math.MaxInt32 - o)) {
-		return Int32Value(math.MaxInt32)
-	} else if (o < 0) && (v < (math.MinInt32 - o)) {
-		return Int32Value(math.MinInt32)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native int128 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v > (Int128TypeMaxIntBig - o)) {
+	//       ...
+	//   } else if (o < 0) && (v < (Int128TypeMinIntBig - o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return Int128Value{sema.Int128TypeMinIntBig}
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return Int128Value{sema.Int128TypeMaxIntBig}
 	}
-	return v + o
+	return Int128Value{res}
 }
 
-func (v Int32Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -3565,17 +5367,30 @@ func (v Int32Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt32 + o)) {
-		panic(OverflowError{})
-	} else if (o < 0) && (v > (math.MaxInt32 + o)) {
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native int128 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v < (Int128TypeMinIntBig + o)) {
+	// 	     ...
+	//   } else if (o < 0) && (v > (Int128TypeMaxIntBig + o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Sub(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
 		panic(UnderflowError{})
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
 	}
-	return v - o
+	return Int128Value{res}
 }
 
-func (v Int32Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -3584,17 +5399,30 @@ func (v Int32Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt32 + o)) {
-		return Int32Value(math.MinInt32)
-	} else if (o < 0) && (v > (math.MaxInt32 + o)) {
-		return Int32Value(math.MaxInt32)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native int128 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v < (Int128TypeMinIntBig + o)) {
+	// 	     ...
+	//   } else if (o < 0) && (v > (Int128TypeMaxIntBig + o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Sub(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return Int128Value{sema.Int128TypeMinIntBig}
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return Int128Value{sema.Int128TypeMaxIntBig}
 	}
-	return v - o
+	return Int128Value{res}
 }
 
-func (v Int32Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -3603,15 +5431,17 @@ func (v Int32Value) Mod(other NumberValue) NumberValue {
 		})
 	}
 
+	res := new(big.Int)
 	// INT33-C
-	if o == 0 {
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
 	}
-	return v % o
+	res.Rem(v.BigInt, o.BigInt)
+	return Int128Value{res}
 }
 
-func (v Int32Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -3620,37 +5450,18 @@ func (v Int32Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if v > 0 {
-		if o > 0 {
-			// positive * positive = positive. overflow?
-			if v > (math.MaxInt32 / o) {
-				panic(OverflowError{})
-			}
-		} else {
-			// positive * negative = negative. underflow?
-			if o < (math.MinInt32 / v) {
-				panic(UnderflowError{})
-			}
-		}
-	} else {
-		if o > 0 {
-			// negative * positive = negative. underflow?
-			if v < (math.MinInt32 / o) {
-				panic(UnderflowError{})
-			}
-		} else {
-			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt32 / v)) {
-				panic(OverflowError{})
-			}
-		}
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
 	}
-	return v * o
+	return Int128Value{res}
 }
 
-func (v Int32Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -3659,37 +5470,18 @@ func (v Int32Value) SaturatingMul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if v > 0 {
-		if o > 0 {
-			// positive * positive = positive. overflow?
-			if v > (math.MaxInt32 / o) {
-				return Int32Value(math.MaxInt32)
-			}
-		} else {
-			// positive * negative = negative. underflow?
-			if o < (math.MinInt32 / v) {
-				return Int32Value(math.MinInt32)
-			}
-		}
-	} else {
-		if o > 0 {
-			// negative * positive = negative. underflow?
-			if v < (math.MinInt32 / o) {
-				return Int32Value(math.MinInt32)
-			}
-		} else {
-			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt32 / v)) {
-				return Int32Value(math.MaxInt32)
-			}
-		}
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		return Int128Value{sema.Int128TypeMinIntBig}
+	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		return Int128Value{sema.Int128TypeMaxIntBig}
 	}
-	return v * o
+	return Int128Value{res}
 }
 
-func (v Int32Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -3698,18 +5490,26 @@ func (v Int32Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT33-C
-	// https://golang.org/ref/spec#Integer_operators
-	if o == 0 {
+	res := new(big.Int)
+	// INT33-C:
+	//   if o == 0 {
+	//       ...
+	//   } else if (v == Int128TypeMinIntBig) && (o == -1) {
+	//       ...
+	//   }
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt32) && (o == -1) {
+	}
+	res.SetInt64(-1)
+	if (v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
 		panic(OverflowError{})
 	}
-	return v / o
+	res.Div(v.BigInt, o.BigInt)
+	return Int128Value{res}
 }
 
-func (v Int32Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
@@ -3718,18 +5518,26 @@ func (v Int32Value) SaturatingDiv(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT33-C
-	// https://golang.org/ref/spec#Integer_operators
-	if o == 0 {
+	res := new(big.Int)
+	// INT33-C:
+	//   if o == 0 {
+	//       ...
+	//   } else if (v == Int128TypeMinIntBig) && (o == -1) {
+	//       ...
+	//   }
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt32) && (o == -1) {
-		return Int32Value(math.MaxInt32)
 	}
-	return v / o
+	res.SetInt64(-1)
+	if (v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
+		return Int128Value{sema.Int128TypeMaxIntBig}
+	}
+	res.Div(v.BigInt, o.BigInt)
+	return Int128Value{res}
 }
 
-func (v Int32Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -3738,11 +5546,12 @@ func (v Int32Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	return v < o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == -1
 }
 
-func (v Int32Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -3751,11 +5560,12 @@ func (v Int32Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v <= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp <= 0
 }
 
-func (v Int32Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -3764,11 +5574,12 @@ func (v Int32Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	return v > o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == 1
 }
 
-func (v Int32Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -3777,57 +5588,63 @@ func (v Int32Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v >= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp >= 0
 }
 
-func (v Int32Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt32, ok := other.(Int32Value)
+func (v Int128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt, ok := other.(Int128Value)
 	if !ok {
 		return false
 	}
-	return v == otherInt32
-}
+	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeInt128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Int128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
 
-// HashInput returns a byte slice containing:
-// - HashInputTypeInt32 (1 byte)
-// - int32 value encoded in big-endian (4 bytes)
-func (v Int32Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeInt32)
-	binary.BigEndian.PutUint32(scratch[1:], uint32(v))
-	return scratch[:5]
+	buffer[0] = byte(HashInputTypeInt128)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func ConvertInt32(value Value) Int32Value {
-	var res int32
+func ConvertInt128(value Value) Int128Value {
+	var v *big.Int
 
 	switch value := value.(type) {
 	case BigNumberValue:
-		v := value.ToBigInt()
-		if v.Cmp(sema.Int32TypeMaxInt) > 0 {
-			panic(OverflowError{})
-		} else if v.Cmp(sema.Int32TypeMinInt) < 0 {
-			panic(UnderflowError{})
-		}
-		res = int32(v.Int64())
+		v = value.ToBigInt()
 
 	case NumberValue:
-		v := value.ToInt()
-		if v > math.MaxInt32 {
-			panic(OverflowError{})
-		} else if v < math.MinInt32 {
-			panic(UnderflowError{})
-		}
-		res = int32(v)
+		v = big.NewInt(int64(value.ToInt()))
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return Int32Value(res)
+	if v.Cmp(sema.Int128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	} else if v.Cmp(sema.Int128TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	}
+
+	return NewInt128ValueFromBigInt(v)
 }
 
-func (v Int32Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -3836,11 +5653,13 @@ func (v Int32Value) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v | o
+	res := new(big.Int)
+	res.Or(v.BigInt, o.BigInt)
+	return Int128Value{res}
 }
 
-func (v Int32Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -3849,11 +5668,13 @@ func (v Int32Value) BitwiseXor(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v ^ o
+	res := new(big.Int)
+	res.Xor(v.BigInt, o.BigInt)
+	return Int128Value{res}
 }
 
-func (v Int32Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -3862,11 +5683,13 @@ func (v Int32Value) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v & o
+	res := new(big.Int)
+	res.And(v.BigInt, o.BigInt)
+	return Int128Value{res}
 }
 
-func (v Int32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -3875,11 +5698,19 @@ func (v Int32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v << o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return Int128Value{res}
 }
 
-func (v Int32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int32Value)
+func (v Int128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -3888,52 +5719,58 @@ func (v Int32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v >> o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return Int128Value{res}
 }
 
-func (v Int32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Int32Type)
+func (v Int128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Int128Type)
 }
 
-func (Int32Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Int128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Int32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Int128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Int32Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, uint32(v))
-	return b
+func (v Int128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v Int32Value) ConformsToDynamicType(
+func (v Int128Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Int32Type.Equal(numberType.StaticType)
+	return ok && sema.Int128Type.Equal(numberType.StaticType)
 }
 
-func (v Int32Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Int128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Int32Value) NeedsStoreTo(_ atree.Address) bool {
+func (Int128Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Int32Value) IsResourceKinded(_ *Interpreter) bool {
+func (Int128Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Int32Value) Transfer(
+func (v Int128Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -3946,90 +5783,100 @@ func (v Int32Value) Transfer(
 	return v
 }
 
-func (v Int32Value) Clone(_ *Interpreter) Value {
-	return v
+func (v Int128Value) Clone(_ *Interpreter) Value {
+	return NewInt128ValueFromBigInt(v.BigInt)
 }
 
-func (Int32Value) DeepRemove(_ *Interpreter) {
+func (Int128Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Int32Value) ByteSize() uint32 {
-	return cborTagSize + getIntCBORSize(int64(v))
+func (v Int128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v Int32Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Int128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Int32Value) ChildStorables() []atree.Storable {
+func (Int128Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Int64Value
+// Int256Value
 
-type Int64Value int64
+type Int256Value struct {
+	BigInt *big.Int
+}
 
-var _ Value = Int64Value(0)
-var _ atree.Storable = Int64Value(0)
-var _ NumberValue = Int64Value(0)
-var _ IntegerValue = Int64Value(0)
-var _ EquatableValue = Int64Value(0)
-var _ HashableValue = Int64Value(0)
-var _ MemberAccessibleValue = Int64Value(0)
+func NewInt256ValueFromInt64(value int64) Int256Value {
+	return NewInt256ValueFromBigInt(big.NewInt(value))
+}
 
-func (Int64Value) IsValue() {}
+func NewInt256ValueFromBigInt(value *big.Int) Int256Value {
+	return Int256Value{BigInt: value}
+}
 
-func (v Int64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitInt64Value(interpreter, v)
+var _ Value = Int256Value{}
+var _ atree.Storable = Int256Value{}
+var _ NumberValue = Int256Value{}
+var _ IntegerValue = Int256Value{}
+var _ EquatableValue = Int256Value{}
+var _ HashableValue = Int256Value{}
+var _ MemberAccessibleValue = Int256Value{}
+
+func (Int256Value) IsValue() {}
+
+func (v Int256Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitInt256Value(interpreter, v)
 }
 
-func (Int64Value) Walk(_ func(Value)) {
+func (Int256Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var int64DynamicType DynamicType = NumberDynamicType{sema.Int64Type}
+var int256DynamicType DynamicType = NumberDynamicType{sema.Int256Type}
 
-func (Int64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return int64DynamicType
+func (Int256Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return int256DynamicType
 }
 
-func (Int64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeInt64
+func (Int256Value) StaticType() StaticType {
+	return PrimitiveStaticTypeInt256
 }
 
-func (v Int64Value) String() string {
-	return format.Int(int64(v))
+func (v Int256Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(v.BigInt.Int64())
 }
 
-func (v Int64Value) RecursiveString(_ SeenReferences) string {
-	return v.String()
+func (v Int256Value) ToBigInt() *big.Int {
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v Int64Value) ToInt() int {
-	return int(v)
+func (v Int256Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func (v Int64Value) Negate() NumberValue {
-	// INT32-C
-	if v == math.MinInt64 {
-		panic(OverflowError{})
-	}
-	return -v
+func (v Int256Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
 }
 
-func safeAddInt64(a, b int64) int64 {
+func (v Int256Value) Negate() NumberValue {
 	// INT32-C
-	if (b > 0) && (a > (math.MaxInt64 - b)) {
+	//   if v == Int256TypeMinIntBig {
+	//       ...
+	//   }
+	if v.BigInt.Cmp(sema.Int256TypeMinIntBig) == 0 {
 		panic(OverflowError{})
-	} else if (b < 0) && (a < (math.MinInt64 - b)) {
-		panic(UnderflowError{})
 	}
-	return a + b
+	return Int256Value{BigInt: new(big.Int).Neg(v.BigInt)}
 }
 
-func (v Int64Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -4038,11 +5885,30 @@ func (v Int64Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return Int64Value(safeAddInt64(int64(v), int64(o)))
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native int256 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v > (Int256TypeMaxIntBig - o)) {
+	//       ...
+	//   } else if (o < 0) && (v < (Int256TypeMinIntBig - o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+	return Int256Value{res}
 }
 
-func (v Int64Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -4051,17 +5917,30 @@ func (v Int64Value) SaturatingPlus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v > (math.MaxInt64 - o)) {
-		return Int64Value(math.MaxInt64)
-	} else if (o < 0) && (v < (math.MinInt64 - o)) {
-		return Int64Value(math.MinInt64)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native int256 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v > (Int256TypeMaxIntBig - o)) {
+	//       ...
+	//   } else if (o < 0) && (v < (Int256TypeMinIntBig - o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		return Int256Value{sema.Int256TypeMinIntBig}
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		return Int256Value{sema.Int256TypeMaxIntBig}
 	}
-	return v + o
+	return Int256Value{res}
 }
 
-func (v Int64Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -4070,17 +5949,30 @@ func (v Int64Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt64 + o)) {
-		panic(OverflowError{})
-	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native int256 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v < (Int256TypeMinIntBig + o)) {
+	// 	     ...
+	//   } else if (o < 0) && (v > (Int256TypeMaxIntBig + o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Sub(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
 		panic(UnderflowError{})
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
 	}
-	return v - o
+	return Int256Value{res}
 }
 
-func (v Int64Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -4089,17 +5981,30 @@ func (v Int64Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt64 + o)) {
-		return Int64Value(math.MinInt64)
-	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
-		return Int64Value(math.MaxInt64)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native int256 type and we switch this value
+	// to be based on it, then we need to follow INT32-C:
+	//
+	//   if (o > 0) && (v < (Int256TypeMinIntBig + o)) {
+	// 	     ...
+	//   } else if (o < 0) && (v > (Int256TypeMaxIntBig + o)) {
+	//       ...
+	//   }
+	//
+	res := new(big.Int)
+	res.Sub(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		return Int256Value{sema.Int256TypeMinIntBig}
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		return Int256Value{sema.Int256TypeMaxIntBig}
 	}
-	return v - o
+	return Int256Value{res}
 }
 
-func (v Int64Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -4108,15 +6013,17 @@ func (v Int64Value) Mod(other NumberValue) NumberValue {
 		})
 	}
 
+	res := new(big.Int)
 	// INT33-C
-	if o == 0 {
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
 	}
-	return v % o
+	res.Rem(v.BigInt, o.BigInt)
+	return Int256Value{res}
 }
 
-func (v Int64Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -4125,37 +6032,18 @@ func (v Int64Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if v > 0 {
-		if o > 0 {
-			// positive * positive = positive. overflow?
-			if v > (math.MaxInt64 / o) {
-				panic(OverflowError{})
-			}
-		} else {
-			// positive * negative = negative. underflow?
-			if o < (math.MinInt64 / v) {
-				panic(UnderflowError{})
-			}
-		}
-	} else {
-		if o > 0 {
-			// negative * positive = negative. underflow?
-			if v < (math.MinInt64 / o) {
-				panic(UnderflowError{})
-			}
-		} else {
-			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt64 / v)) {
-				panic(OverflowError{})
-			}
-		}
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
 	}
-	return v * o
+	return Int256Value{res}
 }
 
-func (v Int64Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -4164,37 +6052,18 @@ func (v Int64Value) SaturatingMul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if v > 0 {
-		if o > 0 {
-			// positive * positive = positive. overflow?
-			if v > (math.MaxInt64 / o) {
-				return Int64Value(math.MaxInt64)
-			}
-		} else {
-			// positive * negative = negative. underflow?
-			if o < (math.MinInt64 / v) {
-				return Int64Value(math.MinInt64)
-			}
-		}
-	} else {
-		if o > 0 {
-			// negative * positive = negative. underflow?
-			if v < (math.MinInt64 / o) {
-				return Int64Value(math.MinInt64)
-			}
-		} else {
-			// negative * negative = positive. overflow?
-			if (v != 0) && (o < (math.MaxInt64 / v)) {
-				return Int64Value(math.MaxInt64)
-			}
-		}
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		return Int256Value{sema.Int256TypeMinIntBig}
+	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		return Int256Value{sema.Int256TypeMaxIntBig}
 	}
-	return v * o
+	return Int256Value{res}
 }
 
-func (v Int64Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -4203,18 +6072,26 @@ func (v Int64Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT33-C
-	// https://golang.org/ref/spec#Integer_operators
-	if o == 0 {
+	res := new(big.Int)
+	// INT33-C:
+	//   if o == 0 {
+	//       ...
+	//   } else if (v == Int256TypeMinIntBig) && (o == -1) {
+	//       ...
+	//   }
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt64) && (o == -1) {
+	}
+	res.SetInt64(-1)
+	if (v.BigInt.Cmp(sema.Int256TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
 		panic(OverflowError{})
 	}
-	return v / o
+	res.Div(v.BigInt, o.BigInt)
+	return Int256Value{res}
 }
 
-func (v Int64Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
@@ -4223,18 +6100,26 @@ func (v Int64Value) SaturatingDiv(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT33-C
-	// https://golang.org/ref/spec#Integer_operators
-	if o == 0 {
+	res := new(big.Int)
+	// INT33-C:
+	//   if o == 0 {
+	//       ...
+	//   } else if (v == Int256TypeMinIntBig) && (o == -1) {
+	//       ...
+	//   }
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
-	} else if (v == math.MinInt64) && (o == -1) {
-		return Int64Value(math.MaxInt64)
 	}
-	return v / o
+	res.SetInt64(-1)
+	if (v.BigInt.Cmp(sema.Int256TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
+		return Int256Value{sema.Int256TypeMaxIntBig}
+	}
+	res.Div(v.BigInt, o.BigInt)
+	return Int256Value{res}
 }
 
-func (v Int64Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -4243,11 +6128,12 @@ func (v Int64Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	return v < o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == -1
 }
 
-func (v Int64Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -4256,11 +6142,12 @@ func (v Int64Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v <= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp <= 0
 }
 
-func (v Int64Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -4269,11 +6156,12 @@ func (v Int64Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	return v > o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == 1
 }
 
-func (v Int64Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -4282,52 +6170,63 @@ func (v Int64Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v >= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp >= 0
 }
 
-func (v Int64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt64, ok := other.(Int64Value)
+func (v Int256Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt, ok := other.(Int256Value)
 	if !ok {
 		return false
 	}
-	return v == otherInt64
+	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	return cmp == 0
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeInt64 (1 byte)
-// - int64 value encoded in big-endian (8 bytes)
-func (v Int64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeInt64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
+// - HashInputTypeInt256 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Int256Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeInt256)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func ConvertInt64(value Value) Int64Value {
-	var res int64
+func ConvertInt256(value Value) Int256Value {
+	var v *big.Int
 
 	switch value := value.(type) {
 	case BigNumberValue:
-		v := value.ToBigInt()
-		if v.Cmp(sema.Int64TypeMaxInt) > 0 {
-			panic(OverflowError{})
-		} else if v.Cmp(sema.Int64TypeMinInt) < 0 {
-			panic(UnderflowError{})
-		}
-		res = v.Int64()
+		v = value.ToBigInt()
 
 	case NumberValue:
-		v := value.ToInt()
-		res = int64(v)
+		v = big.NewInt(int64(value.ToInt()))
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return Int64Value(res)
+	if v.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	} else if v.Cmp(sema.Int256TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	}
+
+	return NewInt256ValueFromBigInt(v)
 }
 
-func (v Int64Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -4336,11 +6235,13 @@ func (v Int64Value) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v | o
+	res := new(big.Int)
+	res.Or(v.BigInt, o.BigInt)
+	return Int256Value{res}
 }
 
-func (v Int64Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -4349,11 +6250,13 @@ func (v Int64Value) BitwiseXor(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v ^ o
+	res := new(big.Int)
+	res.Xor(v.BigInt, o.BigInt)
+	return Int256Value{res}
 }
 
-func (v Int64Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -4362,11 +6265,13 @@ func (v Int64Value) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v & o
+	res := new(big.Int)
+	res.And(v.BigInt, o.BigInt)
+	return Int256Value{res}
 }
 
-func (v Int64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -4375,11 +6280,19 @@ func (v Int64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v << o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return Int256Value{res}
 }
 
-func (v Int64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int64Value)
+func (v Int256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Int256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -4388,52 +6301,58 @@ func (v Int64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v >> o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return Int256Value{res}
 }
 
-func (v Int64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Int64Type)
+func (v Int256Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Int256Type)
 }
 
-func (Int64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Int256Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Int64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Int256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Int64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v Int256Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v Int64Value) ConformsToDynamicType(
+func (v Int256Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Int64Type.Equal(numberType.StaticType)
+	return ok && sema.Int256Type.Equal(numberType.StaticType)
 }
 
-func (v Int64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Int256Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Int64Value) NeedsStoreTo(_ atree.Address) bool {
+func (Int256Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Int64Value) IsResourceKinded(_ *Interpreter) bool {
+func (Int256Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Int64Value) Transfer(
+func (v Int256Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -4446,164 +6365,144 @@ func (v Int64Value) Transfer(
 	return v
 }
 
-func (v Int64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v Int256Value) Clone(_ *Interpreter) Value {
+	return NewInt256ValueFromBigInt(v.BigInt)
 }
 
-func (Int64Value) DeepRemove(_ *Interpreter) {
+func (Int256Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Int64Value) ByteSize() uint32 {
-	return cborTagSize + getIntCBORSize(int64(v))
+func (v Int256Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v Int64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Int256Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Int64Value) ChildStorables() []atree.Storable {
+func (Int256Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Int128Value
+// UIntValue
 
-type Int128Value struct {
+type UIntValue struct {
 	BigInt *big.Int
 }
 
-func NewInt128ValueFromInt64(value int64) Int128Value {
-	return NewInt128ValueFromBigInt(big.NewInt(value))
+func NewUIntValueFromUint64(value uint64) UIntValue {
+	return NewUIntValueFromBigInt(new(big.Int).SetUint64(value))
 }
 
-func NewInt128ValueFromBigInt(value *big.Int) Int128Value {
-	return Int128Value{BigInt: value}
+func NewUIntValueFromBigInt(value *big.Int) UIntValue {
+	return UIntValue{BigInt: value}
 }
 
-var _ Value = Int128Value{}
-var _ atree.Storable = Int128Value{}
-var _ NumberValue = Int128Value{}
-var _ IntegerValue = Int128Value{}
-var _ EquatableValue = Int128Value{}
-var _ HashableValue = Int128Value{}
-var _ MemberAccessibleValue = Int128Value{}
+func ConvertUInt(value Value) UIntValue {
+	switch value := value.(type) {
+	case BigNumberValue:
+		v := value.ToBigInt()
+		if v.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUIntValueFromBigInt(value.ToBigInt())
 
-func (Int128Value) IsValue() {}
+	case NumberValue:
+		v := value.ToInt()
+		if v < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUIntValueFromUint64(uint64(v))
 
-func (v Int128Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitInt128Value(interpreter, v)
+	default:
+		panic(errors.NewUnreachableError())
+	}
 }
 
-func (Int128Value) Walk(_ func(Value)) {
+var _ Value = UIntValue{}
+var _ atree.Storable = UIntValue{}
+var _ NumberValue = UIntValue{}
+var _ IntegerValue = UIntValue{}
+var _ EquatableValue = UIntValue{}
+var _ HashableValue = UIntValue{}
+var _ MemberAccessibleValue = UIntValue{}
+
+func (UIntValue) IsValue() {}
+
+func (v UIntValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUIntValue(interpreter, v)
+}
+
+func (UIntValue) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var int128DynamicType DynamicType = NumberDynamicType{sema.Int128Type}
+var uintDynamicType DynamicType = NumberDynamicType{sema.UIntType}
 
-func (Int128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return int128DynamicType
+func (UIntValue) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return uintDynamicType
 }
 
-func (Int128Value) StaticType() StaticType {
-	return PrimitiveStaticTypeInt128
+func (UIntValue) StaticType() StaticType {
+	return PrimitiveStaticTypeUInt
 }
 
-func (v Int128Value) ToInt() int {
-	if !v.BigInt.IsInt64() {
+func (v UIntValue) ToInt() int {
+	if v.BigInt.IsInt64() {
 		panic(OverflowError{})
 	}
 	return int(v.BigInt.Int64())
 }
 
-func (v Int128Value) ToBigInt() *big.Int {
+func (v UIntValue) ToBigInt() *big.Int {
 	return new(big.Int).Set(v.BigInt)
 }
 
-func (v Int128Value) String() string {
+func (v UIntValue) String() string {
 	return format.BigInt(v.BigInt)
 }
 
-func (v Int128Value) RecursiveString(_ SeenReferences) string {
+func (v UIntValue) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Int128Value) Negate() NumberValue {
-	// INT32-C
-	//   if v == Int128TypeMinIntBig {
-	//       ...
-	//   }
-	if v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0 {
-		panic(OverflowError{})
-	}
-	return Int128Value{new(big.Int).Neg(v.BigInt)}
-}
-
-func (v Int128Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationPlus,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
-
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native int128 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v > (Int128TypeMaxIntBig - o)) {
-	//       ...
-	//   } else if (o < 0) && (v < (Int128TypeMinIntBig - o)) {
-	//       ...
-	//   }
-	//
-	res := new(big.Int)
-	res.Add(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
-	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	}
-	return Int128Value{res}
+func (v UIntValue) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v Int128Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
-
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native int128 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v > (Int128TypeMaxIntBig - o)) {
-	//       ...
-	//   } else if (o < 0) && (v < (Int128TypeMinIntBig - o)) {
-	//       ...
-	//   }
-	//
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
 	res := new(big.Int)
 	res.Add(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
-		return Int128Value{sema.Int128TypeMinIntBig}
-	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
-		return Int128Value{sema.Int128TypeMaxIntBig}
-	}
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) SaturatingPlus(other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+				LeftType:     v.StaticType(),
+				RightType:    other.StaticType(),
+			})
+		}
+	}()
+
+	return v.Plus(other)
+}
+
+func (v UIntValue) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -4612,30 +6511,17 @@ func (v Int128Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native int128 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v < (Int128TypeMinIntBig + o)) {
-	// 	     ...
-	//   } else if (o < 0) && (v > (Int128TypeMaxIntBig + o)) {
-	//       ...
-	//   }
-	//
 	res := new(big.Int)
 	res.Sub(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
+	// INT30-C
+	if res.Sign() < 0 {
 		panic(UnderflowError{})
-	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
 	}
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -4644,30 +6530,17 @@ func (v Int128Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native int128 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v < (Int128TypeMinIntBig + o)) {
-	// 	     ...
-	//   } else if (o < 0) && (v > (Int128TypeMaxIntBig + o)) {
-	//       ...
-	//   }
-	//
 	res := new(big.Int)
 	res.Sub(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
-		return Int128Value{sema.Int128TypeMinIntBig}
-	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
-		return Int128Value{sema.Int128TypeMaxIntBig}
+	// INT30-C
+	if res.Sign() < 0 {
+		return UIntValue{sema.UIntTypeMin}
 	}
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -4682,11 +6555,11 @@ func (v Int128Value) Mod(other NumberValue) NumberValue {
 		panic(DivisionByZeroError{})
 	}
 	res.Rem(v.BigInt, o.BigInt)
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -4697,92 +6570,60 @@ func (v Int128Value) Mul(other NumberValue) NumberValue {
 
 	res := new(big.Int)
 	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
-	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	}
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
+func (v UIntValue) SaturatingMul(other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+				LeftType:     v.StaticType(),
+				RightType:    other.StaticType(),
+			})
+		}
+	}()
 
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int128TypeMinIntBig) < 0 {
-		return Int128Value{sema.Int128TypeMinIntBig}
-	} else if res.Cmp(sema.Int128TypeMaxIntBig) > 0 {
-		return Int128Value{sema.Int128TypeMaxIntBig}
-	}
-	return Int128Value{res}
+	return v.Mul(other)
 }
 
-func (v Int128Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) Div(other NumberValue) NumberValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationDiv,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
 		})
 	}
 
 	res := new(big.Int)
-	// INT33-C:
-	//   if o == 0 {
-	//       ...
-	//   } else if (v == Int128TypeMinIntBig) && (o == -1) {
-	//       ...
-	//   }
+	// INT33-C
 	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.SetInt64(-1)
-	if (v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
-		panic(OverflowError{})
-	}
 	res.Div(v.BigInt, o.BigInt)
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Int128Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
+func (v UIntValue) SaturatingDiv(other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(),
+				RightType:    other.StaticType(),
+			})
+		}
+	}()
 
-	res := new(big.Int)
-	// INT33-C:
-	//   if o == 0 {
-	//       ...
-	//   } else if (v == Int128TypeMinIntBig) && (o == -1) {
-	//       ...
-	//   }
-	if o.BigInt.Cmp(res) == 0 {
-		panic(DivisionByZeroError{})
-	}
-	res.SetInt64(-1)
-	if (v.BigInt.Cmp(sema.Int128TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
-		return Int128Value{sema.Int128TypeMaxIntBig}
-	}
-	res.Div(v.BigInt, o.BigInt)
-	return Int128Value{res}
+	return v.Div(other)
 }
 
-func (v Int128Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) Less(other NumberValue) BoolValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -4795,8 +6636,8 @@ func (v Int128Value) Less(other NumberValue) BoolValue {
 	return cmp == -1
 }
 
-func (v Int128Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -4809,8 +6650,8 @@ func (v Int128Value) LessEqual(other NumberValue) BoolValue {
 	return cmp <= 0
 }
 
-func (v Int128Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -4823,8 +6664,8 @@ func (v Int128Value) Greater(other NumberValue) BoolValue {
 	return cmp == 1
 }
 
-func (v Int128Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -4837,20 +6678,20 @@ func (v Int128Value) GreaterEqual(other NumberValue) BoolValue {
 	return cmp >= 0
 }
 
-func (v Int128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt, ok := other.(Int128Value)
+func (v UIntValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUInt, ok := other.(UIntValue)
 	if !ok {
 		return false
 	}
-	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	cmp := v.BigInt.Cmp(otherUInt.BigInt)
 	return cmp == 0
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeInt128 (1 byte)
+// - HashInputTypeUInt (1 byte)
 // - big int value encoded in big-endian (n bytes)
-func (v Int128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	b := SignedBigIntToBigEndianBytes(v.BigInt)
+func (v UIntValue) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
 
 	length := 1 + len(b)
 	var buffer []byte
@@ -4860,36 +6701,13 @@ func (v Int128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch [
 		buffer = make([]byte, length)
 	}
 
-	buffer[0] = byte(HashInputTypeInt128)
+	buffer[0] = byte(HashInputTypeUInt)
 	copy(buffer[1:], b)
 	return buffer
 }
 
-func ConvertInt128(value Value) Int128Value {
-	var v *big.Int
-
-	switch value := value.(type) {
-	case BigNumberValue:
-		v = value.ToBigInt()
-
-	case NumberValue:
-		v = big.NewInt(int64(value.ToInt()))
-
-	default:
-		panic(errors.NewUnreachableError())
-	}
-
-	if v.Cmp(sema.Int128TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	} else if v.Cmp(sema.Int128TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
-	}
-
-	return NewInt128ValueFromBigInt(v)
-}
-
-func (v Int128Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -4900,11 +6718,11 @@ func (v Int128Value) BitwiseOr(other IntegerValue) IntegerValue {
 
 	res := new(big.Int)
 	res.Or(v.BigInt, o.BigInt)
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -4915,11 +6733,11 @@ func (v Int128Value) BitwiseXor(other IntegerValue) IntegerValue {
 
 	res := new(big.Int)
 	res.Xor(v.BigInt, o.BigInt)
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -4930,11 +6748,11 @@ func (v Int128Value) BitwiseAnd(other IntegerValue) IntegerValue {
 
 	res := new(big.Int)
 	res.And(v.BigInt, o.BigInt)
-	return Int128Value{res}
+	return UIntValue{res}
 }
-
-func (v Int128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int128Value)
+
+func (v UIntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -4951,11 +6769,11 @@ func (v Int128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		panic(OverflowError{})
 	}
 	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int128Value)
+func (v UIntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UIntValue)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -4972,50 +6790,50 @@ func (v Int128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		panic(OverflowError{})
 	}
 	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return Int128Value{res}
+	return UIntValue{res}
 }
 
-func (v Int128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Int128Type)
+func (v UIntValue) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UIntType)
 }
 
-func (Int128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UIntValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Int128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UIntValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Int128Value) ToBigEndianBytes() []byte {
-	return SignedBigIntToBigEndianBytes(v.BigInt)
+func (v UIntValue) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v Int128Value) ConformsToDynamicType(
+func (v UIntValue) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Int128Type.Equal(numberType.StaticType)
+	return ok && sema.UIntType.Equal(numberType.StaticType)
 }
 
-func (v Int128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
-	return v, nil
+func (v UIntValue) Storable(storage atree.SlabStorage, address atree.Address, maxInlineSize uint64) (atree.Storable, error) {
+	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
 }
 
-func (Int128Value) NeedsStoreTo(_ atree.Address) bool {
+func (UIntValue) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Int128Value) IsResourceKinded(_ *Interpreter) bool {
+func (UIntValue) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Int128Value) Transfer(
+func (v UIntValue) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -5028,100 +6846,76 @@ func (v Int128Value) Transfer(
 	return v
 }
 
-func (v Int128Value) Clone(_ *Interpreter) Value {
-	return NewInt128ValueFromBigInt(v.BigInt)
+func (v UIntValue) Clone(_ *Interpreter) Value {
+	return NewUIntValueFromBigInt(v.BigInt)
 }
 
-func (Int128Value) DeepRemove(_ *Interpreter) {
+func (UIntValue) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Int128Value) ByteSize() uint32 {
+func (v UIntValue) ByteSize() uint32 {
 	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v Int128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UIntValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Int128Value) ChildStorables() []atree.Storable {
+func (UIntValue) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Int256Value
-
-type Int256Value struct {
-	BigInt *big.Int
-}
-
-func NewInt256ValueFromInt64(value int64) Int256Value {
-	return NewInt256ValueFromBigInt(big.NewInt(value))
-}
+// UInt8Value
 
-func NewInt256ValueFromBigInt(value *big.Int) Int256Value {
-	return Int256Value{BigInt: value}
-}
+type UInt8Value uint8
 
-var _ Value = Int256Value{}
-var _ atree.Storable = Int256Value{}
-var _ NumberValue = Int256Value{}
-var _ IntegerValue = Int256Value{}
-var _ EquatableValue = Int256Value{}
-var _ HashableValue = Int256Value{}
-var _ MemberAccessibleValue = Int256Value{}
+var _ Value = UInt8Value(0)
+var _ atree.Storable = UInt8Value(0)
+var _ NumberValue = UInt8Value(0)
+var _ IntegerValue = UInt8Value(0)
+var _ EquatableValue = UInt8Value(0)
+var _ HashableValue = UInt8Value(0)
+var _ MemberAccessibleValue = UInt8Value(0)
 
-func (Int256Value) IsValue() {}
+func (UInt8Value) IsValue() {}
 
-func (v Int256Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitInt256Value(interpreter, v)
+func (v UInt8Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUInt8Value(interpreter, v)
 }
 
-func (Int256Value) Walk(_ func(Value)) {
+func (UInt8Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var int256DynamicType DynamicType = NumberDynamicType{sema.Int256Type}
-
-func (Int256Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return int256DynamicType
-}
+var uint8DynamicType DynamicType = NumberDynamicType{sema.UInt8Type}
 
-func (Int256Value) StaticType() StaticType {
-	return PrimitiveStaticTypeInt256
+func (UInt8Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return uint8DynamicType
 }
 
-func (v Int256Value) ToInt() int {
-	if !v.BigInt.IsInt64() {
-		panic(OverflowError{})
-	}
-	return int(v.BigInt.Int64())
+func (UInt8Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUInt8
 }
 
-func (v Int256Value) ToBigInt() *big.Int {
-	return new(big.Int).Set(v.BigInt)
+func (v UInt8Value) String() string {
+	return format.Uint(uint64(v))
 }
 
-func (v Int256Value) String() string {
-	return format.BigInt(v.BigInt)
+func (v UInt8Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
 }
 
-func (v Int256Value) RecursiveString(_ SeenReferences) string {
-	return v.String()
+func (v UInt8Value) ToInt() int {
+	return int(v)
 }
 
-func (v Int256Value) Negate() NumberValue {
-	// INT32-C
-	//   if v == Int256TypeMinIntBig {
-	//       ...
-	//   }
-	if v.BigInt.Cmp(sema.Int256TypeMinIntBig) == 0 {
-		panic(OverflowError{})
-	}
-	return Int256Value{BigInt: new(big.Int).Neg(v.BigInt)}
+func (v UInt8Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v Int256Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -5130,30 +6924,16 @@ func (v Int256Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native int256 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v > (Int256TypeMaxIntBig - o)) {
-	//       ...
-	//   } else if (o < 0) && (v < (Int256TypeMinIntBig - o)) {
-	//       ...
-	//   }
-	//
-	res := new(big.Int)
-	res.Add(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
-	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+	sum := v + o
+	// INT30-C
+	if sum < v {
 		panic(OverflowError{})
 	}
-	return Int256Value{res}
+	return sum
 }
 
-func (v Int256Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -5162,30 +6942,16 @@ func (v Int256Value) SaturatingPlus(other NumberValue) NumberValue {
 		})
 	}
 
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native int256 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v > (Int256TypeMaxIntBig - o)) {
-	//       ...
-	//   } else if (o < 0) && (v < (Int256TypeMinIntBig - o)) {
-	//       ...
-	//   }
-	//
-	res := new(big.Int)
-	res.Add(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
-		return Int256Value{sema.Int256TypeMinIntBig}
-	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
-		return Int256Value{sema.Int256TypeMaxIntBig}
+	sum := v + o
+	// INT30-C
+	if sum < v {
+		return UInt8Value(math.MaxUint8)
 	}
-	return Int256Value{res}
+	return sum
 }
 
-func (v Int256Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -5194,30 +6960,17 @@ func (v Int256Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native int256 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v < (Int256TypeMinIntBig + o)) {
-	// 	     ...
-	//   } else if (o < 0) && (v > (Int256TypeMaxIntBig + o)) {
-	//       ...
-	//   }
-	//
-	res := new(big.Int)
-	res.Sub(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
+	diff := v - o
+
+	// INT30-C
+	if diff > v {
 		panic(UnderflowError{})
-	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
 	}
-	return Int256Value{res}
+	return diff
 }
 
-func (v Int256Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -5226,30 +6979,17 @@ func (v Int256Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native int256 type and we switch this value
-	// to be based on it, then we need to follow INT32-C:
-	//
-	//   if (o > 0) && (v < (Int256TypeMinIntBig + o)) {
-	// 	     ...
-	//   } else if (o < 0) && (v > (Int256TypeMaxIntBig + o)) {
-	//       ...
-	//   }
-	//
-	res := new(big.Int)
-	res.Sub(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
-		return Int256Value{sema.Int256TypeMinIntBig}
-	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
-		return Int256Value{sema.Int256TypeMaxIntBig}
+	diff := v - o
+
+	// INT30-C
+	if diff > v {
+		return UInt8Value(0)
 	}
-	return Int256Value{res}
+	return diff
 }
 
-func (v Int256Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -5258,17 +6998,14 @@ func (v Int256Value) Mod(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	// INT33-C
-	if o.BigInt.Cmp(res) == 0 {
+	if o == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.Rem(v.BigInt, o.BigInt)
-	return Int256Value{res}
+	return v % o
 }
 
-func (v Int256Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -5277,18 +7014,15 @@ func (v Int256Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
-	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
+	// INT30-C
+	if (v > 0) && (o > 0) && (v > (math.MaxUint8 / o)) {
 		panic(OverflowError{})
 	}
-	return Int256Value{res}
+	return v * o
 }
 
-func (v Int256Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -5297,18 +7031,15 @@ func (v Int256Value) SaturatingMul(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.Int256TypeMinIntBig) < 0 {
-		return Int256Value{sema.Int256TypeMinIntBig}
-	} else if res.Cmp(sema.Int256TypeMaxIntBig) > 0 {
-		return Int256Value{sema.Int256TypeMaxIntBig}
+	// INT30-C
+	if (v > 0) && (o > 0) && (v > (math.MaxUint8 / o)) {
+		return UInt8Value(math.MaxUint8)
 	}
-	return Int256Value{res}
+	return v * o
 }
 
-func (v Int256Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -5317,54 +7048,29 @@ func (v Int256Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	// INT33-C:
-	//   if o == 0 {
-	//       ...
-	//   } else if (v == Int256TypeMinIntBig) && (o == -1) {
-	//       ...
-	//   }
-	if o.BigInt.Cmp(res) == 0 {
+	if o == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.SetInt64(-1)
-	if (v.BigInt.Cmp(sema.Int256TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
-		panic(OverflowError{})
-	}
-	res.Div(v.BigInt, o.BigInt)
-	return Int256Value{res}
+	return v / o
 }
 
-func (v Int256Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Int256Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
+func (v UInt8Value) SaturatingDiv(other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(),
+				RightType:    other.StaticType(),
+			})
+		}
+	}()
 
-	res := new(big.Int)
-	// INT33-C:
-	//   if o == 0 {
-	//       ...
-	//   } else if (v == Int256TypeMinIntBig) && (o == -1) {
-	//       ...
-	//   }
-	if o.BigInt.Cmp(res) == 0 {
-		panic(DivisionByZeroError{})
-	}
-	res.SetInt64(-1)
-	if (v.BigInt.Cmp(sema.Int256TypeMinIntBig) == 0) && (o.BigInt.Cmp(res) == 0) {
-		return Int256Value{sema.Int256TypeMaxIntBig}
-	}
-	res.Div(v.BigInt, o.BigInt)
-	return Int256Value{res}
+	return v.Div(other)
 }
 
-func (v Int256Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -5373,12 +7079,11 @@ func (v Int256Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == -1
+	return v < o
 }
 
-func (v Int256Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -5387,12 +7092,11 @@ func (v Int256Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp <= 0
+	return v <= o
 }
 
-func (v Int256Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -5401,12 +7105,11 @@ func (v Int256Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == 1
+	return v > o
 }
 
-func (v Int256Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -5415,63 +7118,57 @@ func (v Int256Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp >= 0
+	return v >= o
 }
 
-func (v Int256Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt, ok := other.(Int256Value)
+func (v UInt8Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUInt8, ok := other.(UInt8Value)
 	if !ok {
 		return false
 	}
-	cmp := v.BigInt.Cmp(otherInt.BigInt)
-	return cmp == 0
+	return v == otherUInt8
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeInt256 (1 byte)
-// - big int value encoded in big-endian (n bytes)
-func (v Int256Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	b := SignedBigIntToBigEndianBytes(v.BigInt)
-
-	length := 1 + len(b)
-	var buffer []byte
-	if length <= len(scratch) {
-		buffer = scratch[:length]
-	} else {
-		buffer = make([]byte, length)
-	}
-
-	buffer[0] = byte(HashInputTypeInt256)
-	copy(buffer[1:], b)
-	return buffer
+// - HashInputTypeUInt8 (1 byte)
+// - uint8 value (1 byte)
+func (v UInt8Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeUInt8)
+	scratch[1] = byte(v)
+	return scratch[:2]
 }
 
-func ConvertInt256(value Value) Int256Value {
-	var v *big.Int
+func ConvertUInt8(value Value) UInt8Value {
+	var res uint8
 
 	switch value := value.(type) {
 	case BigNumberValue:
-		v = value.ToBigInt()
+		v := value.ToBigInt()
+		if v.Cmp(sema.UInt8TypeMaxInt) > 0 {
+			panic(OverflowError{})
+		} else if v.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		res = uint8(v.Int64())
 
 	case NumberValue:
-		v = big.NewInt(int64(value.ToInt()))
+		v := value.ToInt()
+		if v > math.MaxUint8 {
+			panic(OverflowError{})
+		} else if v < 0 {
+			panic(UnderflowError{})
+		}
+		res = uint8(v)
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	if v.Cmp(sema.Int256TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	} else if v.Cmp(sema.Int256TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
-	}
-
-	return NewInt256ValueFromBigInt(v)
+	return UInt8Value(res)
 }
 
-func (v Int256Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -5480,13 +7177,11 @@ func (v Int256Value) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Or(v.BigInt, o.BigInt)
-	return Int256Value{res}
+	return v | o
 }
 
-func (v Int256Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -5495,13 +7190,11 @@ func (v Int256Value) BitwiseXor(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Xor(v.BigInt, o.BigInt)
-	return Int256Value{res}
+	return v ^ o
 }
 
-func (v Int256Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -5510,13 +7203,11 @@ func (v Int256Value) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.And(v.BigInt, o.BigInt)
-	return Int256Value{res}
+	return v & o
 }
 
-func (v Int256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -5525,19 +7216,11 @@ func (v Int256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return Int256Value{res}
+	return v << o
 }
 
-func (v Int256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Int256Value)
+func (v UInt8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -5546,58 +7229,50 @@ func (v Int256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return Int256Value{res}
+	return v >> o
 }
 
-func (v Int256Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Int256Type)
+func (v UInt8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UInt8Type)
 }
 
-func (Int256Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UInt8Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Int256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UInt8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Int256Value) ToBigEndianBytes() []byte {
-	return SignedBigIntToBigEndianBytes(v.BigInt)
+func (v UInt8Value) ToBigEndianBytes() []byte {
+	return []byte{byte(v)}
 }
 
-func (v Int256Value) ConformsToDynamicType(
+func (v UInt8Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Int256Type.Equal(numberType.StaticType)
+	return ok && sema.UInt8Type.Equal(numberType.StaticType)
 }
 
-func (v Int256Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v UInt8Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Int256Value) NeedsStoreTo(_ atree.Address) bool {
+func (UInt8Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Int256Value) IsResourceKinded(_ *Interpreter) bool {
+func (UInt8Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Int256Value) Transfer(
+func (v UInt8Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -5610,114 +7285,75 @@ func (v Int256Value) Transfer(
 	return v
 }
 
-func (v Int256Value) Clone(_ *Interpreter) Value {
-	return NewInt256ValueFromBigInt(v.BigInt)
-}
-
-func (Int256Value) DeepRemove(_ *Interpreter) {
-	// NO-OP
-}
-
-func (v Int256Value) ByteSize() uint32 {
-	return cborTagSize + getBigIntCBORSize(v.BigInt)
-}
-
-func (v Int256Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
-	return v, nil
-}
-
-func (Int256Value) ChildStorables() []atree.Storable {
-	return nil
+func (v UInt8Value) Clone(_ *Interpreter) Value {
+	return v
 }
 
-// UIntValue
-
-type UIntValue struct {
-	BigInt *big.Int
+func (UInt8Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
 }
 
-func NewUIntValueFromUint64(value uint64) UIntValue {
-	return NewUIntValueFromBigInt(new(big.Int).SetUint64(value))
+func (v UInt8Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func NewUIntValueFromBigInt(value *big.Int) UIntValue {
-	return UIntValue{BigInt: value}
+func (v UInt8Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
 }
 
-func ConvertUInt(value Value) UIntValue {
-	switch value := value.(type) {
-	case BigNumberValue:
-		v := value.ToBigInt()
-		if v.Sign() < 0 {
-			panic(UnderflowError{})
-		}
-		return NewUIntValueFromBigInt(value.ToBigInt())
+func (UInt8Value) ChildStorables() []atree.Storable {
+	return nil
+}
 
-	case NumberValue:
-		v := value.ToInt()
-		if v < 0 {
-			panic(UnderflowError{})
-		}
-		return NewUIntValueFromUint64(uint64(v))
+// UInt16Value
 
-	default:
-		panic(errors.NewUnreachableError())
-	}
-}
+type UInt16Value uint16
 
-var _ Value = UIntValue{}
-var _ atree.Storable = UIntValue{}
-var _ NumberValue = UIntValue{}
-var _ IntegerValue = UIntValue{}
-var _ EquatableValue = UIntValue{}
-var _ HashableValue = UIntValue{}
-var _ MemberAccessibleValue = UIntValue{}
+var _ Value = UInt16Value(0)
+var _ atree.Storable = UInt16Value(0)
+var _ NumberValue = UInt16Value(0)
+var _ IntegerValue = UInt16Value(0)
+var _ EquatableValue = UInt16Value(0)
+var _ HashableValue = UInt16Value(0)
+var _ MemberAccessibleValue = UInt16Value(0)
 
-func (UIntValue) IsValue() {}
+func (UInt16Value) IsValue() {}
 
-func (v UIntValue) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUIntValue(interpreter, v)
+func (v UInt16Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUInt16Value(interpreter, v)
 }
 
-func (UIntValue) Walk(_ func(Value)) {
+func (UInt16Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var uintDynamicType DynamicType = NumberDynamicType{sema.UIntType}
-
-func (UIntValue) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return uintDynamicType
-}
-
-func (UIntValue) StaticType() StaticType {
-	return PrimitiveStaticTypeUInt
-}
+var uint16DynamicType DynamicType = NumberDynamicType{sema.UInt16Type}
 
-func (v UIntValue) ToInt() int {
-	if v.BigInt.IsInt64() {
-		panic(OverflowError{})
-	}
-	return int(v.BigInt.Int64())
+func (UInt16Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return uint16DynamicType
 }
 
-func (v UIntValue) ToBigInt() *big.Int {
-	return new(big.Int).Set(v.BigInt)
+func (UInt16Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUInt16
 }
 
-func (v UIntValue) String() string {
-	return format.BigInt(v.BigInt)
+func (v UInt16Value) String() string {
+	return format.Uint(uint64(v))
 }
 
-func (v UIntValue) RecursiveString(_ SeenReferences) string {
+func (v UInt16Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v UIntValue) Negate() NumberValue {
+func (v UInt16Value) ToInt() int {
+	return int(v)
+}
+func (v UInt16Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UIntValue) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -5726,28 +7362,34 @@ func (v UIntValue) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Add(v.BigInt, o.BigInt)
-	return UIntValue{res}
+	sum := v + o
+	// INT30-C
+	if sum < v {
+		panic(OverflowError{})
+	}
+	return sum
 }
 
-func (v UIntValue) SaturatingPlus(other NumberValue) NumberValue {
-	defer func() {
-		r := recover()
-		if _, ok := r.(InvalidOperandsError); ok {
-			panic(InvalidOperandsError{
-				FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-				LeftType:     v.StaticType(),
-				RightType:    other.StaticType(),
-			})
-		}
-	}()
+func (v UInt16Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
 
-	return v.Plus(other)
+	sum := v + o
+	// INT30-C
+	if sum < v {
+		return UInt16Value(math.MaxUint16)
+	}
+	return sum
 }
 
-func (v UIntValue) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -5756,17 +7398,17 @@ func (v UIntValue) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Sub(v.BigInt, o.BigInt)
+	diff := v - o
+
 	// INT30-C
-	if res.Sign() < 0 {
+	if diff > v {
 		panic(UnderflowError{})
 	}
-	return UIntValue{res}
+	return diff
 }
 
-func (v UIntValue) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -5775,17 +7417,17 @@ func (v UIntValue) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Sub(v.BigInt, o.BigInt)
+	diff := v - o
+
 	// INT30-C
-	if res.Sign() < 0 {
-		return UIntValue{sema.UIntTypeMin}
+	if diff > v {
+		return UInt16Value(0)
 	}
-	return UIntValue{res}
+	return diff
 }
 
-func (v UIntValue) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -5794,17 +7436,14 @@ func (v UIntValue) Mod(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	// INT33-C
-	if o.BigInt.Cmp(res) == 0 {
+	if o == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.Rem(v.BigInt, o.BigInt)
-	return UIntValue{res}
+	return v % o
 }
 
-func (v UIntValue) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -5813,28 +7452,15 @@ func (v UIntValue) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	return UIntValue{res}
-}
-
-func (v UIntValue) SaturatingMul(other NumberValue) NumberValue {
-	defer func() {
-		r := recover()
-		if _, ok := r.(InvalidOperandsError); ok {
-			panic(InvalidOperandsError{
-				FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-				LeftType:     v.StaticType(),
-				RightType:    other.StaticType(),
-			})
-		}
-	}()
-
-	return v.Mul(other)
+	// INT30-C
+	if (v > 0) && (o > 0) && (v > (math.MaxUint16 / o)) {
+		panic(OverflowError{})
+	}
+	return v * o
 }
 
-func (v UIntValue) Div(other NumberValue) NumberValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -5843,16 +7469,30 @@ func (v UIntValue) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	// INT33-C
-	if o.BigInt.Cmp(res) == 0 {
+	// INT30-C
+	if (v > 0) && (o > 0) && (v > (math.MaxUint16 / o)) {
+		return UInt16Value(math.MaxUint16)
+	}
+	return v * o
+}
+
+func (v UInt16Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UInt16Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	if o == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.Div(v.BigInt, o.BigInt)
-	return UIntValue{res}
+	return v / o
 }
 
-func (v UIntValue) SaturatingDiv(other NumberValue) NumberValue {
+func (v UInt16Value) SaturatingDiv(other NumberValue) NumberValue {
 	defer func() {
 		r := recover()
 		if _, ok := r.(InvalidOperandsError); ok {
@@ -5867,8 +7507,8 @@ func (v UIntValue) SaturatingDiv(other NumberValue) NumberValue {
 	return v.Div(other)
 }
 
-func (v UIntValue) Less(other NumberValue) BoolValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -5877,12 +7517,11 @@ func (v UIntValue) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == -1
+	return v < o
 }
 
-func (v UIntValue) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -5891,12 +7530,11 @@ func (v UIntValue) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp <= 0
+	return v <= o
 }
 
-func (v UIntValue) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -5905,12 +7543,11 @@ func (v UIntValue) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == 1
+	return v > o
 }
 
-func (v UIntValue) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -5919,40 +7556,57 @@ func (v UIntValue) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp >= 0
+	return v >= o
 }
 
-func (v UIntValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUInt, ok := other.(UIntValue)
+func (v UInt16Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUInt16, ok := other.(UInt16Value)
 	if !ok {
 		return false
 	}
-	cmp := v.BigInt.Cmp(otherUInt.BigInt)
-	return cmp == 0
+	return v == otherUInt16
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUInt (1 byte)
-// - big int value encoded in big-endian (n bytes)
-func (v UIntValue) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+// - HashInputTypeUInt16 (1 byte)
+// - uint16 value encoded in big-endian (2 bytes)
+func (v UInt16Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeUInt16)
+	binary.BigEndian.PutUint16(scratch[1:], uint16(v))
+	return scratch[:3]
+}
 
-	length := 1 + len(b)
-	var buffer []byte
-	if length <= len(scratch) {
-		buffer = scratch[:length]
-	} else {
-		buffer = make([]byte, length)
+func ConvertUInt16(value Value) UInt16Value {
+	var res uint16
+
+	switch value := value.(type) {
+	case BigNumberValue:
+		v := value.ToBigInt()
+		if v.Cmp(sema.UInt16TypeMaxInt) > 0 {
+			panic(OverflowError{})
+		} else if v.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		res = uint16(v.Int64())
+
+	case NumberValue:
+		v := value.ToInt()
+		if v > math.MaxUint16 {
+			panic(OverflowError{})
+		} else if v < 0 {
+			panic(UnderflowError{})
+		}
+		res = uint16(v)
+
+	default:
+		panic(errors.NewUnreachableError())
 	}
 
-	buffer[0] = byte(HashInputTypeUInt)
-	copy(buffer[1:], b)
-	return buffer
+	return UInt16Value(res)
 }
 
-func (v UIntValue) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -5961,13 +7615,11 @@ func (v UIntValue) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Or(v.BigInt, o.BigInt)
-	return UIntValue{res}
+	return v | o
 }
 
-func (v UIntValue) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -5975,14 +7627,11 @@ func (v UIntValue) BitwiseXor(other IntegerValue) IntegerValue {
 			RightType: other.StaticType(),
 		})
 	}
-
-	res := new(big.Int)
-	res.Xor(v.BigInt, o.BigInt)
-	return UIntValue{res}
+	return v ^ o
 }
 
-func (v UIntValue) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -5991,13 +7640,11 @@ func (v UIntValue) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.And(v.BigInt, o.BigInt)
-	return UIntValue{res}
+	return v & o
 }
 
-func (v UIntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -6006,19 +7653,11 @@ func (v UIntValue) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return UIntValue{res}
+	return v << o
 }
 
-func (v UIntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UIntValue)
+func (v UInt16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -6027,58 +7666,56 @@ func (v UIntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return UIntValue{res}
+	return v >> o
 }
 
-func (v UIntValue) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UIntType)
+func (v UInt16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UInt16Type)
 }
 
-func (UIntValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UInt16Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UIntValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UInt16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UIntValue) ToBigEndianBytes() []byte {
-	return UnsignedBigIntToBigEndianBytes(v.BigInt)
+func (v UInt16Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(v))
+	return b
 }
 
-func (v UIntValue) ConformsToDynamicType(
+func (v UInt16Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UIntType.Equal(numberType.StaticType)
+	return ok && sema.UInt16Type.Equal(numberType.StaticType)
 }
 
-func (v UIntValue) Storable(storage atree.SlabStorage, address atree.Address, maxInlineSize uint64) (atree.Storable, error) {
-	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
+func (UInt16Value) IsStorable() bool {
+	return true
 }
 
-func (UIntValue) NeedsStoreTo(_ atree.Address) bool {
+func (v UInt16Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (UInt16Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UIntValue) IsResourceKinded(_ *Interpreter) bool {
+func (UInt16Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UIntValue) Transfer(
+func (v UInt16Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -6091,76 +7728,76 @@ func (v UIntValue) Transfer(
 	return v
 }
 
-func (v UIntValue) Clone(_ *Interpreter) Value {
-	return NewUIntValueFromBigInt(v.BigInt)
+func (v UInt16Value) Clone(_ *Interpreter) Value {
+	return v
 }
 
-func (UIntValue) DeepRemove(_ *Interpreter) {
+func (UInt16Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UIntValue) ByteSize() uint32 {
-	return cborTagSize + getBigIntCBORSize(v.BigInt)
+func (v UInt16Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v UIntValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UInt16Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UIntValue) ChildStorables() []atree.Storable {
+func (UInt16Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UInt8Value
+// UInt32Value
 
-type UInt8Value uint8
+type UInt32Value uint32
 
-var _ Value = UInt8Value(0)
-var _ atree.Storable = UInt8Value(0)
-var _ NumberValue = UInt8Value(0)
-var _ IntegerValue = UInt8Value(0)
-var _ EquatableValue = UInt8Value(0)
-var _ HashableValue = UInt8Value(0)
-var _ MemberAccessibleValue = UInt8Value(0)
+var _ Value = UInt32Value(0)
+var _ atree.Storable = UInt32Value(0)
+var _ NumberValue = UInt32Value(0)
+var _ IntegerValue = UInt32Value(0)
+var _ EquatableValue = UInt32Value(0)
+var _ HashableValue = UInt32Value(0)
+var _ MemberAccessibleValue = UInt32Value(0)
 
-func (UInt8Value) IsValue() {}
+func (UInt32Value) IsValue() {}
 
-func (v UInt8Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUInt8Value(interpreter, v)
+func (v UInt32Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUInt32Value(interpreter, v)
 }
 
-func (UInt8Value) Walk(_ func(Value)) {
+func (UInt32Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var uint8DynamicType DynamicType = NumberDynamicType{sema.UInt8Type}
+var uint32DynamicType DynamicType = NumberDynamicType{sema.UInt32Type}
 
-func (UInt8Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return uint8DynamicType
+func (UInt32Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return uint32DynamicType
 }
 
-func (UInt8Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUInt8
+func (UInt32Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUInt32
 }
 
-func (v UInt8Value) String() string {
+func (v UInt32Value) String() string {
 	return format.Uint(uint64(v))
 }
 
-func (v UInt8Value) RecursiveString(_ SeenReferences) string {
+func (v UInt32Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v UInt8Value) ToInt() int {
+func (v UInt32Value) ToInt() int {
 	return int(v)
 }
 
-func (v UInt8Value) Negate() NumberValue {
+func (v UInt32Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt8Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -6170,6 +7807,7 @@ func (v UInt8Value) Plus(other NumberValue) NumberValue {
 	}
 
 	sum := v + o
+
 	// INT30-C
 	if sum < v {
 		panic(OverflowError{})
@@ -6177,8 +7815,8 @@ func (v UInt8Value) Plus(other NumberValue) NumberValue {
 	return sum
 }
 
-func (v UInt8Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -6190,13 +7828,13 @@ func (v UInt8Value) SaturatingPlus(other NumberValue) NumberValue {
 	sum := v + o
 	// INT30-C
 	if sum < v {
-		return UInt8Value(math.MaxUint8)
+		return UInt32Value(math.MaxUint32)
 	}
 	return sum
 }
 
-func (v UInt8Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -6214,8 +7852,8 @@ func (v UInt8Value) Minus(other NumberValue) NumberValue {
 	return diff
 }
 
-func (v UInt8Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -6228,13 +7866,13 @@ func (v UInt8Value) SaturatingMinus(other NumberValue) NumberValue {
 
 	// INT30-C
 	if diff > v {
-		return UInt8Value(0)
+		return UInt32Value(0)
 	}
 	return diff
 }
 
-func (v UInt8Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -6249,8 +7887,8 @@ func (v UInt8Value) Mod(other NumberValue) NumberValue {
 	return v % o
 }
 
-func (v UInt8Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -6259,15 +7897,14 @@ func (v UInt8Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT30-C
-	if (v > 0) && (o > 0) && (v > (math.MaxUint8 / o)) {
+	if (v > 0) && (o > 0) && (v > (math.MaxUint32 / o)) {
 		panic(OverflowError{})
 	}
 	return v * o
 }
 
-func (v UInt8Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -6277,14 +7914,14 @@ func (v UInt8Value) SaturatingMul(other NumberValue) NumberValue {
 	}
 
 	// INT30-C
-	if (v > 0) && (o > 0) && (v > (math.MaxUint8 / o)) {
-		return UInt8Value(math.MaxUint8)
+	if (v > 0) && (o > 0) && (v > (math.MaxUint32 / o)) {
+		return UInt32Value(math.MaxUint32)
 	}
 	return v * o
 }
 
-func (v UInt8Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -6299,7 +7936,7 @@ func (v UInt8Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
-func (v UInt8Value) SaturatingDiv(other NumberValue) NumberValue {
+func (v UInt32Value) SaturatingDiv(other NumberValue) NumberValue {
 	defer func() {
 		r := recover()
 		if _, ok := r.(InvalidOperandsError); ok {
@@ -6314,8 +7951,8 @@ func (v UInt8Value) SaturatingDiv(other NumberValue) NumberValue {
 	return v.Div(other)
 }
 
-func (v UInt8Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -6327,8 +7964,8 @@ func (v UInt8Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v UInt8Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -6340,8 +7977,8 @@ func (v UInt8Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v UInt8Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -6353,8 +7990,8 @@ func (v UInt8Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v UInt8Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -6366,54 +8003,54 @@ func (v UInt8Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v UInt8Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUInt8, ok := other.(UInt8Value)
+func (v UInt32Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUInt32, ok := other.(UInt32Value)
 	if !ok {
 		return false
 	}
-	return v == otherUInt8
+	return v == otherUInt32
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUInt8 (1 byte)
-// - uint8 value (1 byte)
-func (v UInt8Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeUInt8)
-	scratch[1] = byte(v)
-	return scratch[:2]
+// - HashInputTypeUInt32 (1 byte)
+// - uint32 value encoded in big-endian (4 bytes)
+func (v UInt32Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeUInt32)
+	binary.BigEndian.PutUint32(scratch[1:], uint32(v))
+	return scratch[:5]
 }
 
-func ConvertUInt8(value Value) UInt8Value {
-	var res uint8
+func ConvertUInt32(value Value) UInt32Value {
+	var res uint32
 
 	switch value := value.(type) {
 	case BigNumberValue:
 		v := value.ToBigInt()
-		if v.Cmp(sema.UInt8TypeMaxInt) > 0 {
+		if v.Cmp(sema.UInt32TypeMaxInt) > 0 {
 			panic(OverflowError{})
 		} else if v.Sign() < 0 {
 			panic(UnderflowError{})
 		}
-		res = uint8(v.Int64())
+		res = uint32(v.Int64())
 
 	case NumberValue:
 		v := value.ToInt()
-		if v > math.MaxUint8 {
+		if v > math.MaxUint32 {
 			panic(OverflowError{})
 		} else if v < 0 {
 			panic(UnderflowError{})
 		}
-		res = uint8(v)
+		res = uint32(v)
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return UInt8Value(res)
+	return UInt32Value(res)
 }
 
-func (v UInt8Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -6425,8 +8062,8 @@ func (v UInt8Value) BitwiseOr(other IntegerValue) IntegerValue {
 	return v | o
 }
 
-func (v UInt8Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -6434,12 +8071,11 @@ func (v UInt8Value) BitwiseXor(other IntegerValue) IntegerValue {
 			RightType: other.StaticType(),
 		})
 	}
-
 	return v ^ o
 }
 
-func (v UInt8Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -6451,8 +8087,8 @@ func (v UInt8Value) BitwiseAnd(other IntegerValue) IntegerValue {
 	return v & o
 }
 
-func (v UInt8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -6464,8 +8100,8 @@ func (v UInt8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 	return v << o
 }
 
-func (v UInt8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt8Value)
+func (v UInt32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -6477,47 +8113,53 @@ func (v UInt8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v UInt8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UInt8Type)
+func (v UInt32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UInt32Type)
 }
 
-func (UInt8Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UInt32Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UInt8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UInt32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt8Value) ToBigEndianBytes() []byte {
-	return []byte{byte(v)}
+func (v UInt32Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(v))
+	return b
 }
 
-func (v UInt8Value) ConformsToDynamicType(
+func (v UInt32Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UInt8Type.Equal(numberType.StaticType)
+	return ok && sema.UInt32Type.Equal(numberType.StaticType)
 }
 
-func (v UInt8Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (UInt32Value) IsStorable() bool {
+	return true
+}
+
+func (v UInt32Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UInt8Value) NeedsStoreTo(_ atree.Address) bool {
+func (UInt32Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UInt8Value) IsResourceKinded(_ *Interpreter) bool {
+func (UInt32Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UInt8Value) Transfer(
+func (v UInt32Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -6530,75 +8172,106 @@ func (v UInt8Value) Transfer(
 	return v
 }
 
-func (v UInt8Value) Clone(_ *Interpreter) Value {
+func (v UInt32Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (UInt8Value) DeepRemove(_ *Interpreter) {
+func (UInt32Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UInt8Value) ByteSize() uint32 {
+func (v UInt32Value) ByteSize() uint32 {
 	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v UInt8Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UInt32Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UInt8Value) ChildStorables() []atree.Storable {
+func (UInt32Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UInt16Value
+// UInt64Value
 
-type UInt16Value uint16
+type UInt64Value uint64
 
-var _ Value = UInt16Value(0)
-var _ atree.Storable = UInt16Value(0)
-var _ NumberValue = UInt16Value(0)
-var _ IntegerValue = UInt16Value(0)
-var _ EquatableValue = UInt16Value(0)
-var _ HashableValue = UInt16Value(0)
-var _ MemberAccessibleValue = UInt16Value(0)
+var _ Value = UInt64Value(0)
+var _ atree.Storable = UInt64Value(0)
+var _ NumberValue = UInt64Value(0)
+var _ IntegerValue = UInt64Value(0)
+var _ EquatableValue = UInt64Value(0)
+var _ HashableValue = UInt64Value(0)
+var _ MemberAccessibleValue = UInt64Value(0)
 
-func (UInt16Value) IsValue() {}
+// NOTE: important, do *NOT* remove:
+// UInt64 values > math.MaxInt64 overflow int.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+var _ BigNumberValue = UInt64Value(0)
 
-func (v UInt16Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUInt16Value(interpreter, v)
+func (UInt64Value) IsValue() {}
+
+func (v UInt64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUInt64Value(interpreter, v)
 }
 
-func (UInt16Value) Walk(_ func(Value)) {
+func (UInt64Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var uint16DynamicType DynamicType = NumberDynamicType{sema.UInt16Type}
+var uint64DynamicType DynamicType = NumberDynamicType{sema.UInt64Type}
 
-func (UInt16Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return uint16DynamicType
+func (UInt64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return uint64DynamicType
 }
 
-func (UInt16Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUInt16
+func (UInt64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUInt64
 }
 
-func (v UInt16Value) String() string {
+func (v UInt64Value) String() string {
 	return format.Uint(uint64(v))
 }
 
-func (v UInt16Value) RecursiveString(_ SeenReferences) string {
+func (v UInt64Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v UInt16Value) ToInt() int {
+func (v UInt64Value) ToInt() int {
+	if v > math.MaxInt64 {
+		panic(OverflowError{})
+	}
 	return int(v)
 }
-func (v UInt16Value) Negate() NumberValue {
+
+// ToBigInt
+//
+// NOTE: important, do *NOT* remove:
+// UInt64 values > math.MaxInt64 overflow int.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+func (v UInt64Value) ToBigInt() *big.Int {
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func (v UInt64Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt16Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func safeAddUint64(a, b uint64) uint64 {
+	sum := a + b
+	// INT30-C
+	if sum < a {
+		panic(OverflowError{})
+	}
+	return sum
+}
+
+func (v UInt64Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -6607,16 +8280,11 @@ func (v UInt16Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	sum := v + o
-	// INT30-C
-	if sum < v {
-		panic(OverflowError{})
-	}
-	return sum
+	return UInt64Value(safeAddUint64(uint64(v), uint64(o)))
 }
 
-func (v UInt16Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -6626,15 +8294,16 @@ func (v UInt16Value) SaturatingPlus(other NumberValue) NumberValue {
 	}
 
 	sum := v + o
+
 	// INT30-C
 	if sum < v {
-		return UInt16Value(math.MaxUint16)
+		return UInt64Value(math.MaxUint64)
 	}
 	return sum
 }
 
-func (v UInt16Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -6652,8 +8321,8 @@ func (v UInt16Value) Minus(other NumberValue) NumberValue {
 	return diff
 }
 
-func (v UInt16Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -6666,13 +8335,13 @@ func (v UInt16Value) SaturatingMinus(other NumberValue) NumberValue {
 
 	// INT30-C
 	if diff > v {
-		return UInt16Value(0)
+		return UInt64Value(0)
 	}
 	return diff
 }
 
-func (v UInt16Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -6687,8 +8356,8 @@ func (v UInt16Value) Mod(other NumberValue) NumberValue {
 	return v % o
 }
 
-func (v UInt16Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -6697,15 +8366,14 @@ func (v UInt16Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT30-C
-	if (v > 0) && (o > 0) && (v > (math.MaxUint16 / o)) {
+	if (v > 0) && (o > 0) && (v > (math.MaxUint64 / o)) {
 		panic(OverflowError{})
 	}
 	return v * o
 }
 
-func (v UInt16Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -6715,14 +8383,14 @@ func (v UInt16Value) SaturatingMul(other NumberValue) NumberValue {
 	}
 
 	// INT30-C
-	if (v > 0) && (o > 0) && (v > (math.MaxUint16 / o)) {
-		return UInt16Value(math.MaxUint16)
+	if (v > 0) && (o > 0) && (v > (math.MaxUint64 / o)) {
+		return UInt64Value(math.MaxUint64)
 	}
 	return v * o
 }
 
-func (v UInt16Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -6737,7 +8405,7 @@ func (v UInt16Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
-func (v UInt16Value) SaturatingDiv(other NumberValue) NumberValue {
+func (v UInt64Value) SaturatingDiv(other NumberValue) NumberValue {
 	defer func() {
 		r := recover()
 		if _, ok := r.(InvalidOperandsError); ok {
@@ -6752,8 +8420,8 @@ func (v UInt16Value) SaturatingDiv(other NumberValue) NumberValue {
 	return v.Div(other)
 }
 
-func (v UInt16Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -6765,8 +8433,8 @@ func (v UInt16Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v UInt16Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -6778,8 +8446,8 @@ func (v UInt16Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v UInt16Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -6791,8 +8459,8 @@ func (v UInt16Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v UInt16Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -6804,54 +8472,52 @@ func (v UInt16Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v UInt16Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUInt16, ok := other.(UInt16Value)
+func (v UInt64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUInt64, ok := other.(UInt64Value)
 	if !ok {
 		return false
 	}
-	return v == otherUInt16
+	return v == otherUInt64
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUInt16 (1 byte)
-// - uint16 value encoded in big-endian (2 bytes)
-func (v UInt16Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeUInt16)
-	binary.BigEndian.PutUint16(scratch[1:], uint16(v))
-	return scratch[:3]
+// - HashInputTypeUInt64 (1 byte)
+// - uint64 value encoded in big-endian (8 bytes)
+func (v UInt64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeUInt64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
 }
 
-func ConvertUInt16(value Value) UInt16Value {
-	var res uint16
+func ConvertUInt64(value Value) UInt64Value {
+	var res uint64
 
 	switch value := value.(type) {
 	case BigNumberValue:
 		v := value.ToBigInt()
-		if v.Cmp(sema.UInt16TypeMaxInt) > 0 {
+		if v.Cmp(sema.UInt64TypeMaxInt) > 0 {
 			panic(OverflowError{})
 		} else if v.Sign() < 0 {
 			panic(UnderflowError{})
 		}
-		res = uint16(v.Int64())
+		res = uint64(v.Int64())
 
 	case NumberValue:
 		v := value.ToInt()
-		if v > math.MaxUint16 {
-			panic(OverflowError{})
-		} else if v < 0 {
+		if v < 0 {
 			panic(UnderflowError{})
 		}
-		res = uint16(v)
+		res = uint64(v)
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return UInt16Value(res)
+	return UInt64Value(res)
 }
 
-func (v UInt16Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -6863,8 +8529,8 @@ func (v UInt16Value) BitwiseOr(other IntegerValue) IntegerValue {
 	return v | o
 }
 
-func (v UInt16Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -6875,8 +8541,8 @@ func (v UInt16Value) BitwiseXor(other IntegerValue) IntegerValue {
 	return v ^ o
 }
 
-func (v UInt16Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -6888,8 +8554,8 @@ func (v UInt16Value) BitwiseAnd(other IntegerValue) IntegerValue {
 	return v & o
 }
 
-func (v UInt16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -6901,8 +8567,8 @@ func (v UInt16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 	return v << o
 }
 
-func (v UInt16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt16Value)
+func (v UInt64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -6914,53 +8580,53 @@ func (v UInt16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v UInt16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UInt16Type)
+func (v UInt64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UInt64Type)
 }
 
-func (UInt16Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UInt64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UInt16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UInt64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt16Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 2)
-	binary.BigEndian.PutUint16(b, uint16(v))
+func (v UInt64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
 	return b
 }
 
-func (v UInt16Value) ConformsToDynamicType(
+func (v UInt64Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UInt16Type.Equal(numberType.StaticType)
+	return ok && sema.UInt64Type.Equal(numberType.StaticType)
 }
 
-func (UInt16Value) IsStorable() bool {
+func (UInt64Value) IsStorable() bool {
 	return true
 }
 
-func (v UInt16Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v UInt64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UInt16Value) NeedsStoreTo(_ atree.Address) bool {
+func (UInt64Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UInt16Value) IsResourceKinded(_ *Interpreter) bool {
+func (UInt64Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UInt16Value) Transfer(
+func (v UInt64Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -6973,76 +8639,93 @@ func (v UInt16Value) Transfer(
 	return v
 }
 
-func (v UInt16Value) Clone(_ *Interpreter) Value {
+func (v UInt64Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (UInt16Value) DeepRemove(_ *Interpreter) {
+func (UInt64Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UInt16Value) ByteSize() uint32 {
+func (v UInt64Value) ByteSize() uint32 {
 	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v UInt16Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UInt64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UInt16Value) ChildStorables() []atree.Storable {
+func (UInt64Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UInt32Value
+// UInt128Value
 
-type UInt32Value uint32
+type UInt128Value struct {
+	BigInt *big.Int
+}
 
-var _ Value = UInt32Value(0)
-var _ atree.Storable = UInt32Value(0)
-var _ NumberValue = UInt32Value(0)
-var _ IntegerValue = UInt32Value(0)
-var _ EquatableValue = UInt32Value(0)
-var _ HashableValue = UInt32Value(0)
-var _ MemberAccessibleValue = UInt32Value(0)
+func NewUInt128ValueFromUint64(value uint64) UInt128Value {
+	return NewUInt128ValueFromBigInt(new(big.Int).SetUint64(value))
+}
 
-func (UInt32Value) IsValue() {}
+func NewUInt128ValueFromBigInt(value *big.Int) UInt128Value {
+	return UInt128Value{BigInt: value}
+}
 
-func (v UInt32Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUInt32Value(interpreter, v)
+var _ Value = UInt128Value{}
+var _ atree.Storable = UInt128Value{}
+var _ NumberValue = UInt128Value{}
+var _ IntegerValue = UInt128Value{}
+var _ EquatableValue = UInt128Value{}
+var _ HashableValue = UInt128Value{}
+var _ MemberAccessibleValue = UInt128Value{}
+
+func (UInt128Value) IsValue() {}
+
+func (v UInt128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUInt128Value(interpreter, v)
 }
 
-func (UInt32Value) Walk(_ func(Value)) {
+func (UInt128Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var uint32DynamicType DynamicType = NumberDynamicType{sema.UInt32Type}
+var uint128DynamicType DynamicType = NumberDynamicType{sema.UInt128Type}
 
-func (UInt32Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return uint32DynamicType
+func (UInt128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return uint128DynamicType
 }
 
-func (UInt32Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUInt32
+func (UInt128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUInt128
 }
 
-func (v UInt32Value) String() string {
-	return format.Uint(uint64(v))
+func (v UInt128Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(v.BigInt.Int64())
 }
 
-func (v UInt32Value) RecursiveString(_ SeenReferences) string {
-	return v.String()
+func (v UInt128Value) ToBigInt() *big.Int {
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v UInt32Value) ToInt() int {
-	return int(v)
+func (v UInt128Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func (v UInt32Value) Negate() NumberValue {
+func (v UInt128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UInt128Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt32Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -7051,17 +8734,26 @@ func (v UInt32Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	sum := v + o
-
-	// INT30-C
-	if sum < v {
+	sum := new(big.Int)
+	sum.Add(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native uint128 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//  if sum < v {
+	//      ...
+	//  }
+	//
+	if sum.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
 		panic(OverflowError{})
 	}
-	return sum
+	return UInt128Value{sum}
 }
 
-func (v UInt32Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -7070,16 +8762,26 @@ func (v UInt32Value) SaturatingPlus(other NumberValue) NumberValue {
 		})
 	}
 
-	sum := v + o
-	// INT30-C
-	if sum < v {
-		return UInt32Value(math.MaxUint32)
+	sum := new(big.Int)
+	sum.Add(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native uint128 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//  if sum < v {
+	//      ...
+	//  }
+	//
+	if sum.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		return UInt128Value{sema.UInt128TypeMaxIntBig}
 	}
-	return sum
+	return UInt128Value{sum}
 }
 
-func (v UInt32Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -7088,17 +8790,26 @@ func (v UInt32Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := v - o
-
-	// INT30-C
-	if diff > v {
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native uint128 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//   if diff > v {
+	// 	     ...
+	//   }
+	//
+	if diff.Cmp(sema.UInt128TypeMinIntBig) < 0 {
 		panic(UnderflowError{})
 	}
-	return diff
+	return UInt128Value{diff}
 }
 
-func (v UInt32Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -7107,17 +8818,26 @@ func (v UInt32Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := v - o
-
-	// INT30-C
-	if diff > v {
-		return UInt32Value(0)
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native uint128 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//   if diff > v {
+	// 	     ...
+	//   }
+	//
+	if diff.Cmp(sema.UInt128TypeMinIntBig) < 0 {
+		return UInt128Value{sema.UInt128TypeMinIntBig}
 	}
-	return diff
+	return UInt128Value{diff}
 }
 
-func (v UInt32Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -7126,14 +8846,16 @@ func (v UInt32Value) Mod(other NumberValue) NumberValue {
 		})
 	}
 
-	if o == 0 {
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
 	}
-	return v % o
+	res.Rem(v.BigInt, o.BigInt)
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -7142,14 +8864,16 @@ func (v UInt32Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	if (v > 0) && (o > 0) && (v > (math.MaxUint32 / o)) {
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
 		panic(OverflowError{})
 	}
-	return v * o
+	return UInt128Value{res}
 }
-
-func (v UInt32Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+
+func (v UInt128Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -7158,15 +8882,16 @@ func (v UInt32Value) SaturatingMul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT30-C
-	if (v > 0) && (o > 0) && (v > (math.MaxUint32 / o)) {
-		return UInt32Value(math.MaxUint32)
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		return UInt128Value{sema.UInt128TypeMaxIntBig}
 	}
-	return v * o
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -7175,13 +8900,15 @@ func (v UInt32Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	if o == 0 {
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
 	}
-	return v / o
+	res.Div(v.BigInt, o.BigInt)
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) SaturatingDiv(other NumberValue) NumberValue {
+func (v UInt128Value) SaturatingDiv(other NumberValue) NumberValue {
 	defer func() {
 		r := recover()
 		if _, ok := r.(InvalidOperandsError); ok {
@@ -7196,8 +8923,8 @@ func (v UInt32Value) SaturatingDiv(other NumberValue) NumberValue {
 	return v.Div(other)
 }
 
-func (v UInt32Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -7206,11 +8933,12 @@ func (v UInt32Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	return v < o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == -1
 }
 
-func (v UInt32Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -7219,11 +8947,12 @@ func (v UInt32Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v <= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp <= 0
 }
 
-func (v UInt32Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -7232,11 +8961,12 @@ func (v UInt32Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	return v > o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == 1
 }
 
-func (v UInt32Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -7245,57 +8975,63 @@ func (v UInt32Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v >= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp >= 0
 }
 
-func (v UInt32Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUInt32, ok := other.(UInt32Value)
+func (v UInt128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt, ok := other.(UInt128Value)
 	if !ok {
 		return false
 	}
-	return v == otherUInt32
+	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	return cmp == 0
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUInt32 (1 byte)
-// - uint32 value encoded in big-endian (4 bytes)
-func (v UInt32Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeUInt32)
-	binary.BigEndian.PutUint32(scratch[1:], uint32(v))
-	return scratch[:5]
+// - HashInputTypeUInt128 (1 byte)
+// - big int encoded in big endian (n bytes)
+func (v UInt128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUInt128)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func ConvertUInt32(value Value) UInt32Value {
-	var res uint32
+func ConvertUInt128(value Value) UInt128Value {
+	var v *big.Int
 
 	switch value := value.(type) {
 	case BigNumberValue:
-		v := value.ToBigInt()
-		if v.Cmp(sema.UInt32TypeMaxInt) > 0 {
-			panic(OverflowError{})
-		} else if v.Sign() < 0 {
-			panic(UnderflowError{})
-		}
-		res = uint32(v.Int64())
+		v = value.ToBigInt()
 
 	case NumberValue:
-		v := value.ToInt()
-		if v > math.MaxUint32 {
-			panic(OverflowError{})
-		} else if v < 0 {
-			panic(UnderflowError{})
-		}
-		res = uint32(v)
+		v = big.NewInt(int64(value.ToInt()))
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return UInt32Value(res)
+	if v.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	} else if v.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	return NewUInt128ValueFromBigInt(v)
 }
 
-func (v UInt32Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -7304,11 +9040,13 @@ func (v UInt32Value) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v | o
+	res := new(big.Int)
+	res.Or(v.BigInt, o.BigInt)
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -7316,11 +9054,14 @@ func (v UInt32Value) BitwiseXor(other IntegerValue) IntegerValue {
 			RightType: other.StaticType(),
 		})
 	}
-	return v ^ o
+
+	res := new(big.Int)
+	res.Xor(v.BigInt, o.BigInt)
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -7329,11 +9070,13 @@ func (v UInt32Value) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v & o
+	res := new(big.Int)
+	res.And(v.BigInt, o.BigInt)
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -7342,11 +9085,19 @@ func (v UInt32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v << o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt32Value)
+func (v UInt128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -7355,56 +9106,62 @@ func (v UInt32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v >> o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return UInt128Value{res}
 }
 
-func (v UInt32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UInt32Type)
+func (v UInt128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UInt128Type)
 }
 
-func (UInt32Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UInt128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UInt32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UInt128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt32Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, uint32(v))
-	return b
+func (v UInt128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v UInt32Value) ConformsToDynamicType(
+func (v UInt128Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UInt32Type.Equal(numberType.StaticType)
+	return ok && sema.UInt128Type.Equal(numberType.StaticType)
 }
 
-func (UInt32Value) IsStorable() bool {
+func (UInt128Value) IsStorable() bool {
 	return true
 }
 
-func (v UInt32Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v UInt128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UInt32Value) NeedsStoreTo(_ atree.Address) bool {
+func (UInt128Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UInt32Value) IsResourceKinded(_ *Interpreter) bool {
+func (UInt128Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UInt32Value) Transfer(
+func (v UInt128Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -7417,106 +9174,94 @@ func (v UInt32Value) Transfer(
 	return v
 }
 
-func (v UInt32Value) Clone(_ *Interpreter) Value {
-	return v
+func (v UInt128Value) Clone(_ *Interpreter) Value {
+	return NewUInt128ValueFromBigInt(v.BigInt)
 }
 
-func (UInt32Value) DeepRemove(_ *Interpreter) {
+func (UInt128Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UInt32Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (v UInt128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v UInt32Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UInt128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UInt32Value) ChildStorables() []atree.Storable {
+func (UInt128Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UInt64Value
-
-type UInt64Value uint64
-
-var _ Value = UInt64Value(0)
-var _ atree.Storable = UInt64Value(0)
-var _ NumberValue = UInt64Value(0)
-var _ IntegerValue = UInt64Value(0)
-var _ EquatableValue = UInt64Value(0)
-var _ HashableValue = UInt64Value(0)
-var _ MemberAccessibleValue = UInt64Value(0)
-
-// NOTE: important, do *NOT* remove:
-// UInt64 values > math.MaxInt64 overflow int.
-// Implementing BigNumberValue ensures conversion functions
-// call ToBigInt instead of ToInt.
-//
-var _ BigNumberValue = UInt64Value(0)
+// UInt256Value
 
-func (UInt64Value) IsValue() {}
+type UInt256Value struct {
+	BigInt *big.Int
+}
 
-func (v UInt64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUInt64Value(interpreter, v)
+func NewUInt256ValueFromUint64(value uint64) UInt256Value {
+	return NewUInt256ValueFromBigInt(new(big.Int).SetUint64(value))
 }
 
-func (UInt64Value) Walk(_ func(Value)) {
-	// NO-OP
+func NewUInt256ValueFromBigInt(value *big.Int) UInt256Value {
+	return UInt256Value{BigInt: value}
 }
 
-var uint64DynamicType DynamicType = NumberDynamicType{sema.UInt64Type}
+var _ Value = UInt256Value{}
+var _ atree.Storable = UInt256Value{}
+var _ NumberValue = UInt256Value{}
+var _ IntegerValue = UInt256Value{}
+var _ EquatableValue = UInt256Value{}
+var _ HashableValue = UInt256Value{}
+var _ MemberAccessibleValue = UInt256Value{}
 
-func (UInt64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return uint64DynamicType
+func (UInt256Value) IsValue() {}
+
+func (v UInt256Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUInt256Value(interpreter, v)
 }
 
-func (UInt64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUInt64
+func (UInt256Value) Walk(_ func(Value)) {
+	// NO-OP
 }
 
-func (v UInt64Value) String() string {
-	return format.Uint(uint64(v))
+var uint256DynamicType DynamicType = NumberDynamicType{sema.UInt256Type}
+
+func (UInt256Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return uint256DynamicType
 }
 
-func (v UInt64Value) RecursiveString(_ SeenReferences) string {
-	return v.String()
+func (UInt256Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUInt256
 }
 
-func (v UInt64Value) ToInt() int {
-	if v > math.MaxInt64 {
+func (v UInt256Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
 		panic(OverflowError{})
 	}
-	return int(v)
+
+	return int(v.BigInt.Int64())
 }
 
-// ToBigInt
-//
-// NOTE: important, do *NOT* remove:
-// UInt64 values > math.MaxInt64 overflow int.
-// Implementing BigNumberValue ensures conversion functions
-// call ToBigInt instead of ToInt.
-//
-func (v UInt64Value) ToBigInt() *big.Int {
-	return new(big.Int).SetUint64(uint64(v))
+func (v UInt256Value) ToBigInt() *big.Int {
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v UInt64Value) Negate() NumberValue {
-	panic(errors.NewUnreachableError())
+func (v UInt256Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func safeAddUint64(a, b uint64) uint64 {
-	sum := a + b
-	// INT30-C
-	if sum < a {
-		panic(OverflowError{})
-	}
-	return sum
+func (v UInt256Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
 }
 
-func (v UInt64Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UInt256Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -7525,11 +9270,26 @@ func (v UInt64Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return UInt64Value(safeAddUint64(uint64(v), uint64(o)))
+	sum := new(big.Int)
+	sum.Add(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native uint256 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//  if sum < v {
+	//      ...
+	//  }
+	//
+	if sum.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+	return UInt256Value{sum}
 }
 
-func (v UInt64Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -7538,17 +9298,26 @@ func (v UInt64Value) SaturatingPlus(other NumberValue) NumberValue {
 		})
 	}
 
-	sum := v + o
-
-	// INT30-C
-	if sum < v {
-		return UInt64Value(math.MaxUint64)
+	sum := new(big.Int)
+	sum.Add(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+	//
+	// If Go gains a native uint256 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//  if sum < v {
+	//      ...
+	//  }
+	//
+	if sum.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
+		return UInt256Value{sema.UInt256TypeMaxIntBig}
 	}
-	return sum
+	return UInt256Value{sum}
 }
 
-func (v UInt64Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -7557,17 +9326,26 @@ func (v UInt64Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := v - o
-
-	// INT30-C
-	if diff > v {
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native uint256 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//   if diff > v {
+	// 	     ...
+	//   }
+	//
+	if diff.Cmp(sema.UInt256TypeMinIntBig) < 0 {
 		panic(UnderflowError{})
 	}
-	return diff
+	return UInt256Value{diff}
 }
 
-func (v UInt64Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -7576,17 +9354,26 @@ func (v UInt64Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := v - o
-
-	// INT30-C
-	if diff > v {
-		return UInt64Value(0)
+	diff := new(big.Int)
+	diff.Sub(v.BigInt, o.BigInt)
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just subtract and check the range of the result.
+	//
+	// If Go gains a native uint256 type and we switch this value
+	// to be based on it, then we need to follow INT30-C:
+	//
+	//   if diff > v {
+	// 	     ...
+	//   }
+	//
+	if diff.Cmp(sema.UInt256TypeMinIntBig) < 0 {
+		return UInt256Value{sema.UInt256TypeMinIntBig}
 	}
-	return diff
+	return UInt256Value{diff}
 }
 
-func (v UInt64Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -7595,14 +9382,16 @@ func (v UInt64Value) Mod(other NumberValue) NumberValue {
 		})
 	}
 
-	if o == 0 {
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
 	}
-	return v % o
+	res.Rem(v.BigInt, o.BigInt)
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -7611,14 +9400,16 @@ func (v UInt64Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	if (v > 0) && (o > 0) && (v > (math.MaxUint64 / o)) {
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
 		panic(OverflowError{})
 	}
-	return v * o
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -7627,15 +9418,16 @@ func (v UInt64Value) SaturatingMul(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT30-C
-	if (v > 0) && (o > 0) && (v > (math.MaxUint64 / o)) {
-		return UInt64Value(math.MaxUint64)
+	res := new(big.Int)
+	res.Mul(v.BigInt, o.BigInt)
+	if res.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
+		return UInt256Value{sema.UInt256TypeMaxIntBig}
 	}
-	return v * o
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -7644,13 +9436,15 @@ func (v UInt64Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	if o == 0 {
+	res := new(big.Int)
+	if o.BigInt.Cmp(res) == 0 {
 		panic(DivisionByZeroError{})
 	}
-	return v / o
+	res.Div(v.BigInt, o.BigInt)
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) SaturatingDiv(other NumberValue) NumberValue {
+func (v UInt256Value) SaturatingDiv(other NumberValue) NumberValue {
 	defer func() {
 		r := recover()
 		if _, ok := r.(InvalidOperandsError); ok {
@@ -7665,8 +9459,8 @@ func (v UInt64Value) SaturatingDiv(other NumberValue) NumberValue {
 	return v.Div(other)
 }
 
-func (v UInt64Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -7675,11 +9469,12 @@ func (v UInt64Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	return v < o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == -1
 }
 
-func (v UInt64Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -7688,11 +9483,12 @@ func (v UInt64Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v <= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp <= 0
 }
 
-func (v UInt64Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -7701,11 +9497,12 @@ func (v UInt64Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	return v > o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp == 1
 }
 
-func (v UInt64Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -7714,55 +9511,63 @@ func (v UInt64Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v >= o
+	cmp := v.BigInt.Cmp(o.BigInt)
+	return cmp >= 0
 }
 
-func (v UInt64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUInt64, ok := other.(UInt64Value)
+func (v UInt256Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt, ok := other.(UInt256Value)
 	if !ok {
 		return false
 	}
-	return v == otherUInt64
+	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	return cmp == 0
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUInt64 (1 byte)
-// - uint64 value encoded in big-endian (8 bytes)
-func (v UInt64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeUInt64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
+// - HashInputTypeUInt256 (1 byte)
+// - big int encoded in big endian (n bytes)
+func (v UInt256Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUInt256)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func ConvertUInt64(value Value) UInt64Value {
-	var res uint64
+func ConvertUInt256(value Value) UInt256Value {
+	var v *big.Int
 
 	switch value := value.(type) {
 	case BigNumberValue:
-		v := value.ToBigInt()
-		if v.Cmp(sema.UInt64TypeMaxInt) > 0 {
-			panic(OverflowError{})
-		} else if v.Sign() < 0 {
-			panic(UnderflowError{})
-		}
-		res = uint64(v.Int64())
-
-	case NumberValue:
-		v := value.ToInt()
-		if v < 0 {
-			panic(UnderflowError{})
-		}
-		res = uint64(v)
+		v = value.ToBigInt()
+
+	case NumberValue:
+		v = big.NewInt(int64(value.ToInt()))
 
 	default:
 		panic(errors.NewUnreachableError())
 	}
 
-	return UInt64Value(res)
+	if v.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	} else if v.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	return NewUInt256ValueFromBigInt(v)
 }
 
-func (v UInt64Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -7771,11 +9576,13 @@ func (v UInt64Value) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v | o
+	res := new(big.Int)
+	res.Or(v.BigInt, o.BigInt)
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -7783,11 +9590,14 @@ func (v UInt64Value) BitwiseXor(other IntegerValue) IntegerValue {
 			RightType: other.StaticType(),
 		})
 	}
-	return v ^ o
+
+	res := new(big.Int)
+	res.Xor(v.BigInt, o.BigInt)
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -7796,11 +9606,13 @@ func (v UInt64Value) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v & o
+	res := new(big.Int)
+	res.And(v.BigInt, o.BigInt)
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -7809,11 +9621,19 @@ func (v UInt64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v << o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt64Value)
+func (v UInt256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(UInt256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -7822,56 +9642,61 @@ func (v UInt64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	return v >> o
+	res := new(big.Int)
+	if o.BigInt.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+	if !o.BigInt.IsUint64() {
+		panic(OverflowError{})
+	}
+	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+	return UInt256Value{res}
 }
 
-func (v UInt64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UInt64Type)
+func (v UInt256Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UInt256Type)
 }
 
-func (UInt64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UInt256Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UInt64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UInt256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v UInt256Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v UInt64Value) ConformsToDynamicType(
+func (v UInt256Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UInt64Type.Equal(numberType.StaticType)
+	return ok && sema.UInt256Type.Equal(numberType.StaticType)
 }
 
-func (UInt64Value) IsStorable() bool {
+func (UInt256Value) IsStorable() bool {
 	return true
 }
 
-func (v UInt64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v UInt256Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UInt64Value) NeedsStoreTo(_ atree.Address) bool {
+func (UInt256Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UInt64Value) IsResourceKinded(_ *Interpreter) bool {
+func (UInt256Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
-
-func (v UInt64Value) Transfer(
+func (v UInt256Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -7884,205 +9709,110 @@ func (v UInt64Value) Transfer(
 	return v
 }
 
-func (v UInt64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v UInt256Value) Clone(_ *Interpreter) Value {
+	return NewUInt256ValueFromBigInt(v.BigInt)
 }
 
-func (UInt64Value) DeepRemove(_ *Interpreter) {
+func (UInt256Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UInt64Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (v UInt256Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v UInt64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UInt256Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UInt64Value) ChildStorables() []atree.Storable {
+func (UInt256Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UInt128Value
-
-type UInt128Value struct {
-	BigInt *big.Int
-}
-
-func NewUInt128ValueFromUint64(value uint64) UInt128Value {
-	return NewUInt128ValueFromBigInt(new(big.Int).SetUint64(value))
-}
+// Word8Value
 
-func NewUInt128ValueFromBigInt(value *big.Int) UInt128Value {
-	return UInt128Value{BigInt: value}
-}
+type Word8Value uint8
 
-var _ Value = UInt128Value{}
-var _ atree.Storable = UInt128Value{}
-var _ NumberValue = UInt128Value{}
-var _ IntegerValue = UInt128Value{}
-var _ EquatableValue = UInt128Value{}
-var _ HashableValue = UInt128Value{}
-var _ MemberAccessibleValue = UInt128Value{}
+var _ Value = Word8Value(0)
+var _ atree.Storable = Word8Value(0)
+var _ NumberValue = Word8Value(0)
+var _ IntegerValue = Word8Value(0)
+var _ EquatableValue = Word8Value(0)
+var _ HashableValue = Word8Value(0)
+var _ MemberAccessibleValue = Word8Value(0)
 
-func (UInt128Value) IsValue() {}
+func (Word8Value) IsValue() {}
 
-func (v UInt128Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUInt128Value(interpreter, v)
+func (v Word8Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord8Value(interpreter, v)
 }
 
-func (UInt128Value) Walk(_ func(Value)) {
+func (Word8Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var uint128DynamicType DynamicType = NumberDynamicType{sema.UInt128Type}
-
-func (UInt128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return uint128DynamicType
-}
-
-func (UInt128Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUInt128
-}
+var word8DynamicType DynamicType = NumberDynamicType{sema.Word8Type}
 
-func (v UInt128Value) ToInt() int {
-	if !v.BigInt.IsInt64() {
-		panic(OverflowError{})
-	}
-	return int(v.BigInt.Int64())
+func (Word8Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return word8DynamicType
 }
 
-func (v UInt128Value) ToBigInt() *big.Int {
-	return new(big.Int).Set(v.BigInt)
+func (Word8Value) StaticType() StaticType {
+	return PrimitiveStaticTypeWord8
 }
 
-func (v UInt128Value) String() string {
-	return format.BigInt(v.BigInt)
+func (v Word8Value) String() string {
+	return format.Uint(uint64(v))
 }
 
-func (v UInt128Value) RecursiveString(_ SeenReferences) string {
+func (v Word8Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v UInt128Value) Negate() NumberValue {
-	panic(errors.NewUnreachableError())
-}
-
-func (v UInt128Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationPlus,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
-
-	sum := new(big.Int)
-	sum.Add(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native uint128 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//  if sum < v {
-	//      ...
-	//  }
-	//
-	if sum.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	}
-	return UInt128Value{sum}
+func (v Word8Value) ToInt() int {
+	return int(v)
 }
 
-func (v UInt128Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
-
-	sum := new(big.Int)
-	sum.Add(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native uint128 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//  if sum < v {
-	//      ...
-	//  }
-	//
-	if sum.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
-		return UInt128Value{sema.UInt128TypeMaxIntBig}
-	}
-	return UInt128Value{sum}
+func (v Word8Value) Negate() NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v UInt128Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Word8Value)
 	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationMinus,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
-
-	diff := new(big.Int)
-	diff.Sub(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native uint128 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//   if diff > v {
-	// 	     ...
-	//   }
-	//
-	if diff.Cmp(sema.UInt128TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
 	}
-	return UInt128Value{diff}
+
+	return v + o
 }
 
-func (v UInt128Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) SaturatingPlus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
+}
+
+func (v Word8Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
 		})
 	}
 
-	diff := new(big.Int)
-	diff.Sub(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native uint128 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//   if diff > v {
-	// 	     ...
-	//   }
-	//
-	if diff.Cmp(sema.UInt128TypeMinIntBig) < 0 {
-		return UInt128Value{sema.UInt128TypeMinIntBig}
-	}
-	return UInt128Value{diff}
+	return v - o
 }
 
-func (v UInt128Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) SaturatingMinus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
+}
+
+func (v Word8Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -8091,16 +9821,14 @@ func (v UInt128Value) Mod(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Cmp(res) == 0 {
+	if o == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.Rem(v.BigInt, o.BigInt)
-	return UInt128Value{res}
+	return v % o
 }
 
-func (v UInt128Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -8109,34 +9837,15 @@ func (v UInt128Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	}
-	return UInt128Value{res}
+	return v * o
 }
 
-func (v UInt128Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
-
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
-		return UInt128Value{sema.UInt128TypeMaxIntBig}
-	}
-	return UInt128Value{res}
+func (v Word8Value) SaturatingMul(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UInt128Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -8145,31 +9854,18 @@ func (v UInt128Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Cmp(res) == 0 {
+	if o == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.Div(v.BigInt, o.BigInt)
-	return UInt128Value{res}
+	return v / o
 }
 
-func (v UInt128Value) SaturatingDiv(other NumberValue) NumberValue {
-	defer func() {
-		r := recover()
-		if _, ok := r.(InvalidOperandsError); ok {
-			panic(InvalidOperandsError{
-				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-				LeftType:     v.StaticType(),
-				RightType:    other.StaticType(),
-			})
-		}
-	}()
-
-	return v.Div(other)
+func (v Word8Value) SaturatingDiv(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UInt128Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -8178,12 +9874,11 @@ func (v UInt128Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == -1
+	return v < o
 }
 
-func (v UInt128Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -8192,12 +9887,11 @@ func (v UInt128Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp <= 0
+	return v <= o
 }
 
-func (v UInt128Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -8206,12 +9900,11 @@ func (v UInt128Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == 1
+	return v > o
 }
 
-func (v UInt128Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -8220,63 +9913,32 @@ func (v UInt128Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp >= 0
+	return v >= o
 }
 
-func (v UInt128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt, ok := other.(UInt128Value)
+func (v Word8Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherWord8, ok := other.(Word8Value)
 	if !ok {
 		return false
 	}
-	cmp := v.BigInt.Cmp(otherInt.BigInt)
-	return cmp == 0
+	return v == otherWord8
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUInt128 (1 byte)
-// - big int encoded in big endian (n bytes)
-func (v UInt128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
-
-	length := 1 + len(b)
-	var buffer []byte
-	if length <= len(scratch) {
-		buffer = scratch[:length]
-	} else {
-		buffer = make([]byte, length)
-	}
-
-	buffer[0] = byte(HashInputTypeUInt128)
-	copy(buffer[1:], b)
-	return buffer
+// - HashInputTypeWord8 (1 byte)
+// - uint8 value (1 byte)
+func (v Word8Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeWord8)
+	scratch[1] = byte(v)
+	return scratch[:2]
 }
 
-func ConvertUInt128(value Value) UInt128Value {
-	var v *big.Int
-
-	switch value := value.(type) {
-	case BigNumberValue:
-		v = value.ToBigInt()
-
-	case NumberValue:
-		v = big.NewInt(int64(value.ToInt()))
-
-	default:
-		panic(errors.NewUnreachableError())
-	}
-
-	if v.Cmp(sema.UInt128TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	} else if v.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-
-	return NewUInt128ValueFromBigInt(v)
+func ConvertWord8(value Value) Word8Value {
+	return Word8Value(ConvertUInt8(value))
 }
 
-func (v UInt128Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -8285,13 +9947,11 @@ func (v UInt128Value) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Or(v.BigInt, o.BigInt)
-	return UInt128Value{res}
+	return v | o
 }
 
-func (v UInt128Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -8299,14 +9959,11 @@ func (v UInt128Value) BitwiseXor(other IntegerValue) IntegerValue {
 			RightType: other.StaticType(),
 		})
 	}
-
-	res := new(big.Int)
-	res.Xor(v.BigInt, o.BigInt)
-	return UInt128Value{res}
+	return v ^ o
 }
 
-func (v UInt128Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -8315,13 +9972,11 @@ func (v UInt128Value) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.And(v.BigInt, o.BigInt)
-	return UInt128Value{res}
+	return v & o
 }
 
-func (v UInt128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -8330,19 +9985,11 @@ func (v UInt128Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return UInt128Value{res}
+	return v << o
 }
 
-func (v UInt128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt128Value)
+func (v Word8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word8Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -8351,62 +9998,54 @@ func (v UInt128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return UInt128Value{res}
+	return v >> o
 }
 
-func (v UInt128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UInt128Type)
+func (v Word8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Word8Type)
 }
 
-func (UInt128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Word8Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UInt128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt128Value) ToBigEndianBytes() []byte {
-	return UnsignedBigIntToBigEndianBytes(v.BigInt)
+func (v Word8Value) ToBigEndianBytes() []byte {
+	return []byte{byte(v)}
 }
 
-func (v UInt128Value) ConformsToDynamicType(
+func (v Word8Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UInt128Type.Equal(numberType.StaticType)
+	return ok && sema.Word8Type.Equal(numberType.StaticType)
 }
 
-func (UInt128Value) IsStorable() bool {
+func (Word8Value) IsStorable() bool {
 	return true
 }
 
-func (v UInt128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word8Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UInt128Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word8Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UInt128Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word8Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UInt128Value) Transfer(
+func (v Word8Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -8419,94 +10058,75 @@ func (v UInt128Value) Transfer(
 	return v
 }
 
-func (v UInt128Value) Clone(_ *Interpreter) Value {
-	return NewUInt128ValueFromBigInt(v.BigInt)
+func (v Word8Value) Clone(_ *Interpreter) Value {
+	return v
 }
 
-func (UInt128Value) DeepRemove(_ *Interpreter) {
+func (Word8Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UInt128Value) ByteSize() uint32 {
-	return cborTagSize + getBigIntCBORSize(v.BigInt)
+func (v Word8Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v UInt128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word8Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UInt128Value) ChildStorables() []atree.Storable {
+func (Word8Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UInt256Value
-
-type UInt256Value struct {
-	BigInt *big.Int
-}
-
-func NewUInt256ValueFromUint64(value uint64) UInt256Value {
-	return NewUInt256ValueFromBigInt(new(big.Int).SetUint64(value))
-}
+// Word16Value
 
-func NewUInt256ValueFromBigInt(value *big.Int) UInt256Value {
-	return UInt256Value{BigInt: value}
-}
+type Word16Value uint16
 
-var _ Value = UInt256Value{}
-var _ atree.Storable = UInt256Value{}
-var _ NumberValue = UInt256Value{}
-var _ IntegerValue = UInt256Value{}
-var _ EquatableValue = UInt256Value{}
-var _ HashableValue = UInt256Value{}
-var _ MemberAccessibleValue = UInt256Value{}
+var _ Value = Word16Value(0)
+var _ atree.Storable = Word16Value(0)
+var _ NumberValue = Word16Value(0)
+var _ IntegerValue = Word16Value(0)
+var _ EquatableValue = Word16Value(0)
+var _ HashableValue = Word16Value(0)
+var _ MemberAccessibleValue = Word16Value(0)
 
-func (UInt256Value) IsValue() {}
+func (Word16Value) IsValue() {}
 
-func (v UInt256Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUInt256Value(interpreter, v)
+func (v Word16Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord16Value(interpreter, v)
 }
 
-func (UInt256Value) Walk(_ func(Value)) {
+func (Word16Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var uint256DynamicType DynamicType = NumberDynamicType{sema.UInt256Type}
-
-func (UInt256Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return uint256DynamicType
-}
-
-func (UInt256Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUInt256
-}
-
-func (v UInt256Value) ToInt() int {
-	if !v.BigInt.IsInt64() {
-		panic(OverflowError{})
-	}
+var word16DynamicType DynamicType = NumberDynamicType{sema.Word16Type}
 
-	return int(v.BigInt.Int64())
+func (Word16Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return word16DynamicType
 }
 
-func (v UInt256Value) ToBigInt() *big.Int {
-	return new(big.Int).Set(v.BigInt)
+func (Word16Value) StaticType() StaticType {
+	return PrimitiveStaticTypeWord16
 }
 
-func (v UInt256Value) String() string {
-	return format.BigInt(v.BigInt)
+func (v Word16Value) String() string {
+	return format.Uint(uint64(v))
 }
 
-func (v UInt256Value) RecursiveString(_ SeenReferences) string {
+func (v Word16Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v UInt256Value) Negate() NumberValue {
+func (v Word16Value) ToInt() int {
+	return int(v)
+}
+func (v Word16Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt256Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -8515,54 +10135,15 @@ func (v UInt256Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	sum := new(big.Int)
-	sum.Add(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native uint256 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//  if sum < v {
-	//      ...
-	//  }
-	//
-	if sum.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	}
-	return UInt256Value{sum}
+	return v + o
 }
 
-func (v UInt256Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
-
-	sum := new(big.Int)
-	sum.Add(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just add and check the range of the result.
-	//
-	// If Go gains a native uint256 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//  if sum < v {
-	//      ...
-	//  }
-	//
-	if sum.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
-		return UInt256Value{sema.UInt256TypeMaxIntBig}
-	}
-	return UInt256Value{sum}
+func (v Word16Value) SaturatingPlus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UInt256Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -8571,54 +10152,15 @@ func (v UInt256Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := new(big.Int)
-	diff.Sub(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native uint256 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//   if diff > v {
-	// 	     ...
-	//   }
-	//
-	if diff.Cmp(sema.UInt256TypeMinIntBig) < 0 {
-		panic(UnderflowError{})
-	}
-	return UInt256Value{diff}
+	return v - o
 }
 
-func (v UInt256Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
-
-	diff := new(big.Int)
-	diff.Sub(v.BigInt, o.BigInt)
-	// Given that this value is backed by an arbitrary size integer,
-	// we can just subtract and check the range of the result.
-	//
-	// If Go gains a native uint256 type and we switch this value
-	// to be based on it, then we need to follow INT30-C:
-	//
-	//   if diff > v {
-	// 	     ...
-	//   }
-	//
-	if diff.Cmp(sema.UInt256TypeMinIntBig) < 0 {
-		return UInt256Value{sema.UInt256TypeMinIntBig}
-	}
-	return UInt256Value{diff}
+func (v Word16Value) SaturatingMinus(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UInt256Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -8627,16 +10169,14 @@ func (v UInt256Value) Mod(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Cmp(res) == 0 {
+	if o == 0 {
 		panic(DivisionByZeroError{})
 	}
-	res.Rem(v.BigInt, o.BigInt)
-	return UInt256Value{res}
+	return v % o
 }
 
-func (v UInt256Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -8645,67 +10185,35 @@ func (v UInt256Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	}
-	return UInt256Value{res}
+	return v * o
 }
 
-func (v UInt256Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-			LeftType:     v.StaticType(),
-			RightType:    other.StaticType(),
-		})
-	}
-
-	res := new(big.Int)
-	res.Mul(v.BigInt, o.BigInt)
-	if res.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
-		return UInt256Value{sema.UInt256TypeMaxIntBig}
-	}
-	return UInt256Value{res}
+func (v Word16Value) SaturatingMul(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
 }
 
-func (v UInt256Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
-		})
-	}
-
-	res := new(big.Int)
-	if o.BigInt.Cmp(res) == 0 {
-		panic(DivisionByZeroError{})
-	}
-	res.Div(v.BigInt, o.BigInt)
-	return UInt256Value{res}
-}
-
-func (v UInt256Value) SaturatingDiv(other NumberValue) NumberValue {
-	defer func() {
-		r := recover()
-		if _, ok := r.(InvalidOperandsError); ok {
-			panic(InvalidOperandsError{
-				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-				LeftType:     v.StaticType(),
-				RightType:    other.StaticType(),
-			})
-		}
-	}()
+		})
+	}
 
-	return v.Div(other)
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	}
+	return v / o
 }
 
-func (v UInt256Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) SaturatingDiv(_ NumberValue) NumberValue {
+	panic(errors.UnreachableError{})
+}
+
+func (v Word16Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -8714,12 +10222,11 @@ func (v UInt256Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == -1
+	return v < o
 }
 
-func (v UInt256Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -8728,12 +10235,11 @@ func (v UInt256Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp <= 0
+	return v <= o
 }
 
-func (v UInt256Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -8742,12 +10248,11 @@ func (v UInt256Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp == 1
+	return v > o
 }
 
-func (v UInt256Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -8756,63 +10261,32 @@ func (v UInt256Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	cmp := v.BigInt.Cmp(o.BigInt)
-	return cmp >= 0
+	return v >= o
 }
 
-func (v UInt256Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherInt, ok := other.(UInt256Value)
+func (v Word16Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherWord16, ok := other.(Word16Value)
 	if !ok {
 		return false
 	}
-	cmp := v.BigInt.Cmp(otherInt.BigInt)
-	return cmp == 0
+	return v == otherWord16
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUInt256 (1 byte)
-// - big int encoded in big endian (n bytes)
-func (v UInt256Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
-
-	length := 1 + len(b)
-	var buffer []byte
-	if length <= len(scratch) {
-		buffer = scratch[:length]
-	} else {
-		buffer = make([]byte, length)
-	}
-
-	buffer[0] = byte(HashInputTypeUInt256)
-	copy(buffer[1:], b)
-	return buffer
+// - HashInputTypeWord16 (1 byte)
+// - uint16 value encoded in big-endian (2 bytes)
+func (v Word16Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeWord16)
+	binary.BigEndian.PutUint16(scratch[1:], uint16(v))
+	return scratch[:3]
 }
 
-func ConvertUInt256(value Value) UInt256Value {
-	var v *big.Int
-
-	switch value := value.(type) {
-	case BigNumberValue:
-		v = value.ToBigInt()
-
-	case NumberValue:
-		v = big.NewInt(int64(value.ToInt()))
-
-	default:
-		panic(errors.NewUnreachableError())
-	}
-
-	if v.Cmp(sema.UInt256TypeMaxIntBig) > 0 {
-		panic(OverflowError{})
-	} else if v.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-
-	return NewUInt256ValueFromBigInt(v)
+func ConvertWord16(value Value) Word16Value {
+	return Word16Value(ConvertUInt16(value))
 }
 
-func (v UInt256Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -8821,13 +10295,11 @@ func (v UInt256Value) BitwiseOr(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.Or(v.BigInt, o.BigInt)
-	return UInt256Value{res}
+	return v | o
 }
 
-func (v UInt256Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -8835,14 +10307,11 @@ func (v UInt256Value) BitwiseXor(other IntegerValue) IntegerValue {
 			RightType: other.StaticType(),
 		})
 	}
-
-	res := new(big.Int)
-	res.Xor(v.BigInt, o.BigInt)
-	return UInt256Value{res}
+	return v ^ o
 }
 
-func (v UInt256Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -8851,13 +10320,11 @@ func (v UInt256Value) BitwiseAnd(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	res.And(v.BigInt, o.BigInt)
-	return UInt256Value{res}
+	return v & o
 }
 
-func (v UInt256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -8866,19 +10333,11 @@ func (v UInt256Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return UInt256Value{res}
+	return v << o
 }
 
-func (v UInt256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(UInt256Value)
+func (v Word16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word16Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -8887,61 +10346,56 @@ func (v UInt256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 		})
 	}
 
-	res := new(big.Int)
-	if o.BigInt.Sign() < 0 {
-		panic(UnderflowError{})
-	}
-	if !o.BigInt.IsUint64() {
-		panic(OverflowError{})
-	}
-	res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
-	return UInt256Value{res}
+	return v >> o
 }
 
-func (v UInt256Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UInt256Type)
+func (v Word16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Word16Type)
 }
 
-func (UInt256Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Word16Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UInt256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UInt256Value) ToBigEndianBytes() []byte {
-	return UnsignedBigIntToBigEndianBytes(v.BigInt)
+func (v Word16Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(v))
+	return b
 }
 
-func (v UInt256Value) ConformsToDynamicType(
+func (v Word16Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UInt256Type.Equal(numberType.StaticType)
+	return ok && sema.Word16Type.Equal(numberType.StaticType)
 }
 
-func (UInt256Value) IsStorable() bool {
+func (Word16Value) IsStorable() bool {
 	return true
 }
 
-func (v UInt256Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word16Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UInt256Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word16Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UInt256Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word16Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
-func (v UInt256Value) Transfer(
+
+func (v Word16Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -8954,76 +10408,76 @@ func (v UInt256Value) Transfer(
 	return v
 }
 
-func (v UInt256Value) Clone(_ *Interpreter) Value {
-	return NewUInt256ValueFromBigInt(v.BigInt)
+func (v Word16Value) Clone(_ *Interpreter) Value {
+	return v
 }
 
-func (UInt256Value) DeepRemove(_ *Interpreter) {
+func (Word16Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UInt256Value) ByteSize() uint32 {
-	return cborTagSize + getBigIntCBORSize(v.BigInt)
+func (v Word16Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v UInt256Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word16Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UInt256Value) ChildStorables() []atree.Storable {
+func (Word16Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Word8Value
+// Word32Value
 
-type Word8Value uint8
+type Word32Value uint32
 
-var _ Value = Word8Value(0)
-var _ atree.Storable = Word8Value(0)
-var _ NumberValue = Word8Value(0)
-var _ IntegerValue = Word8Value(0)
-var _ EquatableValue = Word8Value(0)
-var _ HashableValue = Word8Value(0)
-var _ MemberAccessibleValue = Word8Value(0)
+var _ Value = Word32Value(0)
+var _ atree.Storable = Word32Value(0)
+var _ NumberValue = Word32Value(0)
+var _ IntegerValue = Word32Value(0)
+var _ EquatableValue = Word32Value(0)
+var _ HashableValue = Word32Value(0)
+var _ MemberAccessibleValue = Word32Value(0)
 
-func (Word8Value) IsValue() {}
+func (Word32Value) IsValue() {}
 
-func (v Word8Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitWord8Value(interpreter, v)
+func (v Word32Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord32Value(interpreter, v)
 }
 
-func (Word8Value) Walk(_ func(Value)) {
+func (Word32Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var word8DynamicType DynamicType = NumberDynamicType{sema.Word8Type}
+var word32DynamicType DynamicType = NumberDynamicType{sema.Word32Type}
 
-func (Word8Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return word8DynamicType
+func (Word32Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return word32DynamicType
 }
 
-func (Word8Value) StaticType() StaticType {
-	return PrimitiveStaticTypeWord8
+func (Word32Value) StaticType() StaticType {
+	return PrimitiveStaticTypeWord32
 }
 
-func (v Word8Value) String() string {
+func (v Word32Value) String() string {
 	return format.Uint(uint64(v))
 }
 
-func (v Word8Value) RecursiveString(_ SeenReferences) string {
+func (v Word32Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Word8Value) ToInt() int {
+func (v Word32Value) ToInt() int {
 	return int(v)
 }
 
-func (v Word8Value) Negate() NumberValue {
+func (v Word32Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v Word8Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -9035,12 +10489,12 @@ func (v Word8Value) Plus(other NumberValue) NumberValue {
 	return v + o
 }
 
-func (v Word8Value) SaturatingPlus(_ NumberValue) NumberValue {
+func (v Word32Value) SaturatingPlus(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word8Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -9052,12 +10506,12 @@ func (v Word8Value) Minus(other NumberValue) NumberValue {
 	return v - o
 }
 
-func (v Word8Value) SaturatingMinus(_ NumberValue) NumberValue {
+func (v Word32Value) SaturatingMinus(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word8Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -9072,8 +10526,8 @@ func (v Word8Value) Mod(other NumberValue) NumberValue {
 	return v % o
 }
 
-func (v Word8Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -9085,12 +10539,12 @@ func (v Word8Value) Mul(other NumberValue) NumberValue {
 	return v * o
 }
 
-func (v Word8Value) SaturatingMul(_ NumberValue) NumberValue {
+func (v Word32Value) SaturatingMul(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word8Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -9105,12 +10559,12 @@ func (v Word8Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
-func (v Word8Value) SaturatingDiv(_ NumberValue) NumberValue {
+func (v Word32Value) SaturatingDiv(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word8Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -9122,8 +10576,8 @@ func (v Word8Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v Word8Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -9135,8 +10589,8 @@ func (v Word8Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v Word8Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -9148,8 +10602,8 @@ func (v Word8Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v Word8Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -9161,29 +10615,29 @@ func (v Word8Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v Word8Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherWord8, ok := other.(Word8Value)
+func (v Word32Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherWord32, ok := other.(Word32Value)
 	if !ok {
 		return false
 	}
-	return v == otherWord8
+	return v == otherWord32
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeWord8 (1 byte)
-// - uint8 value (1 byte)
-func (v Word8Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeWord8)
-	scratch[1] = byte(v)
-	return scratch[:2]
+// - HashInputTypeWord32 (1 byte)
+// - uint32 value encoded in big-endian (4 bytes)
+func (v Word32Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeWord32)
+	binary.BigEndian.PutUint32(scratch[1:], uint32(v))
+	return scratch[:5]
 }
 
-func ConvertWord8(value Value) Word8Value {
-	return Word8Value(ConvertUInt8(value))
+func ConvertWord32(value Value) Word32Value {
+	return Word32Value(ConvertUInt32(value))
 }
 
-func (v Word8Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -9195,8 +10649,8 @@ func (v Word8Value) BitwiseOr(other IntegerValue) IntegerValue {
 	return v | o
 }
 
-func (v Word8Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -9207,8 +10661,8 @@ func (v Word8Value) BitwiseXor(other IntegerValue) IntegerValue {
 	return v ^ o
 }
 
-func (v Word8Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -9220,8 +10674,8 @@ func (v Word8Value) BitwiseAnd(other IntegerValue) IntegerValue {
 	return v & o
 }
 
-func (v Word8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -9233,8 +10687,8 @@ func (v Word8Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 	return v << o
 }
 
-func (v Word8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word8Value)
+func (v Word32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word32Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -9246,51 +10700,53 @@ func (v Word8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Word8Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Word8Type)
+func (v Word32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Word32Type)
 }
 
-func (Word8Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Word32Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Word8Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Word8Value) ToBigEndianBytes() []byte {
-	return []byte{byte(v)}
+func (v Word32Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(v))
+	return b
 }
 
-func (v Word8Value) ConformsToDynamicType(
+func (v Word32Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Word8Type.Equal(numberType.StaticType)
+	return ok && sema.Word32Type.Equal(numberType.StaticType)
 }
 
-func (Word8Value) IsStorable() bool {
+func (Word32Value) IsStorable() bool {
 	return true
 }
 
-func (v Word8Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word32Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Word8Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word32Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Word8Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word32Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Word8Value) Transfer(
+func (v Word32Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -9303,75 +10759,97 @@ func (v Word8Value) Transfer(
 	return v
 }
 
-func (v Word8Value) Clone(_ *Interpreter) Value {
+func (v Word32Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (Word8Value) DeepRemove(_ *Interpreter) {
+func (Word32Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Word8Value) ByteSize() uint32 {
+func (v Word32Value) ByteSize() uint32 {
 	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v Word8Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word32Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Word8Value) ChildStorables() []atree.Storable {
+func (Word32Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Word16Value
+// Word64Value
 
-type Word16Value uint16
+type Word64Value uint64
 
-var _ Value = Word16Value(0)
-var _ atree.Storable = Word16Value(0)
-var _ NumberValue = Word16Value(0)
-var _ IntegerValue = Word16Value(0)
-var _ EquatableValue = Word16Value(0)
-var _ HashableValue = Word16Value(0)
-var _ MemberAccessibleValue = Word16Value(0)
+var _ Value = Word64Value(0)
+var _ atree.Storable = Word64Value(0)
+var _ NumberValue = Word64Value(0)
+var _ IntegerValue = Word64Value(0)
+var _ EquatableValue = Word64Value(0)
+var _ HashableValue = Word64Value(0)
+var _ MemberAccessibleValue = Word64Value(0)
 
-func (Word16Value) IsValue() {}
+// NOTE: important, do *NOT* remove:
+// Word64 values > math.MaxInt64 overflow int.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+var _ BigNumberValue = Word64Value(0)
 
-func (v Word16Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitWord16Value(interpreter, v)
+func (Word64Value) IsValue() {}
+
+func (v Word64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord64Value(interpreter, v)
 }
 
-func (Word16Value) Walk(_ func(Value)) {
+func (Word64Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var word16DynamicType DynamicType = NumberDynamicType{sema.Word16Type}
+var word64DynamicType DynamicType = NumberDynamicType{sema.Word64Type}
 
-func (Word16Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return word16DynamicType
+func (Word64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return word64DynamicType
 }
 
-func (Word16Value) StaticType() StaticType {
-	return PrimitiveStaticTypeWord16
+func (Word64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeWord64
 }
 
-func (v Word16Value) String() string {
+func (v Word64Value) String() string {
 	return format.Uint(uint64(v))
 }
 
-func (v Word16Value) RecursiveString(_ SeenReferences) string {
+func (v Word64Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Word16Value) ToInt() int {
+func (v Word64Value) ToInt() int {
+	if v > math.MaxInt64 {
+		panic(OverflowError{})
+	}
 	return int(v)
 }
-func (v Word16Value) Negate() NumberValue {
+
+// ToBigInt
+//
+// NOTE: important, do *NOT* remove:
+// Word64 values > math.MaxInt64 overflow int.
+// Implementing BigNumberValue ensures conversion functions
+// call ToBigInt instead of ToInt.
+//
+func (v Word64Value) ToBigInt() *big.Int {
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func (v Word64Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v Word16Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -9383,12 +10861,12 @@ func (v Word16Value) Plus(other NumberValue) NumberValue {
 	return v + o
 }
 
-func (v Word16Value) SaturatingPlus(_ NumberValue) NumberValue {
+func (v Word64Value) SaturatingPlus(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word16Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -9400,12 +10878,12 @@ func (v Word16Value) Minus(other NumberValue) NumberValue {
 	return v - o
 }
 
-func (v Word16Value) SaturatingMinus(_ NumberValue) NumberValue {
+func (v Word64Value) SaturatingMinus(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word16Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -9420,8 +10898,8 @@ func (v Word16Value) Mod(other NumberValue) NumberValue {
 	return v % o
 }
 
-func (v Word16Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -9433,12 +10911,12 @@ func (v Word16Value) Mul(other NumberValue) NumberValue {
 	return v * o
 }
 
-func (v Word16Value) SaturatingMul(_ NumberValue) NumberValue {
+func (v Word64Value) SaturatingMul(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word16Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -9453,12 +10931,12 @@ func (v Word16Value) Div(other NumberValue) NumberValue {
 	return v / o
 }
 
-func (v Word16Value) SaturatingDiv(_ NumberValue) NumberValue {
+func (v Word64Value) SaturatingDiv(_ NumberValue) NumberValue {
 	panic(errors.UnreachableError{})
 }
 
-func (v Word16Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -9470,8 +10948,8 @@ func (v Word16Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v Word16Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -9483,8 +10961,8 @@ func (v Word16Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v Word16Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -9496,8 +10974,8 @@ func (v Word16Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v Word16Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -9509,29 +10987,29 @@ func (v Word16Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v Word16Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherWord16, ok := other.(Word16Value)
+func (v Word64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherWord64, ok := other.(Word64Value)
 	if !ok {
 		return false
 	}
-	return v == otherWord16
+	return v == otherWord64
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeWord16 (1 byte)
-// - uint16 value encoded in big-endian (2 bytes)
-func (v Word16Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeWord16)
-	binary.BigEndian.PutUint16(scratch[1:], uint16(v))
-	return scratch[:3]
+// - HashInputTypeWord64 (1 byte)
+// - uint64 value encoded in big-endian (8 bytes)
+func (v Word64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeWord64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
 }
 
-func ConvertWord16(value Value) Word16Value {
-	return Word16Value(ConvertUInt16(value))
+func ConvertWord64(value Value) Word64Value {
+	return Word64Value(ConvertUInt64(value))
 }
 
-func (v Word16Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) BitwiseOr(other IntegerValue) IntegerValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseOr,
@@ -9543,8 +11021,8 @@ func (v Word16Value) BitwiseOr(other IntegerValue) IntegerValue {
 	return v | o
 }
 
-func (v Word16Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) BitwiseXor(other IntegerValue) IntegerValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseXor,
@@ -9555,8 +11033,8 @@ func (v Word16Value) BitwiseXor(other IntegerValue) IntegerValue {
 	return v ^ o
 }
 
-func (v Word16Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) BitwiseAnd(other IntegerValue) IntegerValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseAnd,
@@ -9568,8 +11046,8 @@ func (v Word16Value) BitwiseAnd(other IntegerValue) IntegerValue {
 	return v & o
 }
 
-func (v Word16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseLeftShift,
@@ -9581,8 +11059,8 @@ func (v Word16Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
 	return v << o
 }
 
-func (v Word16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word16Value)
+func (v Word64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
+	o, ok := other.(Word64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationBitwiseRightShift,
@@ -9594,53 +11072,53 @@ func (v Word16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Word16Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Word16Type)
+func (v Word64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Word64Type)
 }
 
-func (Word16Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Word64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Word16Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Word16Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 2)
-	binary.BigEndian.PutUint16(b, uint16(v))
+func (v Word64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
 	return b
 }
 
-func (v Word16Value) ConformsToDynamicType(
+func (v Word64Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Word16Type.Equal(numberType.StaticType)
+	return ok && sema.Word64Type.Equal(numberType.StaticType)
 }
 
-func (Word16Value) IsStorable() bool {
+func (Word64Value) IsStorable() bool {
 	return true
 }
 
-func (v Word16Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Word16Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word64Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Word16Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word64Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Word16Value) Transfer(
+func (v Word64Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -9653,76 +11131,94 @@ func (v Word16Value) Transfer(
 	return v
 }
 
-func (v Word16Value) Clone(_ *Interpreter) Value {
+func (v Word64Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (Word16Value) DeepRemove(_ *Interpreter) {
-	// NO-OP
+func (v Word64Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v Word16Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (Word64Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
 }
 
-func (v Word16Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Word16Value) ChildStorables() []atree.Storable {
+func (Word64Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Word32Value
+// Fix64Value
+//
+type Fix64Value int64
 
-type Word32Value uint32
+const Fix64MaxValue = math.MaxInt64
 
-var _ Value = Word32Value(0)
-var _ atree.Storable = Word32Value(0)
-var _ NumberValue = Word32Value(0)
-var _ IntegerValue = Word32Value(0)
-var _ EquatableValue = Word32Value(0)
-var _ HashableValue = Word32Value(0)
-var _ MemberAccessibleValue = Word32Value(0)
+func NewFix64ValueWithInteger(integer int64) Fix64Value {
 
-func (Word32Value) IsValue() {}
+	if integer < sema.Fix64TypeMinInt {
+		panic(UnderflowError{})
+	}
 
-func (v Word32Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitWord32Value(interpreter, v)
+	if integer > sema.Fix64TypeMaxInt {
+		panic(OverflowError{})
+	}
+
+	return Fix64Value(integer * sema.Fix64Factor)
 }
 
-func (Word32Value) Walk(_ func(Value)) {
+var _ Value = Fix64Value(0)
+var _ atree.Storable = Fix64Value(0)
+var _ NumberValue = Fix64Value(0)
+var _ EquatableValue = Fix64Value(0)
+var _ HashableValue = Fix64Value(0)
+var _ MemberAccessibleValue = Fix64Value(0)
+
+func (Fix64Value) IsValue() {}
+
+func (v Fix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix64Value(interpreter, v)
+}
+
+func (Fix64Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var word32DynamicType DynamicType = NumberDynamicType{sema.Word32Type}
+var fix64DynamicType DynamicType = NumberDynamicType{sema.Fix64Type}
 
-func (Word32Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return word32DynamicType
+func (Fix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return fix64DynamicType
 }
 
-func (Word32Value) StaticType() StaticType {
-	return PrimitiveStaticTypeWord32
+func (Fix64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeFix64
 }
 
-func (v Word32Value) String() string {
-	return format.Uint(uint64(v))
+func (v Fix64Value) String() string {
+	return format.Fix64(int64(v))
 }
 
-func (v Word32Value) RecursiveString(_ SeenReferences) string {
+func (v Fix64Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Word32Value) ToInt() int {
-	return int(v)
+func (v Fix64Value) ToInt() int {
+	return int(v / sema.Fix64Factor)
 }
 
-func (v Word32Value) Negate() NumberValue {
-	panic(errors.NewUnreachableError())
+func (v Fix64Value) Negate() NumberValue {
+	// INT32-C
+	if v == math.MinInt64 {
+		panic(OverflowError{})
+	}
+	return -v
 }
 
-func (v Word32Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -9731,15 +11227,30 @@ func (v Word32Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return v + o
+	return Fix64Value(safeAddInt64(int64(v), int64(o)))
 }
 
-func (v Word32Value) SaturatingPlus(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+func (v Fix64Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v > (math.MaxInt64 - o)) {
+		return Fix64Value(math.MaxInt64)
+	} else if (o < 0) && (v < (math.MinInt64 - o)) {
+		return Fix64Value(math.MinInt64)
+	}
+	return v + o
 }
 
-func (v Word32Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -9748,68 +11259,162 @@ func (v Word32Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt64 + o)) {
+		panic(OverflowError{})
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+		panic(UnderflowError{})
+	}
 	return v - o
 }
 
-func (v Word32Value) SaturatingMinus(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+func (v Fix64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	// INT32-C
+	if (o > 0) && (v < (math.MinInt64 + o)) {
+		return Fix64Value(math.MinInt64)
+	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+		return Fix64Value(math.MaxInt64)
+	}
+	return v - o
 }
 
-func (v Word32Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Word32Value)
+var minInt64Big = big.NewInt(math.MinInt64)
+var maxInt64Big = big.NewInt(math.MaxInt64)
+
+func (v Fix64Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
+			Operation: ast.OperationMul,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	if o == 0 {
-		panic(DivisionByZeroError{})
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if result.Cmp(minInt64Big) < 0 {
+		panic(UnderflowError{})
+	} else if result.Cmp(maxInt64Big) > 0 {
+		panic(OverflowError{})
 	}
-	return v % o
+
+	return Fix64Value(result.Int64())
 }
 
-func (v Word32Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMul,
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if result.Cmp(minInt64Big) < 0 {
+		return Fix64Value(math.MinInt64)
+	} else if result.Cmp(maxInt64Big) > 0 {
+		return Fix64Value(math.MaxInt64)
+	}
+
+	return Fix64Value(result.Int64())
+}
+
+func (v Fix64Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	return v * o
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	if result.Cmp(minInt64Big) < 0 {
+		panic(UnderflowError{})
+	} else if result.Cmp(maxInt64Big) > 0 {
+		panic(OverflowError{})
+	}
+
+	return Fix64Value(result.Int64())
 }
 
-func (v Word32Value) SaturatingMul(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+func (v Fix64Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	if result.Cmp(minInt64Big) < 0 {
+		return Fix64Value(math.MinInt64)
+	} else if result.Cmp(maxInt64Big) > 0 {
+		return Fix64Value(math.MaxInt64)
+	}
+
+	return Fix64Value(result.Int64())
 }
 
-func (v Word32Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationDiv,
+			Operation: ast.OperationMod,
 			LeftType:  v.StaticType(),
 			RightType: other.StaticType(),
 		})
 	}
 
-	if o == 0 {
-		panic(DivisionByZeroError{})
+	// v - int(v/o) * o
+	quotient, ok := v.Div(o).(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
 	}
-	return v / o
-}
-
-func (v Word32Value) SaturatingDiv(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+	truncatedQuotient := (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+	return v.Minus(Fix64Value(truncatedQuotient).Mul(o))
 }
 
-func (v Word32Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -9821,8 +11426,8 @@ func (v Word32Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v Word32Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -9834,8 +11439,8 @@ func (v Word32Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v Word32Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -9847,8 +11452,8 @@ func (v Word32Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v Word32Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word32Value)
+func (v Fix64Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -9860,138 +11465,121 @@ func (v Word32Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v Word32Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherWord32, ok := other.(Word32Value)
+func (v Fix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFix64, ok := other.(Fix64Value)
 	if !ok {
 		return false
 	}
-	return v == otherWord32
+	return v == otherFix64
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeWord32 (1 byte)
-// - uint32 value encoded in big-endian (4 bytes)
-func (v Word32Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeWord32)
-	binary.BigEndian.PutUint32(scratch[1:], uint32(v))
-	return scratch[:5]
+// - HashInputTypeFix64 (1 byte)
+// - int64 value encoded in big-endian (8 bytes)
+func (v Fix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeFix64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
 }
 
-func ConvertWord32(value Value) Word32Value {
-	return Word32Value(ConvertUInt32(value))
-}
+func ConvertFix64(value Value) Fix64Value {
+	switch value := value.(type) {
+	case Fix64Value:
+		return value
 
-func (v Word32Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Word32Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseOr,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+	case UFix64Value:
+		if value > Fix64MaxValue {
+			panic(OverflowError{})
+		}
+		return Fix64Value(value)
 
-	return v | o
-}
+	case Fix128Value:
+		v := new(big.Int).Quo(value.BigInt, fix128ScaleFactorBig)
+		if v.Cmp(minInt64Big) < 0 {
+			panic(UnderflowError{})
+		} else if v.Cmp(maxInt64Big) > 0 {
+			panic(OverflowError{})
+		}
+		return Fix64Value(v.Int64())
 
-func (v Word32Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Word32Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseXor,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
-	return v ^ o
-}
+	case UFix128Value:
+		v := new(big.Int).Quo(value.BigInt, fix128ScaleFactorBig)
+		if v.Cmp(maxInt64Big) > 0 {
+			panic(OverflowError{})
+		}
+		return Fix64Value(v.Int64())
 
-func (v Word32Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Word32Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseAnd,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+	case BigNumberValue:
+		v := value.ToBigInt()
 
-	return v & o
-}
+		// First, check if the value is at least in the int64 range.
+		// The integer range for Fix64 is smaller, but this test at least
+		// allows us to call `v.Int64()` safely.
 
-func (v Word32Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word32Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseLeftShift,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+		if !v.IsInt64() {
+			panic(OverflowError{})
+		}
 
-	return v << o
-}
+		// Now check that the integer value fits the range of Fix64
+		return NewFix64ValueWithInteger(v.Int64())
 
-func (v Word32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word32Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseRightShift,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+	case NumberValue:
+		v := value.ToInt()
+		// Check that the integer value fits the range of Fix64
+		return NewFix64ValueWithInteger(int64(v))
 
-	return v >> o
+	default:
+		panic(fmt.Sprintf("can't convert Fix64: %s", value))
+	}
 }
 
-func (v Word32Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Word32Type)
+func (v Fix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Fix64Type)
 }
 
-func (Word32Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Fix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Word32Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Fix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Word32Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, uint32(v))
+func (v Fix64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
 	return b
 }
 
-func (v Word32Value) ConformsToDynamicType(
+func (v Fix64Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Word32Type.Equal(numberType.StaticType)
+	return ok && sema.Fix64Type.Equal(numberType.StaticType)
 }
 
-func (Word32Value) IsStorable() bool {
+func (Fix64Value) IsStorable() bool {
 	return true
 }
 
-func (v Word32Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Fix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Word32Value) NeedsStoreTo(_ atree.Address) bool {
+func (Fix64Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Word32Value) IsResourceKinded(_ *Interpreter) bool {
+func (Fix64Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Word32Value) Transfer(
+func (v Fix64Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -10004,97 +11592,85 @@ func (v Word32Value) Transfer(
 	return v
 }
 
-func (v Word32Value) Clone(_ *Interpreter) Value {
+func (v Fix64Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (Word32Value) DeepRemove(_ *Interpreter) {
+func (Fix64Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Word32Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (v Fix64Value) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
 }
 
-func (v Word32Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Fix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Word32Value) ChildStorables() []atree.Storable {
+func (Fix64Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Word64Value
+// UFix64Value
+//
+type UFix64Value uint64
 
-type Word64Value uint64
+const UFix64MaxValue = math.MaxUint64
 
-var _ Value = Word64Value(0)
-var _ atree.Storable = Word64Value(0)
-var _ NumberValue = Word64Value(0)
-var _ IntegerValue = Word64Value(0)
-var _ EquatableValue = Word64Value(0)
-var _ HashableValue = Word64Value(0)
-var _ MemberAccessibleValue = Word64Value(0)
+func NewUFix64ValueWithInteger(integer uint64) UFix64Value {
+	if integer > sema.UFix64TypeMaxInt {
+		panic(OverflowError{})
+	}
 
-// NOTE: important, do *NOT* remove:
-// Word64 values > math.MaxInt64 overflow int.
-// Implementing BigNumberValue ensures conversion functions
-// call ToBigInt instead of ToInt.
-//
-var _ BigNumberValue = Word64Value(0)
+	return UFix64Value(integer * sema.Fix64Factor)
+}
 
-func (Word64Value) IsValue() {}
+var _ Value = UFix64Value(0)
+var _ atree.Storable = UFix64Value(0)
+var _ NumberValue = UFix64Value(0)
+var _ EquatableValue = UFix64Value(0)
+var _ HashableValue = UFix64Value(0)
+var _ MemberAccessibleValue = UFix64Value(0)
 
-func (v Word64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitWord64Value(interpreter, v)
+func (UFix64Value) IsValue() {}
+
+func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix64Value(interpreter, v)
 }
 
-func (Word64Value) Walk(_ func(Value)) {
+func (UFix64Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var word64DynamicType DynamicType = NumberDynamicType{sema.Word64Type}
+var ufix64DynamicType DynamicType = NumberDynamicType{sema.UFix64Type}
 
-func (Word64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return word64DynamicType
+func (UFix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return ufix64DynamicType
 }
 
-func (Word64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeWord64
+func (UFix64Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUFix64
 }
 
-func (v Word64Value) String() string {
-	return format.Uint(uint64(v))
+func (v UFix64Value) String() string {
+	return format.UFix64(uint64(v))
 }
 
-func (v Word64Value) RecursiveString(_ SeenReferences) string {
+func (v UFix64Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Word64Value) ToInt() int {
-	if v > math.MaxInt64 {
-		panic(OverflowError{})
-	}
-	return int(v)
-}
-
-// ToBigInt
-//
-// NOTE: important, do *NOT* remove:
-// Word64 values > math.MaxInt64 overflow int.
-// Implementing BigNumberValue ensures conversion functions
-// call ToBigInt instead of ToInt.
-//
-func (v Word64Value) ToBigInt() *big.Int {
-	return new(big.Int).SetUint64(uint64(v))
+func (v UFix64Value) ToInt() int {
+	return int(v / sema.Fix64Factor)
 }
 
-func (v Word64Value) Negate() NumberValue {
+func (v UFix64Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v Word64Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -10103,15 +11679,29 @@ func (v Word64Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return v + o
+	return UFix64Value(safeAddUint64(uint64(v), uint64(o)))
 }
 
-func (v Word64Value) SaturatingPlus(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+func (v UFix64Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	sum := v + o
+	// INT30-C
+	if sum < v {
+		return UFix64Value(math.MaxUint64)
+	}
+	return sum
 }
 
-func (v Word64Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -10120,31 +11710,36 @@ func (v Word64Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	return v - o
-}
+	diff := v - o
 
-func (v Word64Value) SaturatingMinus(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+	// INT30-C
+	if diff > v {
+		panic(UnderflowError{})
+	}
+	return diff
 }
 
-func (v Word64Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
 		})
 	}
 
-	if o == 0 {
-		panic(DivisionByZeroError{})
+	diff := v - o
+
+	// INT30-C
+	if diff > v {
+		return UFix64Value(0)
 	}
-	return v % o
+	return diff
 }
 
-func (v Word64Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -10153,15 +11748,44 @@ func (v Word64Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	return v * o
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if !result.IsUint64() {
+		panic(OverflowError{})
+	}
+
+	return UFix64Value(result.Uint64())
 }
 
-func (v Word64Value) SaturatingMul(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+func (v UFix64Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(),
+			RightType:    other.StaticType(),
+		})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, b)
+	result.Div(result, sema.Fix64FactorBig)
+
+	if !result.IsUint64() {
+		return UFix64Value(math.MaxUint64)
+	}
+
+	return UFix64Value(result.Uint64())
 }
 
-func (v Word64Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -10170,18 +11794,55 @@ func (v Word64Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	if o == 0 {
-		panic(DivisionByZeroError{})
-	}
-	return v / o
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+	result.Div(result, b)
+
+	return UFix64Value(result.Uint64())
 }
 
-func (v Word64Value) SaturatingDiv(_ NumberValue) NumberValue {
-	panic(errors.UnreachableError{})
+func (v UFix64Value) SaturatingDiv(other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(),
+				RightType:    other.StaticType(),
+			})
+		}
+	}()
+
+	return v.Div(other)
 }
 
-func (v Word64Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(o).(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(),
+			RightType: other.StaticType(),
+		})
+	}
+	truncatedQuotient := (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+	return v.Minus(UFix64Value(truncatedQuotient).Mul(o))
+}
+
+func (v UFix64Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -10193,8 +11854,8 @@ func (v Word64Value) Less(other NumberValue) BoolValue {
 	return v < o
 }
 
-func (v Word64Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -10206,8 +11867,8 @@ func (v Word64Value) LessEqual(other NumberValue) BoolValue {
 	return v <= o
 }
 
-func (v Word64Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -10219,8 +11880,8 @@ func (v Word64Value) Greater(other NumberValue) BoolValue {
 	return v > o
 }
 
-func (v Word64Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Word64Value)
+func (v UFix64Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -10232,138 +11893,129 @@ func (v Word64Value) GreaterEqual(other NumberValue) BoolValue {
 	return v >= o
 }
 
-func (v Word64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherWord64, ok := other.(Word64Value)
+func (v UFix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUFix64, ok := other.(UFix64Value)
 	if !ok {
 		return false
 	}
-	return v == otherWord64
+	return v == otherUFix64
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeWord64 (1 byte)
+// - HashInputTypeUFix64 (1 byte)
 // - uint64 value encoded in big-endian (8 bytes)
-func (v Word64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeWord64)
+func (v UFix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeUFix64)
 	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
 	return scratch[:9]
 }
 
-func ConvertWord64(value Value) Word64Value {
-	return Word64Value(ConvertUInt64(value))
-}
+func ConvertUFix64(value Value) UFix64Value {
+	switch value := value.(type) {
+	case UFix64Value:
+		return value
 
-func (v Word64Value) BitwiseOr(other IntegerValue) IntegerValue {
-	o, ok := other.(Word64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseOr,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{})
+		}
+		return UFix64Value(value)
 
-	return v | o
-}
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		v := new(big.Int).Quo(value.BigInt, fix128ScaleFactorBig)
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+		return UFix64Value(v.Uint64())
 
-func (v Word64Value) BitwiseXor(other IntegerValue) IntegerValue {
-	o, ok := other.(Word64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseXor,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
-	return v ^ o
-}
+	case UFix128Value:
+		v := new(big.Int).Quo(value.BigInt, fix128ScaleFactorBig)
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+		return UFix64Value(v.Uint64())
 
-func (v Word64Value) BitwiseAnd(other IntegerValue) IntegerValue {
-	o, ok := other.(Word64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseAnd,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+	case BigNumberValue:
+		v := value.ToBigInt()
 
-	return v & o
-}
+		if v.Sign() < 0 {
+			panic(UnderflowError{})
+		}
 
-func (v Word64Value) BitwiseLeftShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseLeftShift,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
-	}
+		// First, check if the value is at least in the uint64 range.
+		// The integer range for UFix64 is smaller, but this test at least
+		// allows us to call `v.UInt64()` safely.
+
+		if !v.IsUint64() {
+			panic(OverflowError{})
+		}
+
+		// Now check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(v.Uint64())
 
-	return v << o
-}
+	case NumberValue:
+		v := value.ToInt()
+		if v < 0 {
+			panic(UnderflowError{})
+		}
+		// Check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(uint64(v))
 
-func (v Word64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
-	o, ok := other.(Word64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationBitwiseRightShift,
-			LeftType:  v.StaticType(),
-			RightType: other.StaticType(),
-		})
+	default:
+		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
 	}
-
-	return v >> o
 }
 
-func (v Word64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Word64Type)
+func (v UFix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UFix64Type)
 }
 
-func (Word64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UFix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Word64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Word64Value) ToBigEndianBytes() []byte {
+func (v UFix64Value) ToBigEndianBytes() []byte {
 	b := make([]byte, 8)
 	binary.BigEndian.PutUint64(b, uint64(v))
 	return b
 }
 
-func (v Word64Value) ConformsToDynamicType(
+func (v UFix64Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Word64Type.Equal(numberType.StaticType)
+	return ok && sema.UFix64Type.Equal(numberType.StaticType)
 }
 
-func (Word64Value) IsStorable() bool {
+func (UFix64Value) IsStorable() bool {
 	return true
 }
 
-func (v Word64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Word64Value) NeedsStoreTo(_ atree.Address) bool {
+func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Word64Value) IsResourceKinded(_ *Interpreter) bool {
+func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Word64Value) Transfer(
+func (v UFix64Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -10376,94 +12028,99 @@ func (v Word64Value) Transfer(
 	return v
 }
 
-func (v Word64Value) Clone(_ *Interpreter) Value {
+func (v UFix64Value) Clone(_ *Interpreter) Value {
 	return v
 }
 
-func (v Word64Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (UFix64Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
 }
 
-func (Word64Value) DeepRemove(_ *Interpreter) {
-	// NO-OP
+func (v UFix64Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
 }
 
-func (v Word64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Word64Value) ChildStorables() []atree.Storable {
+func (UFix64Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// Fix64Value
+// Fix128Value
 //
-type Fix64Value int64
+type Fix128Value struct {
+	BigInt *big.Int
+}
 
-const Fix64MaxValue = math.MaxInt64
+func NewFix128ValueFromBigInt(value *big.Int) Fix128Value {
+	return Fix128Value{BigInt: value}
+}
 
-func NewFix64ValueWithInteger(integer int64) Fix64Value {
+func NewFix128ValueWithInteger(integer *big.Int) Fix128Value {
 
-	if integer < sema.Fix64TypeMinInt {
+	if integer.Cmp(sema.Fix128TypeMinIntBig) < 0 {
 		panic(UnderflowError{})
 	}
 
-	if integer > sema.Fix64TypeMaxInt {
+	if integer.Cmp(sema.Fix128TypeMaxIntBig) > 0 {
 		panic(OverflowError{})
 	}
 
-	return Fix64Value(integer * sema.Fix64Factor)
+	return Fix128Value{new(big.Int).Mul(integer, sema.Fix128FactorBig)}
 }
 
-var _ Value = Fix64Value(0)
-var _ atree.Storable = Fix64Value(0)
-var _ NumberValue = Fix64Value(0)
-var _ EquatableValue = Fix64Value(0)
-var _ HashableValue = Fix64Value(0)
-var _ MemberAccessibleValue = Fix64Value(0)
+var _ Value = Fix128Value{}
+var _ atree.Storable = Fix128Value{}
+var _ NumberValue = Fix128Value{}
+var _ EquatableValue = Fix128Value{}
+var _ HashableValue = Fix128Value{}
+var _ MemberAccessibleValue = Fix128Value{}
 
-func (Fix64Value) IsValue() {}
+func (Fix128Value) IsValue() {}
 
-func (v Fix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitFix64Value(interpreter, v)
+func (v Fix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix128Value(interpreter, v)
 }
 
-func (Fix64Value) Walk(_ func(Value)) {
+func (Fix128Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var fix64DynamicType DynamicType = NumberDynamicType{sema.Fix64Type}
+var fix128DynamicType DynamicType = NumberDynamicType{sema.Fix128Type}
 
-func (Fix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return fix64DynamicType
+func (Fix128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return fix128DynamicType
 }
 
-func (Fix64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeFix64
+func (Fix128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeFix128
 }
 
-func (v Fix64Value) String() string {
-	return format.Fix64(int64(v))
+func (v Fix128Value) String() string {
+	return format.Fix128(v.BigInt)
 }
 
-func (v Fix64Value) RecursiveString(_ SeenReferences) string {
+func (v Fix128Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Fix64Value) ToInt() int {
-	return int(v / sema.Fix64Factor)
+func (v Fix128Value) ToInt() int {
+	// The integer part of a Fix128 always fits into an int64
+	return int(new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig).Int64())
 }
 
-func (v Fix64Value) Negate() NumberValue {
+func (v Fix128Value) Negate() NumberValue {
 	// INT32-C
-	if v == math.MinInt64 {
+	if v.BigInt.Cmp(sema.Fix128TypeMinBig) == 0 {
 		panic(OverflowError{})
 	}
-	return -v
+	return Fix128Value{new(big.Int).Neg(v.BigInt)}
 }
 
-func (v Fix64Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -10472,11 +12129,20 @@ func (v Fix64Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return Fix64Value(safeAddInt64(int64(v), int64(o)))
+	// Given that this value is backed by an arbitrary size integer,
+	// we can just add and check the range of the result.
+
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+		panic(UnderflowError{})
+	} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		panic(OverflowError{})
+	}
+	return Fix128Value{res}
 }
 
-func (v Fix64Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -10485,17 +12151,12 @@ func (v Fix64Value) SaturatingPlus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v > (math.MaxInt64 - o)) {
-		return Fix64Value(math.MaxInt64)
-	} else if (o < 0) && (v < (math.MinInt64 - o)) {
-		return Fix64Value(math.MinInt64)
-	}
-	return v + o
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	return saturateFix128(res)
 }
 
-func (v Fix64Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -10504,17 +12165,17 @@ func (v Fix64Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt64 + o)) {
-		panic(OverflowError{})
-	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	if res.Cmp(sema.Fix128TypeMinBig) < 0 {
 		panic(UnderflowError{})
+	} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		panic(OverflowError{})
 	}
-	return v - o
+	return Fix128Value{res}
 }
 
-func (v Fix64Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -10523,20 +12184,12 @@ func (v Fix64Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	// INT32-C
-	if (o > 0) && (v < (math.MinInt64 + o)) {
-		return Fix64Value(math.MinInt64)
-	} else if (o < 0) && (v > (math.MaxInt64 + o)) {
-		return Fix64Value(math.MaxInt64)
-	}
-	return v - o
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	return saturateFix128(res)
 }
 
-var minInt64Big = big.NewInt(math.MinInt64)
-var maxInt64Big = big.NewInt(math.MaxInt64)
-
-func (v Fix64Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -10545,23 +12198,20 @@ func (v Fix64Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
-
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
 
-	if result.Cmp(minInt64Big) < 0 {
+	if result.Cmp(sema.Fix128TypeMinBig) < 0 {
 		panic(UnderflowError{})
-	} else if result.Cmp(maxInt64Big) > 0 {
+	} else if result.Cmp(sema.Fix128TypeMaxBig) > 0 {
 		panic(OverflowError{})
 	}
 
-	return Fix64Value(result.Int64())
+	return Fix128Value{result}
 }
 
-func (v Fix64Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -10570,23 +12220,14 @@ func (v Fix64Value) SaturatingMul(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
-
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
-
-	if result.Cmp(minInt64Big) < 0 {
-		return Fix64Value(math.MinInt64)
-	} else if result.Cmp(maxInt64Big) > 0 {
-		return Fix64Value(math.MaxInt64)
-	}
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
 
-	return Fix64Value(result.Int64())
+	return saturateFix128(result)
 }
 
-func (v Fix64Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -10595,23 +12236,24 @@ func (v Fix64Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
+	}
 
-	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-	result.Div(result, b)
+	result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	result.Div(result, o.BigInt)
 
-	if result.Cmp(minInt64Big) < 0 {
+	if result.Cmp(sema.Fix128TypeMinBig) < 0 {
 		panic(UnderflowError{})
-	} else if result.Cmp(maxInt64Big) > 0 {
+	} else if result.Cmp(sema.Fix128TypeMaxBig) > 0 {
 		panic(OverflowError{})
 	}
 
-	return Fix64Value(result.Int64())
+	return Fix128Value{result}
 }
 
-func (v Fix64Value) SaturatingDiv(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) SaturatingDiv(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
@@ -10620,23 +12262,18 @@ func (v Fix64Value) SaturatingDiv(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
-
-	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-	result.Div(result, b)
-
-	if result.Cmp(minInt64Big) < 0 {
-		return Fix64Value(math.MinInt64)
-	} else if result.Cmp(maxInt64Big) > 0 {
-		return Fix64Value(math.MaxInt64)
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
 	}
 
-	return Fix64Value(result.Int64())
+	result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	result.Div(result, o.BigInt)
+
+	return saturateFix128(result)
 }
 
-func (v Fix64Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -10646,7 +12283,7 @@ func (v Fix64Value) Mod(other NumberValue) NumberValue {
 	}
 
 	// v - int(v/o) * o
-	quotient, ok := v.Div(o).(Fix64Value)
+	quotient, ok := v.Div(o).(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -10654,12 +12291,13 @@ func (v Fix64Value) Mod(other NumberValue) NumberValue {
 			RightType: other.StaticType(),
 		})
 	}
-	truncatedQuotient := (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
-	return v.Minus(Fix64Value(truncatedQuotient).Mul(o))
+	truncatedQuotient := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+	truncatedQuotient.Mul(truncatedQuotient, sema.Fix128FactorBig)
+	return v.Minus(Fix128Value{truncatedQuotient}.Mul(o))
 }
 
-func (v Fix64Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -10668,11 +12306,11 @@ func (v Fix64Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	return v < o
+	return v.BigInt.Cmp(o.BigInt) < 0
 }
 
-func (v Fix64Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -10681,11 +12319,11 @@ func (v Fix64Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v <= o
+	return v.BigInt.Cmp(o.BigInt) <= 0
 }
 
-func (v Fix64Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -10694,11 +12332,11 @@ func (v Fix64Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	return v > o
+	return v.BigInt.Cmp(o.BigInt) > 0
 }
 
-func (v Fix64Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Fix128Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -10707,108 +12345,128 @@ func (v Fix64Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v >= o
+	return v.BigInt.Cmp(o.BigInt) >= 0
 }
 
-func (v Fix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherFix64, ok := other.(Fix64Value)
+func (v Fix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFix128, ok := other.(Fix128Value)
 	if !ok {
 		return false
 	}
-	return v == otherFix64
+	return v.BigInt.Cmp(otherFix128.BigInt) == 0
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeFix64 (1 byte)
-// - int64 value encoded in big-endian (8 bytes)
-func (v Fix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeFix64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
+// - HashInputTypeFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Fix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeFix128)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func ConvertFix64(value Value) Fix64Value {
+// saturateFix128 returns the given value, clamped to the range of Fix128
+//
+func saturateFix128(value *big.Int) Fix128Value {
+	if value.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return Fix128Value{sema.Fix128TypeMinBig}
+	} else if value.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return Fix128Value{sema.Fix128TypeMaxBig}
+	}
+	return Fix128Value{value}
+}
+
+// fix128ScaleFactorBig is the factor between the scales of Fix128 and Fix64
+//
+var fix128ScaleFactorBig = new(big.Int).Quo(sema.Fix128FactorBig, sema.Fix64FactorBig)
+
+func ConvertFix128(value Value) Fix128Value {
 	switch value := value.(type) {
-	case Fix64Value:
+	case Fix128Value:
 		return value
 
-	case UFix64Value:
-		if value > Fix64MaxValue {
+	case UFix128Value:
+		if value.BigInt.Cmp(sema.Fix128TypeMaxBig) > 0 {
 			panic(OverflowError{})
 		}
-		return Fix64Value(value)
-
-	case BigNumberValue:
-		v := value.ToBigInt()
+		return Fix128Value(value)
 
-		// First, check if the value is at least in the int64 range.
-		// The integer range for Fix64 is smaller, but this test at least
-		// allows us to call `v.Int64()` safely.
+	case Fix64Value:
+		v := big.NewInt(int64(value))
+		return Fix128Value{v.Mul(v, fix128ScaleFactorBig)}
 
-		if !v.IsInt64() {
-			panic(OverflowError{})
-		}
+	case UFix64Value:
+		v := new(big.Int).SetUint64(uint64(value))
+		return Fix128Value{v.Mul(v, fix128ScaleFactorBig)}
 
-		// Now check that the integer value fits the range of Fix64
-		return NewFix64ValueWithInteger(v.Int64())
+	case BigNumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(value.ToBigInt())
 
 	case NumberValue:
-		v := value.ToInt()
-		// Check that the integer value fits the range of Fix64
-		return NewFix64ValueWithInteger(int64(v))
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(big.NewInt(int64(value.ToInt())))
 
 	default:
-		panic(fmt.Sprintf("can't convert Fix64: %s", value))
+		panic(fmt.Sprintf("can't convert Fix128: %s", value))
 	}
 }
 
-func (v Fix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.Fix64Type)
+func (v Fix128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.Fix128Type)
 }
 
-func (Fix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Fix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Fix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Fix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Fix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v Fix128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v Fix64Value) ConformsToDynamicType(
+func (v Fix128Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.Fix64Type.Equal(numberType.StaticType)
+	return ok && sema.Fix128Type.Equal(numberType.StaticType)
 }
 
-func (Fix64Value) IsStorable() bool {
+func (Fix128Value) IsStorable() bool {
 	return true
 }
 
-func (v Fix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Fix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Fix64Value) NeedsStoreTo(_ atree.Address) bool {
+func (Fix128Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Fix64Value) IsResourceKinded(_ *Interpreter) bool {
+func (Fix128Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Fix64Value) Transfer(
+func (v Fix128Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -10821,85 +12479,95 @@ func (v Fix64Value) Transfer(
 	return v
 }
 
-func (v Fix64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v Fix128Value) Clone(_ *Interpreter) Value {
+	return NewFix128ValueFromBigInt(v.BigInt)
 }
 
-func (Fix64Value) DeepRemove(_ *Interpreter) {
+func (Fix128Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Fix64Value) ByteSize() uint32 {
-	return cborTagSize + getIntCBORSize(int64(v))
+func (v Fix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v Fix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Fix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Fix64Value) ChildStorables() []atree.Storable {
+func (Fix128Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// UFix64Value
+// UFix128Value
 //
-type UFix64Value uint64
+type UFix128Value struct {
+	BigInt *big.Int
+}
 
-const UFix64MaxValue = math.MaxUint64
+func NewUFix128ValueFromBigInt(value *big.Int) UFix128Value {
+	return UFix128Value{BigInt: value}
+}
 
-func NewUFix64ValueWithInteger(integer uint64) UFix64Value {
-	if integer > sema.UFix64TypeMaxInt {
+func NewUFix128ValueWithInteger(integer *big.Int) UFix128Value {
+
+	if integer.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.UFix128TypeMaxIntBig) > 0 {
 		panic(OverflowError{})
 	}
 
-	return UFix64Value(integer * sema.Fix64Factor)
+	return UFix128Value{new(big.Int).Mul(integer, sema.Fix128FactorBig)}
 }
 
-var _ Value = UFix64Value(0)
-var _ atree.Storable = UFix64Value(0)
-var _ NumberValue = UFix64Value(0)
-var _ EquatableValue = UFix64Value(0)
-var _ HashableValue = UFix64Value(0)
-var _ MemberAccessibleValue = UFix64Value(0)
+var _ Value = UFix128Value{}
+var _ atree.Storable = UFix128Value{}
+var _ NumberValue = UFix128Value{}
+var _ EquatableValue = UFix128Value{}
+var _ HashableValue = UFix128Value{}
+var _ MemberAccessibleValue = UFix128Value{}
 
-func (UFix64Value) IsValue() {}
+func (UFix128Value) IsValue() {}
 
-func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUFix64Value(interpreter, v)
+func (v UFix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix128Value(interpreter, v)
 }
 
-func (UFix64Value) Walk(_ func(Value)) {
+func (UFix128Value) Walk(_ func(Value)) {
 	// NO-OP
 }
 
-var ufix64DynamicType DynamicType = NumberDynamicType{sema.UFix64Type}
+var ufix128DynamicType DynamicType = NumberDynamicType{sema.UFix128Type}
 
-func (UFix64Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
-	return ufix64DynamicType
+func (UFix128Value) DynamicType(_ *Interpreter, _ SeenReferences) DynamicType {
+	return ufix128DynamicType
 }
 
-func (UFix64Value) StaticType() StaticType {
-	return PrimitiveStaticTypeUFix64
+func (UFix128Value) StaticType() StaticType {
+	return PrimitiveStaticTypeUFix128
 }
 
-func (v UFix64Value) String() string {
-	return format.UFix64(uint64(v))
+func (v UFix128Value) String() string {
+	return format.UFix128(v.BigInt)
 }
 
-func (v UFix64Value) RecursiveString(_ SeenReferences) string {
+func (v UFix128Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v UFix64Value) ToInt() int {
-	return int(v / sema.Fix64Factor)
+func (v UFix128Value) ToInt() int {
+	// The integer part of a UFix128 always fits into an int64
+	return int(new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig).Int64())
 }
 
-func (v UFix64Value) Negate() NumberValue {
+func (v UFix128Value) Negate() NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) Plus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) Plus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -10908,11 +12576,15 @@ func (v UFix64Value) Plus(other NumberValue) NumberValue {
 		})
 	}
 
-	return UFix64Value(safeAddUint64(uint64(v), uint64(o)))
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	if res.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		panic(OverflowError{})
+	}
+	return UFix128Value{res}
 }
 
-func (v UFix64Value) SaturatingPlus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) SaturatingPlus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
@@ -10921,16 +12593,12 @@ func (v UFix64Value) SaturatingPlus(other NumberValue) NumberValue {
 		})
 	}
 
-	sum := v + o
-	// INT30-C
-	if sum < v {
-		return UFix64Value(math.MaxUint64)
-	}
-	return sum
+	res := new(big.Int).Add(v.BigInt, o.BigInt)
+	return saturateUFix128(res)
 }
 
-func (v UFix64Value) Minus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) Minus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -10939,17 +12607,15 @@ func (v UFix64Value) Minus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := v - o
-
-	// INT30-C
-	if diff > v {
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	if res.Sign() < 0 {
 		panic(UnderflowError{})
 	}
-	return diff
+	return UFix128Value{res}
 }
 
-func (v UFix64Value) SaturatingMinus(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) SaturatingMinus(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
@@ -10958,17 +12624,12 @@ func (v UFix64Value) SaturatingMinus(other NumberValue) NumberValue {
 		})
 	}
 
-	diff := v - o
-
-	// INT30-C
-	if diff > v {
-		return UFix64Value(0)
-	}
-	return diff
+	res := new(big.Int).Sub(v.BigInt, o.BigInt)
+	return saturateUFix128(res)
 }
 
-func (v UFix64Value) Mul(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) Mul(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -10977,21 +12638,18 @@ func (v UFix64Value) Mul(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
 
-	if !result.IsUint64() {
+	if result.Cmp(sema.UFix128TypeMaxBig) > 0 {
 		panic(OverflowError{})
 	}
 
-	return UFix64Value(result.Uint64())
+	return UFix128Value{result}
 }
 
-func (v UFix64Value) SaturatingMul(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) SaturatingMul(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
@@ -11000,21 +12658,14 @@ func (v UFix64Value) SaturatingMul(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	result := new(big.Int).Mul(a, b)
-	result.Div(result, sema.Fix64FactorBig)
-
-	if !result.IsUint64() {
-		return UFix64Value(math.MaxUint64)
-	}
+	result := new(big.Int).Mul(v.BigInt, o.BigInt)
+	result.Div(result, sema.Fix128FactorBig)
 
-	return UFix64Value(result.Uint64())
+	return saturateUFix128(result)
 }
 
-func (v UFix64Value) Div(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) Div(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -11023,16 +12674,21 @@ func (v UFix64Value) Div(other NumberValue) NumberValue {
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
+	if o.BigInt.Sign() == 0 {
+		panic(DivisionByZeroError{})
+	}
 
-	result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-	result.Div(result, b)
+	result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+	result.Div(result, o.BigInt)
 
-	return UFix64Value(result.Uint64())
+	if result.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return UFix128Value{result}
 }
 
-func (v UFix64Value) SaturatingDiv(other NumberValue) NumberValue {
+func (v UFix128Value) SaturatingDiv(other NumberValue) NumberValue {
 	defer func() {
 		r := recover()
 		if _, ok := r.(InvalidOperandsError); ok {
@@ -11041,14 +12697,16 @@ func (v UFix64Value) SaturatingDiv(other NumberValue) NumberValue {
 				LeftType:     v.StaticType(),
 				RightType:    other.StaticType(),
 			})
+		} else if r != nil {
+			panic(r)
 		}
 	}()
 
 	return v.Div(other)
 }
 
-func (v UFix64Value) Mod(other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) Mod(other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -11058,7 +12716,7 @@ func (v UFix64Value) Mod(other NumberValue) NumberValue {
 	}
 
 	// v - int(v/o) * o
-	quotient, ok := v.Div(o).(UFix64Value)
+	quotient, ok := v.Div(o).(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMod,
@@ -11066,12 +12724,13 @@ func (v UFix64Value) Mod(other NumberValue) NumberValue {
 			RightType: other.StaticType(),
 		})
 	}
-	truncatedQuotient := (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
-	return v.Minus(UFix64Value(truncatedQuotient).Mul(o))
+	truncatedQuotient := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+	truncatedQuotient.Mul(truncatedQuotient, sema.Fix128FactorBig)
+	return v.Minus(UFix128Value{truncatedQuotient}.Mul(o))
 }
 
-func (v UFix64Value) Less(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) Less(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLess,
@@ -11080,11 +12739,11 @@ func (v UFix64Value) Less(other NumberValue) BoolValue {
 		})
 	}
 
-	return v < o
+	return v.BigInt.Cmp(o.BigInt) < 0
 }
 
-func (v UFix64Value) LessEqual(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) LessEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationLessEqual,
@@ -11093,11 +12752,11 @@ func (v UFix64Value) LessEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v <= o
+	return v.BigInt.Cmp(o.BigInt) <= 0
 }
 
-func (v UFix64Value) Greater(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) Greater(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreater,
@@ -11106,11 +12765,11 @@ func (v UFix64Value) Greater(other NumberValue) BoolValue {
 		})
 	}
 
-	return v > o
+	return v.BigInt.Cmp(o.BigInt) > 0
 }
 
-func (v UFix64Value) GreaterEqual(other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v UFix128Value) GreaterEqual(other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationGreaterEqual,
@@ -11119,115 +12778,127 @@ func (v UFix64Value) GreaterEqual(other NumberValue) BoolValue {
 		})
 	}
 
-	return v >= o
+	return v.BigInt.Cmp(o.BigInt) >= 0
 }
 
-func (v UFix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUFix64, ok := other.(UFix64Value)
+func (v UFix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUFix128, ok := other.(UFix128Value)
 	if !ok {
 		return false
 	}
-	return v == otherUFix64
+	return v.BigInt.Cmp(otherUFix128.BigInt) == 0
 }
 
 // HashInput returns a byte slice containing:
-// - HashInputTypeUFix64 (1 byte)
-// - uint64 value encoded in big-endian (8 bytes)
-func (v UFix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeUFix64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
+// - HashInputTypeUFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v UFix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUFix128)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func ConvertUFix64(value Value) UFix64Value {
+// saturateUFix128 returns the given value, clamped to the range of UFix128
+//
+func saturateUFix128(value *big.Int) UFix128Value {
+	if value.Sign() < 0 {
+		return UFix128Value{sema.UFix128TypeMinBig}
+	} else if value.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return UFix128Value{sema.UFix128TypeMaxBig}
+	}
+	return UFix128Value{value}
+}
+
+func ConvertUFix128(value Value) UFix128Value {
 	switch value := value.(type) {
-	case UFix64Value:
+	case UFix128Value:
 		return value
 
-	case Fix64Value:
-		if value < 0 {
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
 			panic(UnderflowError{})
 		}
-		return UFix64Value(value)
-
-	case BigNumberValue:
-		v := value.ToBigInt()
+		return UFix128Value(value)
 
-		if v.Sign() < 0 {
+	case Fix64Value:
+		if value < 0 {
 			panic(UnderflowError{})
 		}
+		v := big.NewInt(int64(value))
+		return UFix128Value{v.Mul(v, fix128ScaleFactorBig)}
 
-		// First, check if the value is at least in the uint64 range.
-		// The integer range for UFix64 is smaller, but this test at least
-		// allows us to call `v.UInt64()` safely.
-
-		if !v.IsUint64() {
-			panic(OverflowError{})
-		}
+	case UFix64Value:
+		v := new(big.Int).SetUint64(uint64(value))
+		return UFix128Value{v.Mul(v, fix128ScaleFactorBig)}
 
-		// Now check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(v.Uint64())
+	case BigNumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(value.ToBigInt())
 
 	case NumberValue:
-		v := value.ToInt()
-		if v < 0 {
-			panic(UnderflowError{})
-		}
-		// Check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(uint64(v))
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(big.NewInt(int64(value.ToInt())))
 
 	default:
-		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
+		panic(fmt.Sprintf("can't convert to UFix128: %s", value))
 	}
 }
 
-func (v UFix64Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(v, name, sema.UFix64Type)
+func (v UFix128Value) GetMember(_ *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(v, name, sema.UFix128Type)
 }
 
-func (UFix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (UFix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (UFix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v UFix128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v UFix64Value) ConformsToDynamicType(
+func (v UFix128Value) ConformsToDynamicType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	dynamicType DynamicType,
 	_ TypeConformanceResults,
 ) bool {
 	numberType, ok := dynamicType.(NumberDynamicType)
-	return ok && sema.UFix64Type.Equal(numberType.StaticType)
+	return ok && sema.UFix128Type.Equal(numberType.StaticType)
 }
 
-func (UFix64Value) IsStorable() bool {
+func (UFix128Value) IsStorable() bool {
 	return true
 }
 
-func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v UFix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
+func (UFix128Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
+func (UFix128Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UFix64Value) Transfer(
+func (v UFix128Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -11240,23 +12911,23 @@ func (v UFix64Value) Transfer(
 	return v
 }
 
-func (v UFix64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v UFix128Value) Clone(_ *Interpreter) Value {
+	return NewUFix128ValueFromBigInt(v.BigInt)
 }
 
-func (UFix64Value) DeepRemove(_ *Interpreter) {
+func (UFix128Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UFix64Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (v UFix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v UFix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UFix64Value) ChildStorables() []atree.Storable {
+func (UFix128Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
@@ -12495,37 +14166,245 @@ func (v *DictionaryValue) GetMember(
 				keyValue := invocation.Arguments[0]
 				newValue := invocation.Arguments[1]
 
-				return v.Insert(
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					keyValue,
+					newValue,
+				)
+			},
+			sema.DictionaryInsertFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
+
+	case "containsKey":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				return v.ContainsKey(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.DictionaryContainsKeyFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
+
+	case "forEachKey":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				function, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEachKey(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					function,
+				)
+
+				return VoidValue{}
+			},
+			sema.DictionaryForEachKeyFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
+
+	case "filter":
+		return NewHostFunctionValue(
+			func(invocation Invocation) Value {
+				isIncluded, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Filter(
 					invocation.Interpreter,
 					invocation.GetLocationRange,
-					keyValue,
-					newValue,
+					isIncluded,
 				)
 			},
-			sema.DictionaryInsertFunctionType(
+			sema.DictionaryFilterFunctionType(
 				v.SemaType(interpreter),
 			),
 		)
 
-	case "containsKey":
+	case "mapValues":
 		return NewHostFunctionValue(
 			func(invocation Invocation) Value {
-				return v.ContainsKey(
+				transform, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.MapValues(
 					invocation.Interpreter,
 					invocation.GetLocationRange,
-					invocation.Arguments[0],
+					transform,
+					typeParameterPair.Value,
 				)
 			},
-			sema.DictionaryContainsKeyFunctionType(
+			sema.DictionaryMapValuesFunctionType(
 				v.SemaType(interpreter),
 			),
 		)
-
 	}
 
 	return nil
 }
 
+// The functions below call the given function values for the entries of the dictionary.
+//
+// They iterate over a snapshot of the keys, instead of iterating over the underlying atree map,
+// as the called functions may modify the dictionary.
+// Entries which are removed by the called functions are skipped.
+
+// keys returns the keys of the dictionary
+//
+func (v *DictionaryValue) keys() []Value {
+	keys := make([]Value, 0, v.Count())
+
+	err := v.dictionary.IterateKeys(func(key atree.Value) (resume bool, err error) {
+		keys = append(keys, MustConvertStoredValue(key))
+		return true, nil
+	})
+	if err != nil {
+		panic(ExternalError{err})
+	}
+
+	return keys
+}
+
+// ForEachKey calls the given function for each key of the dictionary,
+// until the function returns false
+//
+func (v *DictionaryValue) ForEachKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	function FunctionValue,
+) {
+	argumentTypes := []sema.Type{
+		v.SemaType(interpreter).KeyType,
+	}
+
+	for _, key := range v.keys() {
+		interpreter.MeterComputation(1)
+
+		result := interpreter.invokeFunctionArgument(
+			function,
+			[]Value{key},
+			argumentTypes,
+			getLocationRange,
+		)
+
+		if !bool(result.(BoolValue)) {
+			break
+		}
+	}
+}
+
+// Filter returns a new dictionary containing the entries of the dictionary
+// for which the given function returns true
+//
+func (v *DictionaryValue) Filter(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	isIncluded FunctionValue,
+) Value {
+	dictionaryType := v.SemaType(interpreter)
+
+	argumentTypes := []sema.Type{
+		dictionaryType.KeyType,
+		dictionaryType.ValueType,
+	}
+
+	result := NewDictionaryValue(interpreter, v.Type)
+
+	for _, key := range v.keys() {
+		interpreter.MeterComputation(1)
+
+		value, ok := v.Get(interpreter, getLocationRange, key)
+		if !ok {
+			continue
+		}
+
+		included := interpreter.invokeFunctionArgument(
+			isIncluded,
+			[]Value{key, value},
+			argumentTypes,
+			getLocationRange,
+		)
+
+		if !bool(included.(BoolValue)) {
+			continue
+		}
+
+		result.Insert(
+			interpreter,
+			getLocationRange,
+			key.Transfer(interpreter, getLocationRange, atree.Address{}, false, nil),
+			value.Transfer(interpreter, getLocationRange, atree.Address{}, false, nil),
+		)
+	}
+
+	return result
+}
+
+// MapValues returns a new dictionary containing the keys of the dictionary,
+// and the results of calling the given function for the associated values
+//
+func (v *DictionaryValue) MapValues(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	transform FunctionValue,
+	resultType sema.Type,
+) Value {
+	argumentTypes := []sema.Type{
+		v.SemaType(interpreter).ValueType,
+	}
+
+	result := NewDictionaryValue(
+		interpreter,
+		DictionaryStaticType{
+			KeyType:   v.Type.KeyType,
+			ValueType: ConvertSemaToStaticType(resultType),
+		},
+	)
+
+	for _, key := range v.keys() {
+		interpreter.MeterComputation(1)
+
+		value, ok := v.Get(interpreter, getLocationRange, key)
+		if !ok {
+			continue
+		}
+
+		newValue := interpreter.invokeFunctionArgument(
+			transform,
+			[]Value{value},
+			argumentTypes,
+			getLocationRange,
+		)
+
+		result.Insert(
+			interpreter,
+			getLocationRange,
+			key.Transfer(interpreter, getLocationRange, atree.Address{}, false, nil),
+			newValue,
+		)
+	}
+
+	return result
+}
+
 func (*DictionaryValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Dictionaries have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
@@ -13389,6 +15268,270 @@ func (s SomeStorable) ChildStorables() []atree.Storable {
 	}
 }
 
+// TupleValue
+
+type TupleValue struct {
+	Elements         []Value
+	elementStorables []atree.Storable
+	isDestroyed      bool
+}
+
+func NewTupleValueNonCopying(elements ...Value) *TupleValue {
+	return &TupleValue{
+		Elements: elements,
+	}
+}
+
+var _ Value = &TupleValue{}
+var _ EquatableValue = &TupleValue{}
+var _ ResourceKindedValue = &TupleValue{}
+
+func (*TupleValue) IsValue() {}
+
+func (v *TupleValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitTupleValue(interpreter, v)
+	if !descend {
+		return
+	}
+	for _, element := range v.Elements {
+		element.Accept(interpreter, visitor)
+	}
+}
+
+func (v *TupleValue) Walk(walkChild func(Value)) {
+	for _, element := range v.Elements {
+		walkChild(element)
+	}
+}
+
+func (v *TupleValue) DynamicType(interpreter *Interpreter, seenReferences SeenReferences) DynamicType {
+	elementTypes := make([]DynamicType, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elementTypes[i] = element.DynamicType(interpreter, seenReferences)
+	}
+
+	return &TupleDynamicType{
+		ElementTypes: elementTypes,
+	}
+}
+
+func (v *TupleValue) StaticType() StaticType {
+	elementTypes := make([]StaticType, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elementType := element.StaticType()
+		if elementType == nil {
+			return nil
+		}
+		elementTypes[i] = elementType
+	}
+
+	return &TupleStaticType{
+		ElementTypes: elementTypes,
+	}
+}
+
+func (v *TupleValue) IsDestroyed() bool {
+	return v.isDestroyed
+}
+
+func (v *TupleValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	for _, element := range v.Elements {
+		maybeDestroy(interpreter, getLocationRange, element)
+	}
+	v.isDestroyed = true
+}
+
+func (v *TupleValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *TupleValue) RecursiveString(seenReferences SeenReferences) string {
+	elements := make([]string, len(v.Elements))
+	for i, element := range v.Elements {
+		elements[i] = element.RecursiveString(seenReferences)
+	}
+	return format.Tuple(elements)
+}
+
+func (v *TupleValue) ConformsToDynamicType(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	dynamicType DynamicType,
+	results TypeConformanceResults,
+) bool {
+	tupleType, ok := dynamicType.(*TupleDynamicType)
+	if !ok || len(tupleType.ElementTypes) != len(v.Elements) {
+		return false
+	}
+
+	for i, element := range v.Elements {
+		if !element.ConformsToDynamicType(
+			interpreter,
+			getLocationRange,
+			tupleType.ElementTypes[i],
+			results,
+		) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *TupleValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
+	otherTuple, ok := other.(*TupleValue)
+	if !ok || len(v.Elements) != len(otherTuple.Elements) {
+		return false
+	}
+
+	for i, element := range v.Elements {
+		equatableElement, ok := element.(EquatableValue)
+		if !ok || !equatableElement.Equal(interpreter, getLocationRange, otherTuple.Elements[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *TupleValue) Storable(
+	storage atree.SlabStorage,
+	address atree.Address,
+	maxInlineSize uint64,
+) (atree.Storable, error) {
+
+	if v.elementStorables == nil {
+		elementStorables := make([]atree.Storable, len(v.Elements))
+		for i, element := range v.Elements {
+			var err error
+			elementStorables[i], err = element.Storable(
+				storage,
+				address,
+				maxInlineSize,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+		v.elementStorables = elementStorables
+	}
+
+	return maybeLargeImmutableStorable(
+		TupleStorable{
+			Storables: v.elementStorables,
+		},
+		storage,
+		address,
+		maxInlineSize,
+	)
+}
+
+func (v *TupleValue) NeedsStoreTo(address atree.Address) bool {
+	for _, element := range v.Elements {
+		if element.NeedsStoreTo(address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *TupleValue) IsResourceKinded(interpreter *Interpreter) bool {
+	for _, element := range v.Elements {
+		if element.IsResourceKinded(interpreter) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *TupleValue) Transfer(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+
+	elements := v.Elements
+
+	needsStoreTo := v.NeedsStoreTo(address)
+	isResourceKinded := v.IsResourceKinded(interpreter)
+
+	if needsStoreTo || !isResourceKinded {
+
+		elements = make([]Value, len(v.Elements))
+		for i, element := range v.Elements {
+			elements[i] = element.Transfer(interpreter, getLocationRange, address, remove, nil)
+		}
+
+		if remove {
+			for _, elementStorable := range v.elementStorables {
+				interpreter.RemoveReferencedSlab(elementStorable)
+			}
+			interpreter.RemoveReferencedSlab(storable)
+		}
+	}
+
+	if isResourceKinded {
+		v.Elements = elements
+		v.elementStorables = nil
+		return v
+	} else {
+		result := NewTupleValueNonCopying(elements...)
+		result.isDestroyed = v.isDestroyed
+		return result
+	}
+}
+
+func (v *TupleValue) Clone(interpreter *Interpreter) Value {
+	elements := make([]Value, len(v.Elements))
+	for i, element := range v.Elements {
+		elements[i] = element.Clone(interpreter)
+	}
+	return NewTupleValueNonCopying(elements...)
+}
+
+func (v *TupleValue) DeepRemove(interpreter *Interpreter) {
+	for _, element := range v.Elements {
+		element.DeepRemove(interpreter)
+	}
+	for _, elementStorable := range v.elementStorables {
+		interpreter.RemoveReferencedSlab(elementStorable)
+	}
+}
+
+type TupleStorable struct {
+	Storables []atree.Storable
+}
+
+var _ atree.Storable = TupleStorable{}
+
+func (s TupleStorable) ByteSize() uint32 {
+	size := cborTagSize + getUintCBORSize(uint64(len(s.Storables)))
+	for _, storable := range s.Storables {
+		size += storable.ByteSize()
+	}
+	return size
+}
+
+func (s TupleStorable) StoredValue(storage atree.SlabStorage) (atree.Value, error) {
+	elements := make([]Value, len(s.Storables))
+	for i, storable := range s.Storables {
+		elements[i] = StoredValue(storable, storage)
+	}
+
+	return &TupleValue{
+		Elements:         elements,
+		elementStorables: s.Storables,
+	}, nil
+}
+
+func (s TupleStorable) ChildStorables() []atree.Storable {
+	return s.Storables
+}
+
 // StorageReferenceValue
 
 type StorageReferenceValue struct {
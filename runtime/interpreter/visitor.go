@@ -44,11 +44,14 @@ type Visitor interface {
 	VisitWord32Value(interpreter *Interpreter, value Word32Value)
 	VisitWord64Value(interpreter *Interpreter, value Word64Value)
 	VisitFix64Value(interpreter *Interpreter, value Fix64Value)
+	VisitFix128Value(interpreter *Interpreter, value Fix128Value)
 	VisitUFix64Value(interpreter *Interpreter, value UFix64Value)
+	VisitUFix128Value(interpreter *Interpreter, value UFix128Value)
 	VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool
 	VisitDictionaryValue(interpreter *Interpreter, value *DictionaryValue) bool
 	VisitNilValue(interpreter *Interpreter, value NilValue)
 	VisitSomeValue(interpreter *Interpreter, value *SomeValue) bool
+	VisitTupleValue(interpreter *Interpreter, value *TupleValue) bool
 	VisitStorageReferenceValue(interpreter *Interpreter, value *StorageReferenceValue)
 	VisitEphemeralReferenceValue(interpreter *Interpreter, value *EphemeralReferenceValue)
 	VisitAddressValue(interpreter *Interpreter, value AddressValue)
@@ -86,11 +89,14 @@ type EmptyVisitor struct {
 	Word32ValueVisitor              func(interpreter *Interpreter, value Word32Value)
 	Word64ValueVisitor              func(interpreter *Interpreter, value Word64Value)
 	Fix64ValueVisitor               func(interpreter *Interpreter, value Fix64Value)
+	Fix128ValueVisitor              func(interpreter *Interpreter, value Fix128Value)
 	UFix64ValueVisitor              func(interpreter *Interpreter, value UFix64Value)
+	UFix128ValueVisitor             func(interpreter *Interpreter, value UFix128Value)
 	CompositeValueVisitor           func(interpreter *Interpreter, value *CompositeValue) bool
 	DictionaryValueVisitor          func(interpreter *Interpreter, value *DictionaryValue) bool
 	NilValueVisitor                 func(interpreter *Interpreter, value NilValue)
 	SomeValueVisitor                func(interpreter *Interpreter, value *SomeValue) bool
+	TupleValueVisitor               func(interpreter *Interpreter, value *TupleValue) bool
 	StorageReferenceValueVisitor    func(interpreter *Interpreter, value *StorageReferenceValue)
 	EphemeralReferenceValueVisitor  func(interpreter *Interpreter, value *EphemeralReferenceValue)
 	AddressValueVisitor             func(interpreter *Interpreter, value AddressValue)
@@ -279,6 +285,13 @@ func (v EmptyVisitor) VisitFix64Value(interpreter *Interpreter, value Fix64Value
 	v.Fix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitFix128Value(interpreter *Interpreter, value Fix128Value) {
+	if v.Fix128ValueVisitor == nil {
+		return
+	}
+	v.Fix128ValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Value) {
 	if v.UFix64ValueVisitor == nil {
 		return
@@ -286,6 +299,13 @@ func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Val
 	v.UFix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitUFix128Value(interpreter *Interpreter, value UFix128Value) {
+	if v.UFix128ValueVisitor == nil {
+		return
+	}
+	v.UFix128ValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool {
 	if v.CompositeValueVisitor == nil {
 		return true
@@ -314,6 +334,13 @@ func (v EmptyVisitor) VisitSomeValue(interpreter *Interpreter, value *SomeValue)
 	return v.SomeValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitTupleValue(interpreter *Interpreter, value *TupleValue) bool {
+	if v.TupleValueVisitor == nil {
+		return true
+	}
+	return v.TupleValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitStorageReferenceValue(interpreter *Interpreter, value *StorageReferenceValue) {
 	if v.StorageReferenceValueVisitor == nil {
 		return
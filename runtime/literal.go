@@ -211,7 +211,21 @@ func fixedPointLiteralValue(expression ast.Expression, ty sema.Type) (cadence.Va
 		return nil, InvalidLiteralError
 	}
 
-	// TODO: adjust once/if we support more fixed point types
+	switch ty {
+	case sema.Fix128Type, sema.UFix128Type:
+		value := fixedpoint.ConvertToFixedPointBigInt(
+			fixedPointExpression.Negative,
+			fixedPointExpression.UnsignedInteger,
+			fixedPointExpression.Fractional,
+			fixedPointExpression.Scale,
+			sema.Fix128Scale,
+		)
+
+		if ty == sema.Fix128Type {
+			return cadence.NewFix128FromBig(value)
+		}
+		return cadence.NewUFix128FromBig(value)
+	}
 
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		fixedPointExpression.Negative,
@@ -0,0 +1,153 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/stdlib"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeMath(t *testing.T) {
+
+	t.Parallel()
+
+	executeScript := func(code string) (cadence.Value, error) {
+		runtime := newTestInterpreterRuntime()
+
+		runtimeInterface := &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+		}
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: []byte(code),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  utils.TestLocation,
+			},
+		)
+	}
+
+	test := func(expression string, expected cadence.Value) {
+
+		t.Run(expression, func(t *testing.T) {
+
+			t.Parallel()
+
+			result, err := executeScript(
+				fmt.Sprintf(
+					`
+                      pub fun main(): AnyStruct {
+                          return %s
+                      }
+                    `,
+					expression,
+				),
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, result)
+		})
+	}
+
+	test(
+		"Math.sqrt(2.0, rounding: RoundingMode.towardZero)",
+		cadence.UFix64(1_41_421_356),
+	)
+	test(
+		"Math.sqrt(2.0, rounding: RoundingMode.awayFromZero)",
+		cadence.UFix64(1_41_421_357),
+	)
+	test(
+		"Math.pow(0.5, 10, rounding: RoundingMode.nearestHalfEven)",
+		cadence.UFix64(97_656),
+	)
+	test(
+		"Math.ln(0.5, rounding: RoundingMode.nearestHalfAway)",
+		cadence.Fix64(-69_314_718),
+	)
+	test(
+		"Math.exp(1.0, rounding: RoundingMode.nearestHalfEven)",
+		cadence.UFix64(2_71_828_183),
+	)
+	test(
+		"Math.mulDiv(1.0, 2.0, 3.0, rounding: RoundingMode.nearestHalfEven)",
+		cadence.UFix64(66_666_667),
+	)
+	test(
+		"Math.mulDiv(UFix64.max, UFix64.max, UFix64.max, rounding: RoundingMode.towardZero)",
+		cadence.UFix64(18446744073709551615),
+	)
+	test(
+		"RoundingMode.nearestHalfEven.rawValue",
+		cadence.NewUInt8(3),
+	)
+
+	testError := func(expression string, expectedErr error) {
+
+		t.Run(expression, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := executeScript(
+				fmt.Sprintf(
+					`
+                      pub fun main(): AnyStruct {
+                          return %s
+                      }
+                    `,
+					expression,
+				),
+			)
+			require.Error(t, err)
+
+			require.ErrorAs(t, err, expectedErr)
+		})
+	}
+
+	testError(
+		"Math.pow(2.0, 64, rounding: RoundingMode.towardZero)",
+		&interpreter.OverflowError{},
+	)
+	testError(
+		"Math.exp(26.0, rounding: RoundingMode.towardZero)",
+		&interpreter.OverflowError{},
+	)
+	testError(
+		"Math.mulDiv(UFix64.max, 2.0, 1.0, rounding: RoundingMode.towardZero)",
+		&interpreter.OverflowError{},
+	)
+	testError(
+		"Math.mulDiv(1.0, 1.0, 0.0, rounding: RoundingMode.towardZero)",
+		&interpreter.DivisionByZeroError{},
+	)
+	testError(
+		"Math.ln(0.0, rounding: RoundingMode.towardZero)",
+		&stdlib.MathDomainError{},
+	)
+}
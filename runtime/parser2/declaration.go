@@ -79,6 +79,12 @@ func parseDeclaration(p *parser, docString string) ast.Declaration {
 			case keywordFun:
 				return parseFunctionDeclaration(p, false, access, accessPos, docString)
 
+			case keywordView:
+				if !isNextTokenFunKeyword(p) {
+					break
+				}
+				return parseFunctionDeclaration(p, false, access, accessPos, docString)
+
 			case keywordImport:
 				return parseImportDeclaration(p)
 
@@ -315,6 +321,104 @@ func parseVariableDeclaration(
 	return variableDeclaration
 }
 
+// isNextTokenParenOpen checks whether the token to follow is an opening parenthesis.
+func isNextTokenParenOpen(p *parser) bool {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	// skip the current token
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// Lookahead the next token
+	return p.current.Is(lexer.TokenParenOpen)
+}
+
+// parseTupleVariableDeclaration parses a variable declaration
+// which declares a variable for each element of a tuple.
+//
+//     tupleVariableDeclaration :
+//         ( 'let' | 'var' )
+//         '(' identifier ( ',' identifier )+ ')'
+//         ( ':' typeAnnotation )?
+//         transfer expression
+//
+func parseTupleVariableDeclaration(p *parser) *ast.TupleVariableDeclaration {
+
+	startPos := p.current.StartPos
+
+	isLet := p.current.Value == keywordLet
+
+	// Skip the `let` or `var` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	p.mustOne(lexer.TokenParenOpen)
+
+	var identifiers []ast.Identifier
+
+	for {
+		p.skipSpaceAndComments(true)
+		if !p.current.Is(lexer.TokenIdentifier) {
+			panic(fmt.Errorf(
+				"expected identifier in tuple variable declaration, got %s",
+				p.current.Type,
+			))
+		}
+
+		identifiers = append(identifiers, tokenToIdentifier(p.current))
+
+		// Skip the identifier
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		if !p.current.Is(lexer.TokenComma) {
+			break
+		}
+
+		// Skip the comma
+		p.next()
+	}
+
+	p.mustOne(lexer.TokenParenClose)
+
+	if len(identifiers) < 2 {
+		panic(fmt.Errorf(
+			"expected at least two identifiers in tuple variable declaration, got %d",
+			len(identifiers),
+		))
+	}
+
+	p.skipSpaceAndComments(true)
+
+	var typeAnnotation *ast.TypeAnnotation
+
+	if p.current.Is(lexer.TokenColon) {
+		// Skip the colon
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeAnnotation = parseTypeAnnotation(p)
+	}
+
+	p.skipSpaceAndComments(true)
+	transfer := parseTransfer(p)
+	if transfer == nil {
+		panic(fmt.Errorf("expected transfer"))
+	}
+
+	value := parseExpression(p, lowestBindingPower)
+
+	return &ast.TupleVariableDeclaration{
+		IsConstant:     isLet,
+		Identifiers:    identifiers,
+		TypeAnnotation: typeAnnotation,
+		Value:          value,
+		Transfer:       transfer,
+		StartPos:       startPos,
+	}
+}
+
 // parseTransfer parses a transfer.
 //
 //     transfer : '=' | '<-' | '<-!'
@@ -360,12 +464,17 @@ func parsePragmaDeclaration(p *parser) *ast.PragmaDeclaration {
 	}
 }
 
+// importHashLength is the length of the hash of the imported code, a SHA3-256 hash
+//
+const importHashLength = 32
+
 // parseImportDeclaration parses an import declaration
 //
 //     importDeclaration :
 //         'import'
 //         ( identifier (',' identifier)* 'from' )?
 //         ( string | hexadecimalLiteral | identifier )
+//         ( 'hash' string )?
 //
 func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 
@@ -377,6 +486,9 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 	var locationPos ast.Position
 	var endPos ast.Position
 
+	var hash []byte
+	var hashPos ast.Position
+
 	parseStringOrAddressLocation := func() {
 		locationPos = p.current.StartPos
 		endPos = p.current.EndPos
@@ -512,6 +624,62 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 		}
 	}
 
+	maybeParseHash := func() {
+		// The location may be followed by the `hash` keyword
+		// and a string containing the hex-encoded hash of the imported code.
+		//
+		// Only consume the trivia before the keyword if it is present,
+		// so that e.g. the docstring of the next declaration is preserved.
+
+		p.startBuffering()
+		p.skipSpaceAndComments(true)
+
+		if !p.current.IsString(lexer.TokenIdentifier, keywordHash) {
+			p.replayBuffered()
+			return
+		}
+
+		p.acceptBuffered()
+
+		// Skip the `hash` keyword
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		if !p.current.Is(lexer.TokenString) {
+			panic(fmt.Errorf(
+				"unexpected token in import declaration: got %s, expected hash string",
+				p.current.Type,
+			))
+		}
+
+		hashPos = p.current.StartPos
+		endPos = p.current.EndPos
+
+		parsedString, errs := parseStringLiteral(p.current.Value.(string))
+		p.report(errs...)
+
+		var err error
+		hash, err = hex.DecodeString(parsedString)
+		if err != nil {
+			p.report(&SyntaxError{
+				Message: fmt.Sprintf("invalid import hash: %s", err),
+				Pos:     hashPos,
+			})
+		} else if len(hash) != importHashLength {
+			p.report(&SyntaxError{
+				Message: fmt.Sprintf(
+					"invalid import hash: expected %d bytes, got %d",
+					importHashLength,
+					len(hash),
+				),
+				Pos: hashPos,
+			})
+		}
+
+		// Skip the hash
+		p.next()
+	}
+
 	// Skip the `import` keyword
 	p.next()
 	p.skipSpaceAndComments(true)
@@ -559,14 +727,18 @@ func parseImportDeclaration(p *parser) *ast.ImportDeclaration {
 		))
 	}
 
+	maybeParseHash()
+
 	return &ast.ImportDeclaration{
 		Identifiers: identifiers,
 		Location:    location,
+		Hash:        hash,
 		Range: ast.Range{
 			StartPos: startPosition,
 			EndPos:   endPos,
 		},
 		LocationPos: locationPos,
+		HashPos:     hashPos,
 	}
 }
 
@@ -970,6 +1142,17 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration
 				continue
 
 			default:
+				// The `view` keyword is contextual:
+				// it is only a purity annotation if it is followed by the `fun` keyword,
+				// otherwise it is the identifier of a field
+
+				if p.current.Value == keywordView &&
+					previousIdentifierToken == nil &&
+					isNextTokenFunKeyword(p) {
+
+					return parseFunctionDeclaration(p, functionBlockIsOptional, access, accessPos, docString)
+				}
+
 				if previousIdentifierToken != nil {
 					panic(fmt.Errorf("unexpected %s", p.current.Type))
 				}
@@ -19,8 +19,10 @@
 package parser2
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -454,6 +456,77 @@ func TestParseParameterList(t *testing.T) {
 		)
 	})
 
+	t.Run("two, with default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parse("( a : Int , b : Int = 1 )")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.ParameterList{
+				Parameters: []*ast.Parameter{
+					{
+						Label: "",
+						Identifier: ast.Identifier{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+						},
+						TypeAnnotation: &ast.TypeAnnotation{
+							IsResource: false,
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 6, Offset: 6},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 2, Offset: 2},
+							EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					{
+						Label: "",
+						Identifier: ast.Identifier{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 12, Offset: 12},
+						},
+						TypeAnnotation: &ast.TypeAnnotation{
+							IsResource: false,
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+						DefaultArgument: &ast.IntegerExpression{
+							PositiveLiteral: "1",
+							Value:           big.NewInt(1),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 22, Offset: 22},
+								EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+				},
+			},
+			result,
+		)
+	})
+
 	t.Run("two, with and without argument label, missing comma", func(t *testing.T) {
 
 		t.Parallel()
@@ -956,6 +1029,137 @@ func TestParseFunctionDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub view fun foo () { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Access: ast.AccessPublic,
+					Purity: ast.FunctionPurityView,
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+							EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+								EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("view, without access modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("view fun foo () { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Purity: ast.FunctionPurityView,
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+							EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+								EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("view, as field name", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("struct S { let view: Int; view: Int }")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, result[0])
+
+		fields := result[0].(*ast.CompositeDeclaration).Members.Fields()
+		require.Len(t, fields, 2)
+		require.Equal(t, "view", fields[0].Identifier.Identifier)
+		require.Equal(t, "view", fields[1].Identifier.Identifier)
+	})
+
+	t.Run("view, member function", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("struct S { pub view fun foo() {} }")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, result[0])
+
+		functions := result[0].(*ast.CompositeDeclaration).Members.Functions()
+		require.Len(t, functions, 1)
+		require.Equal(t, ast.AccessPublic, functions[0].Access)
+		require.Equal(t, ast.FunctionPurityView, functions[0].Purity)
+		require.Equal(t,
+			ast.Position{Line: 1, Column: 11, Offset: 11},
+			functions[0].StartPos,
+		)
+	})
 }
 
 func TestParseAccess(t *testing.T) {
@@ -4102,6 +4306,134 @@ func TestParseImportWithFromIdentifier(t *testing.T) {
 	)
 }
 
+func TestParseImportWithHash(t *testing.T) {
+
+	t.Parallel()
+
+	hash := strings.Repeat("cafe", 16)
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(fmt.Sprintf(` import Foo from 0x1 hash "%s"`, hash))
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "Foo",
+							Pos:        ast.Position{Offset: 8, Line: 1, Column: 8},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x1}),
+					},
+					Hash: bytes.Repeat([]byte{0xca, 0xfe}, 16),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 1, Line: 1, Column: 1},
+						EndPos:   ast.Position{Offset: 91, Line: 1, Column: 91},
+					},
+					LocationPos: ast.Position{Offset: 17, Line: 1, Column: 17},
+					HashPos:     ast.Position{Offset: 26, Line: 1, Column: 26},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("identifier location", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(fmt.Sprintf(` import Foo hash "%s"`, hash))
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Location: common.IdentifierLocation("Foo"),
+					Hash:     bytes.Repeat([]byte{0xca, 0xfe}, 16),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 1, Line: 1, Column: 1},
+						EndPos:   ast.Position{Offset: 82, Line: 1, Column: 82},
+					},
+					LocationPos: ast.Position{Offset: 8, Line: 1, Column: 8},
+					HashPos:     ast.Position{Offset: 17, Line: 1, Column: 17},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid hex", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import Foo from 0x1 hash "xyz"`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: encoding/hex: invalid byte: U+0078 'x'",
+					Pos:     ast.Position{Offset: 26, Line: 1, Column: 26},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import Foo from 0x1 hash "cafe"`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: expected 32 bytes, got 2",
+					Pos:     ast.Position{Offset: 26, Line: 1, Column: 26},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(` import Foo from 0x1 hash`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in import declaration: got EOF, expected hash string",
+					Pos:     ast.Position{Offset: 25, Line: 1, Column: 25},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("docstring of next declaration is preserved", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(`
+          import Foo from 0x1
+
+          /// test
+          fun foo() {}
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+		require.Nil(t, result[0].(*ast.ImportDeclaration).Hash)
+		require.Equal(t, " test", result[1].DeclarationDocString())
+	})
+}
+
 func TestParseSemicolonsBetweenDeclarations(t *testing.T) {
 
 	t.Parallel()
@@ -764,7 +764,22 @@ func defineIdentifierExpression() {
 				}
 
 			case keywordFun:
-				return parseFunctionExpression(p, token)
+				return parseFunctionExpression(p, ast.FunctionPurityUnspecified, token)
+
+			case keywordView:
+				// The `view` keyword is contextual:
+				// it is only a purity annotation if it is followed by the `fun` keyword
+				if !isFunKeywordAhead(p) {
+					return &ast.IdentifierExpression{
+						Identifier: tokenToIdentifier(token),
+					}
+				}
+
+				// Skip the `fun` keyword
+				p.skipSpaceAndComments(true)
+				p.next()
+
+				return parseFunctionExpression(p, ast.FunctionPurityView, token)
 
 			default:
 				return &ast.IdentifierExpression{
@@ -775,12 +790,13 @@ func defineIdentifierExpression() {
 	})
 }
 
-func parseFunctionExpression(p *parser, token lexer.Token) *ast.FunctionExpression {
+func parseFunctionExpression(p *parser, purity ast.FunctionPurity, token lexer.Token) *ast.FunctionExpression {
 
 	parameterList, returnTypeAnnotation, functionBlock :=
 		parseFunctionParameterListAndRest(p, false)
 
 	return &ast.FunctionExpression{
+		Purity:               purity,
 		ParameterList:        parameterList,
 		ReturnTypeAnnotation: returnTypeAnnotation,
 		FunctionBlock:        functionBlock,
@@ -966,10 +982,34 @@ func parseArgument(p *parser) *ast.Argument {
 func defineNestedExpression() {
 	setExprNullDenotation(
 		lexer.TokenParenOpen,
-		func(p *parser, token lexer.Token) ast.Expression {
+		func(p *parser, startToken lexer.Token) ast.Expression {
 			expression := parseExpression(p, lowestBindingPower)
-			p.mustOne(lexer.TokenParenClose)
-			return expression
+
+			// If the expression is followed by a comma,
+			// it is the first element of a tuple expression
+
+			if !p.current.Is(lexer.TokenComma) {
+				p.mustOne(lexer.TokenParenClose)
+				return expression
+			}
+
+			elements := []ast.Expression{expression}
+
+			for p.current.Is(lexer.TokenComma) {
+				p.mustOne(lexer.TokenComma)
+				element := parseExpression(p, lowestBindingPower)
+				elements = append(elements, element)
+			}
+
+			endToken := p.mustOne(lexer.TokenParenClose)
+
+			return &ast.TupleExpression{
+				Elements: elements,
+				Range: ast.Range{
+					StartPos: startToken.StartPos,
+					EndPos:   endToken.EndPos,
+				},
+			}
 		},
 	)
 }
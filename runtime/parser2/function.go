@@ -151,13 +151,28 @@ func parseParameter(p *parser) *ast.Parameter {
 
 	endPos := typeAnnotation.EndPosition()
 
+	// The type annotation may be followed by an equal sign
+	// and the default argument
+
+	var defaultArgument ast.Expression
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenEqual) {
+		// Skip the equal sign
+		p.next()
+
+		defaultArgument = parseExpression(p, lowestBindingPower)
+		endPos = defaultArgument.EndPosition()
+	}
+
 	return &ast.Parameter{
 		Label: argumentLabel,
 		Identifier: ast.Identifier{
 			Identifier: parameterName,
 			Pos:        parameterPos,
 		},
-		TypeAnnotation: typeAnnotation,
+		TypeAnnotation:  typeAnnotation,
+		DefaultArgument: defaultArgument,
 		Range: ast.Range{
 			StartPos: startPos,
 			EndPos:   endPos,
@@ -165,6 +180,56 @@ func parseParameter(p *parser) *ast.Parameter {
 	}
 }
 
+// parsePurityAnnotation parses an optional purity annotation
+// of a function declaration or function expression.
+//
+//     purity : 'view'?
+//
+func parsePurityAnnotation(p *parser) ast.FunctionPurity {
+
+	// The `view` keyword is contextual:
+	// it is only a purity annotation if it is followed by the `fun` keyword
+
+	if !p.current.IsString(lexer.TokenIdentifier, keywordView) ||
+		!isNextTokenFunKeyword(p) {
+
+		return ast.FunctionPurityUnspecified
+	}
+
+	// Skip the `view` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	return ast.FunctionPurityView
+}
+
+// isNextTokenFunKeyword reports whether the token following the current token,
+// ignoring whitespace and comments, is the `fun` keyword.
+//
+func isNextTokenFunKeyword(p *parser) bool {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	// skip the current token
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// Lookahead the next token
+	return p.current.IsString(lexer.TokenIdentifier, keywordFun)
+}
+
+// isFunKeywordAhead reports whether the current token,
+// ignoring whitespace and comments, is the `fun` keyword.
+//
+func isFunKeywordAhead(p *parser) bool {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	p.skipSpaceAndComments(true)
+
+	return p.current.IsString(lexer.TokenIdentifier, keywordFun)
+}
+
 func parseFunctionDeclaration(
 	p *parser,
 	functionBlockIsOptional bool,
@@ -178,6 +243,8 @@ func parseFunctionDeclaration(
 		startPos = *accessPos
 	}
 
+	purity := parsePurityAnnotation(p)
+
 	// Skip the `fun` keyword
 	p.next()
 
@@ -199,6 +266,7 @@ func parseFunctionDeclaration(
 
 	return &ast.FunctionDeclaration{
 		Access:               access,
+		Purity:               purity,
 		Identifier:           identifier,
 		ParameterList:        parameterList,
 		ReturnTypeAnnotation: returnTypeAnnotation,
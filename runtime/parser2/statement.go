@@ -95,10 +95,23 @@ func parseStatement(p *parser) ast.Statement {
 			return parseForStatement(p)
 		case keywordEmit:
 			return parseEmitStatement(p)
+		case keywordLet, keywordVar:
+			// A variable declaration keyword followed by an opening parenthesis
+			// introduces a tuple variable declaration.
+			// Otherwise, it is parsed as a declaration below
+			if isNextTokenParenOpen(p) {
+				return parseTupleVariableDeclaration(p)
+			}
 		case keywordFun:
 			// The `fun` keyword is ambiguous: it either introduces a function expression
 			// or a function declaration, depending on if an identifier follows, or not.
 			return parseFunctionDeclarationOrFunctionExpressionStatement(p)
+		case keywordView:
+			// The `view` keyword is contextual:
+			// it is only a purity annotation if it is followed by the `fun` keyword
+			if isNextTokenFunKeyword(p) {
+				return parseFunctionDeclarationOrFunctionExpressionStatement(p)
+			}
 		}
 	}
 
@@ -152,6 +165,8 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) ast.Statem
 
 	startPos := p.current.StartPos
 
+	purity := parsePurityAnnotation(p)
+
 	// Skip the `fun` keyword
 	p.next()
 
@@ -167,6 +182,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) ast.Statem
 
 		return &ast.FunctionDeclaration{
 			Access:               ast.AccessNotSpecified,
+			Purity:               purity,
 			Identifier:           identifier,
 			ParameterList:        parameterList,
 			ReturnTypeAnnotation: returnTypeAnnotation,
@@ -179,6 +195,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) ast.Statem
 
 		return &ast.ExpressionStatement{
 			Expression: &ast.FunctionExpression{
+				Purity:               purity,
 				ParameterList:        parameterList,
 				ReturnTypeAnnotation: returnTypeAnnotation,
 				FunctionBlock:        functionBlock,
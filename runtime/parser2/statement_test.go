@@ -1032,6 +1032,50 @@ func TestParseFunctionStatementOrExpression(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("view function declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("view fun foo() {}")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.FunctionDeclaration{}, result[0])
+
+		declaration := result[0].(*ast.FunctionDeclaration)
+		require.Equal(t, ast.FunctionPurityView, declaration.Purity)
+		require.Equal(t, "foo", declaration.Identifier.Identifier)
+	})
+
+	t.Run("view function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("view fun () {}")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.ExpressionStatement{}, result[0])
+
+		expression := result[0].(*ast.ExpressionStatement).Expression
+		require.IsType(t, &ast.FunctionExpression{}, expression)
+		require.Equal(t,
+			ast.FunctionPurityView,
+			expression.(*ast.FunctionExpression).Purity,
+		)
+	})
+
+	t.Run("view, as identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("view = 1")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.AssignmentStatement{}, result[0])
+	})
 }
 
 func TestParseStatements(t *testing.T) {
@@ -2405,3 +2449,132 @@ func TestParseSwapStatementInFunctionDeclaration(t *testing.T) {
 		result.Declarations(),
 	)
 }
+
+func TestParseTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("move, with type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("let (a, b): @(Int, Int) <- x")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TupleVariableDeclaration{
+					IsConstant: true,
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+						{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						IsResource: true,
+						Type: &ast.TupleType{
+							ElementTypeAnnotations: []*ast.TypeAnnotation{
+								{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+								},
+								{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 19, Offset: 19},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 19, Offset: 19},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+								EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationMove,
+						Pos:       ast.Position{Line: 1, Column: 24, Offset: 24},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 27, Offset: 27},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("var (a, b) = x")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TupleVariableDeclaration{
+					IsConstant: false,
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+						{
+							Identifier: "b",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, one identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("let (a) = x")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at least two identifiers in tuple variable declaration, got 1",
+					Pos:     ast.Position{Line: 1, Column: 7, Offset: 7},
+				},
+			},
+			errs,
+		)
+	})
+}
@@ -145,7 +145,7 @@ func init() {
 	defineOptionalType()
 	defineReferenceType()
 	defineRestrictedOrDictionaryType()
-	defineFunctionType()
+	defineFunctionAndTupleType()
 	defineInstantiationType()
 
 	setTypeNullDenotation(
@@ -569,15 +569,49 @@ func parseNominalTypes(
 	return
 }
 
-func defineFunctionType() {
+func defineFunctionAndTupleType() {
 	setTypeNullDenotation(
 		lexer.TokenParenOpen,
 		func(p *parser, startToken lexer.Token) ast.Type {
 
+			// A function type starts with an optional purity annotation,
+			// followed by the parameter list, i.e. a second opening parenthesis.
+			// Otherwise, the type is a tuple type
+
+			p.skipSpaceAndComments(true)
+
+			purity := ast.FunctionPurityUnspecified
+			if p.current.IsString(lexer.TokenIdentifier, keywordView) &&
+				isNextTokenParenOpen(p) {
+
+				// Skip the `view` keyword
+				p.next()
+				p.skipSpaceAndComments(true)
+
+				purity = ast.FunctionPurityView
+			}
+
+			// Without a purity annotation, a second opening parenthesis may also start
+			// the first element type of a tuple type, e.g. `((Int, Int), String)`
+			// or `(((Int): Int), String)`.
+			// It is only the parameter list if it is followed by a colon
+
+			if !p.current.Is(lexer.TokenParenOpen) ||
+				(purity == ast.FunctionPurityUnspecified && !isFunctionTypeParameterList(p)) {
+
+				return parseTupleType(p, startToken)
+			}
+
 			parameterTypeAnnotations := parseParameterTypeAnnotations(p)
 
 			p.skipSpaceAndComments(true)
-			p.mustOne(lexer.TokenColon)
+			if !p.current.Is(lexer.TokenColon) {
+				panic(fmt.Errorf(
+					"expected token %s after parameter list of view function type",
+					lexer.TokenColon,
+				))
+			}
+			p.next()
 
 			p.skipSpaceAndComments(true)
 			returnTypeAnnotation := parseTypeAnnotation(p)
@@ -586,6 +620,7 @@ func defineFunctionType() {
 			endToken := p.mustOne(lexer.TokenParenClose)
 
 			return &ast.FunctionType{
+				Purity:                   purity,
 				ParameterTypeAnnotations: parameterTypeAnnotations,
 				ReturnTypeAnnotation:     returnTypeAnnotation,
 				Range: ast.Range{
@@ -597,6 +632,68 @@ func defineFunctionType() {
 	)
 }
 
+// isFunctionTypeParameterList returns true if the current opening parenthesis
+// starts a parameter list which is followed by a colon, i.e. the parameter list of a function type.
+//
+func isFunctionTypeParameterList(p *parser) (result bool) {
+	p.startBuffering()
+	defer p.replayBuffered()
+
+	// The parse may fail, e.g. if the parenthesis starts a tuple type,
+	// in which case we just ignore the error
+
+	defer func() {
+		_ = recover()
+	}()
+
+	parseParameterTypeAnnotations(p)
+	p.skipSpaceAndComments(true)
+
+	return p.current.Is(lexer.TokenColon)
+}
+
+// parseTupleType parses a tuple type.
+// The opening parenthesis was already consumed.
+//
+//     tupleType : '(' typeAnnotation ( ',' typeAnnotation )+ ')'
+//
+func parseTupleType(p *parser, startToken lexer.Token) ast.Type {
+
+	elementTypeAnnotations := []*ast.TypeAnnotation{
+		parseTypeAnnotation(p),
+	}
+
+	p.skipSpaceAndComments(true)
+
+	for p.current.Is(lexer.TokenComma) {
+		// Skip the comma
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		elementTypeAnnotation := parseTypeAnnotation(p)
+		elementTypeAnnotations = append(elementTypeAnnotations, elementTypeAnnotation)
+
+		p.skipSpaceAndComments(true)
+	}
+
+	endToken := p.mustOne(lexer.TokenParenClose)
+
+	if len(elementTypeAnnotations) < 2 {
+		panic(fmt.Errorf(
+			"expected at least two element types in tuple type, got %d",
+			len(elementTypeAnnotations),
+		))
+	}
+
+	return &ast.TupleType{
+		ElementTypeAnnotations: elementTypeAnnotations,
+		Range: ast.Range{
+			StartPos: startToken.StartPos,
+			EndPos:   endToken.EndPos,
+		},
+	}
+}
+
 func parseParameterTypeAnnotations(p *parser) (typeAnnotations []*ast.TypeAnnotation) {
 
 	p.skipSpaceAndComments(true)
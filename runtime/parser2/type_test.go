@@ -1747,6 +1747,73 @@ func TestParseIntegerTypes(t *testing.T) {
 		[]ast.Declaration{a, b, c, d, e, f, g, h},
 		result.Declarations(),
 	)
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(view (Int): Bool)")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.FunctionType{
+				Purity: ast.FunctionPurityView,
+				ParameterTypeAnnotations: []*ast.TypeAnnotation{
+					{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Int",
+								Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+				},
+				ReturnTypeAnnotation: &ast.TypeAnnotation{
+					IsResource: false,
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "Bool",
+							Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 17, Offset: 17},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("view, as type name in tuple type", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(view, Int)")
+		require.Empty(t, errs)
+
+		require.IsType(t, &ast.TupleType{}, result)
+	})
+
+	t.Run("view, missing return type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseType("(view (Int, Int))")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token ':' after parameter list of view function type",
+					Pos:     ast.Position{Offset: 16, Line: 1, Column: 16},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseFunctionTypeInVariableDeclaration(t *testing.T) {
@@ -2779,3 +2846,204 @@ func TestParseConstantSizedSizedArrayWithTrailingUnderscoreSize(t *testing.T) {
 		errs,
 	)
 }
+
+func TestParseTupleType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("two elements", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(Int, @R)")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TupleType{
+				ElementTypeAnnotations: []*ast.TypeAnnotation{
+					{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Int",
+								Pos:        ast.Position{Line: 1, Column: 1, Offset: 1},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+					},
+					{
+						IsResource: true,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "R",
+								Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("((Int, String), Bool)")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TupleType{
+				ElementTypeAnnotations: []*ast.TypeAnnotation{
+					{
+						IsResource: false,
+						Type: &ast.TupleType{
+							ElementTypeAnnotations: []*ast.TypeAnnotation{
+								{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 2, Offset: 2},
+								},
+								{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "String",
+											Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+								EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+					},
+					{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Bool",
+								Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("function type element", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(((Int): Int), String)")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.TupleType{
+				ElementTypeAnnotations: []*ast.TypeAnnotation{
+					{
+						IsResource: false,
+						Type: &ast.FunctionType{
+							ParameterTypeAnnotations: []*ast.TypeAnnotation{
+								{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 3, Offset: 3},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+								},
+							},
+							ReturnTypeAnnotation: &ast.TypeAnnotation{
+								IsResource: false,
+								Type: &ast.NominalType{
+									Identifier: ast.Identifier{
+										Identifier: "Int",
+										Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+									},
+								},
+								StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+								EndPos:   ast.Position{Line: 1, Column: 12, Offset: 12},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+					},
+					{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "String",
+								Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("function type with tuple type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(((Int, String)): Int)")
+		require.Empty(t, errs)
+
+		require.IsType(t, &ast.FunctionType{}, result)
+		functionType := result.(*ast.FunctionType)
+
+		require.Len(t, functionType.ParameterTypeAnnotations, 1)
+		require.IsType(t, &ast.TupleType{}, functionType.ParameterTypeAnnotations[0].Type)
+	})
+
+	t.Run("invalid, one element", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("(Int)")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected at least two element types in tuple type, got 1",
+					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			errs,
+		)
+
+		require.Nil(t, result)
+	})
+}
@@ -52,6 +52,8 @@ func NewREPL(
 		stdlib.BuiltinFunctions...,
 	)
 
+	builtinValues := stdlib.BuiltinValues()
+
 	checkers := map[common.LocationID]*sema.Checker{}
 	codes := map[common.LocationID]string{}
 
@@ -59,7 +61,12 @@ func NewREPL(
 
 	checkerOptions = append(
 		[]sema.Option{
-			sema.WithPredeclaredValues(valueDeclarations.ToSemaValueDeclarations()),
+			sema.WithPredeclaredValues(
+				append(
+					valueDeclarations.ToSemaValueDeclarations(),
+					builtinValues.ToSemaValueDeclarations()...,
+				),
+			),
 			sema.WithPredeclaredTypes(typeDeclarations),
 			sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
 			sema.WithImportHandler(
@@ -105,7 +112,10 @@ func NewREPL(
 		return nil, err
 	}
 
-	values := valueDeclarations.ToInterpreterValueDeclarations()
+	values := append(
+		valueDeclarations.ToInterpreterValueDeclarations(),
+		builtinValues.ToInterpreterValueDeclarations()...,
+	)
 
 	var uuid uint64
 
@@ -115,6 +125,41 @@ func NewREPL(
 		[]interpreter.Option{
 			interpreter.WithStorage(storage),
 			interpreter.WithPredeclaredValues(values),
+			interpreter.WithHashHandler(
+				func(
+					inter *interpreter.Interpreter,
+					getLocationRange func() interpreter.LocationRange,
+					data *interpreter.ArrayValue,
+					tag *interpreter.StringValue,
+					hashAlgorithm interpreter.MemberAccessibleValue,
+				) *interpreter.ArrayValue {
+					return hash(
+						inter,
+						getLocationRange,
+						data,
+						tag,
+						hashAlgorithm,
+						stdlib.DefaultHash,
+					)
+				},
+			),
+			interpreter.WithRecoverSecp256k1PublicKeyHandler(
+				func(
+					inter *interpreter.Interpreter,
+					getLocationRange func() interpreter.LocationRange,
+					signature *interpreter.ArrayValue,
+					hash *interpreter.ArrayValue,
+				) interpreter.OptionalValue {
+					return recoverSecp256k1PublicKey(
+						inter,
+						getLocationRange,
+						signature,
+						hash,
+						stdlib.DefaultRecoverSecp256k1PublicKey,
+						nil,
+					)
+				},
+			),
 			interpreter.WithUUIDHandler(func() (uint64, error) {
 				defer func() { uuid++ }()
 				return uuid, nil
@@ -0,0 +1,56 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestREPLHash(t *testing.T) {
+
+	t.Parallel()
+
+	var results []interpreter.Value
+
+	repl, err := NewREPL(
+		func(err error, _ common.Location, _ map[common.LocationID]string) {
+			require.NoError(t, err)
+		},
+		func(value interpreter.Value) {
+			results = append(results, value)
+		},
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	repl.Accept(`String.encodeHex(HashAlgorithm.KECCAK_256.hash([]))`)
+
+	require.Len(t, results, 1)
+	assert.Equal(t,
+		interpreter.NewStringValue("c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"),
+		results[0],
+	)
+}
@@ -0,0 +1,165 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/stdlib"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeRLP(t *testing.T) {
+
+	t.Parallel()
+
+	executeScript := func(code string, runtimeInterface *testRuntimeInterface) (cadence.Value, error) {
+		runtime := newTestInterpreterRuntime()
+
+		runtimeInterface.storage = newTestLedger(nil, nil)
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: []byte(code),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  utils.TestLocation,
+			},
+		)
+	}
+
+	t.Run("encode", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(
+			`
+              pub fun main(): String {
+                  let item: [AnyStruct] = [
+                      "cat".utf8,
+                      "dog".utf8,
+                      [] as [AnyStruct],
+                      [[0x80] as [UInt8]]
+                  ]
+                  return String.encodeHex(RLP.encode(item))
+              }
+            `,
+			&testRuntimeInterface{},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.String("cc8363617483646f67c0c28180"),
+			result,
+		)
+	})
+
+	t.Run("decode", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(
+			`
+              pub fun main(): [String] {
+                  let list = RLP.decode("cc8363617483646f67c0c28180".decodeHex()) as! [AnyStruct]
+                  let nested = list[3] as! [AnyStruct]
+                  return [
+                      String.fromUTF8(list[0] as! [UInt8])!,
+                      String.fromUTF8(list[1] as! [UInt8])!,
+                      (list[2] as! [AnyStruct]).length.toString(),
+                      String.encodeHex(nested[0] as! [UInt8])
+                  ]
+              }
+            `,
+			&testRuntimeInterface{},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.String("cat"),
+				cadence.String("dog"),
+				cadence.String("0"),
+				cadence.String("80"),
+			}),
+			result,
+		)
+	})
+
+	t.Run("encode invalid item", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := executeScript(
+			`
+              pub fun main() {
+                  RLP.encode([1, 2, 3])
+              }
+            `,
+			&testRuntimeInterface{},
+		)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &stdlib.RLPError{})
+	})
+
+	t.Run("decode invalid input", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := executeScript(
+			`
+              pub fun main() {
+                  RLP.decode("8101".decodeHex())
+              }
+            `,
+			&testRuntimeInterface{},
+		)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &stdlib.RLPError{})
+	})
+
+	t.Run("decode is metered", func(t *testing.T) {
+
+		t.Parallel()
+
+		const inputLength = 100
+
+		_, err := executeScript(
+			`
+              pub fun main() {
+                  RLP.decode("b864`+strings.Repeat("00", inputLength)+`".decodeHex())
+              }
+            `,
+			&testRuntimeInterface{
+				computationLimit: inputLength / 2,
+			},
+		)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &ComputationLimitExceededError{})
+	})
+}
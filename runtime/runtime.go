@@ -19,6 +19,7 @@
 package runtime
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
@@ -95,6 +96,9 @@ type Runtime interface {
 	// SetResourceOwnerChangeCallbackEnabled configures if the resource owner change callback is enabled.
 	SetResourceOwnerChangeHandlerEnabled(enabled bool)
 
+	// SetReadOnlyScriptAuthAccounts configures if the auth accounts scripts can access are read-only.
+	SetReadOnlyScriptAuthAccounts(readOnly bool)
+
 	// ReadStored reads the value stored at the given path
 	//
 	ReadStored(address common.Address, path cadence.Path, context Context) (cadence.Value, error)
@@ -160,6 +164,7 @@ type interpreterRuntime struct {
 	atreeValidationEnabled            bool
 	tracingEnabled                    bool
 	resourceOwnerChangeHandlerEnabled bool
+	readOnlyScriptAuthAccounts        bool
 }
 
 type Option func(Runtime)
@@ -200,6 +205,16 @@ func WithResourceOwnerChangeCallbackEnabled(enabled bool) Option {
 	}
 }
 
+// WithReadOnlyScriptAuthAccounts returns a runtime option
+// that configures if the auth accounts returned by the function `getAuthAccount`
+// in scripts are read-only.
+//
+func WithReadOnlyScriptAuthAccounts(readOnly bool) Option {
+	return func(runtime Runtime) {
+		runtime.SetReadOnlyScriptAuthAccounts(readOnly)
+	}
+}
+
 // NewInterpreterRuntime returns a interpreter-based version of the Flow runtime.
 func NewInterpreterRuntime(options ...Option) Runtime {
 	runtime := &interpreterRuntime{}
@@ -229,6 +244,10 @@ func (r *interpreterRuntime) SetResourceOwnerChangeHandlerEnabled(enabled bool)
 	r.resourceOwnerChangeHandlerEnabled = enabled
 }
 
+func (r *interpreterRuntime) SetReadOnlyScriptAuthAccounts(readOnly bool) {
+	r.readOnlyScriptAuthAccounts = readOnly
+}
+
 func (r *interpreterRuntime) ExecuteScript(script Script, context Context) (cadence.Value, error) {
 	context.InitializeCodesAndPrograms()
 
@@ -460,6 +479,67 @@ func (r *interpreterRuntime) newAuthAccountValue(
 	)
 }
 
+func (r *interpreterRuntime) newReadOnlyAuthAccountValue(
+	addressValue interpreter.AddressValue,
+	context Context,
+	storage *Storage,
+) interpreter.Value {
+	return interpreter.NewReadOnlyAuthAccountValue(
+		addressValue,
+		accountBalanceGetFunction(addressValue, context.Interface),
+		accountAvailableBalanceGetFunction(addressValue, context.Interface),
+		storageUsedGetFunction(addressValue, context.Interface, storage),
+		storageCapacityGetFunction(addressValue, context.Interface),
+		func() interpreter.Value {
+			return interpreter.NewAuthAccountContractsValue(
+				addressValue,
+				interpreter.NewReadOnlyAccountFunction(
+					addressValue,
+					sema.AuthAccountContractsTypeAddFunctionName,
+					sema.AuthAccountContractsTypeAddFunctionType,
+				),
+				interpreter.NewReadOnlyAccountFunction(
+					addressValue,
+					sema.AuthAccountContractsTypeUpdateExperimentalFunctionName,
+					sema.AuthAccountContractsTypeUpdateExperimentalFunctionType,
+				),
+				r.newAccountContractsGetFunction(
+					addressValue,
+					context.Interface,
+				),
+				interpreter.NewReadOnlyAccountFunction(
+					addressValue,
+					sema.AuthAccountContractsTypeRemoveFunctionName,
+					sema.AuthAccountContractsTypeRemoveFunctionType,
+				),
+				r.newAccountContractsGetNamesFunction(
+					addressValue,
+					context.Interface,
+				),
+			)
+		},
+		func() interpreter.Value {
+			return interpreter.NewAuthAccountKeysValue(
+				addressValue,
+				interpreter.NewReadOnlyAccountFunction(
+					addressValue,
+					sema.AccountKeysAddFunctionName,
+					sema.AuthAccountKeysTypeAddFunctionType,
+				),
+				r.newAccountKeysGetFunction(
+					addressValue,
+					context.Interface,
+				),
+				interpreter.NewReadOnlyAccountFunction(
+					addressValue,
+					sema.AccountKeysRevokeFunctionName,
+					sema.AuthAccountKeysTypeRevokeFunctionType,
+				),
+			)
+		},
+	)
+}
+
 func (r *interpreterRuntime) InvokeContractFunction(
 	contractLocation common.AddressLocation,
 	functionName string,
@@ -644,12 +724,8 @@ func (r *interpreterRuntime) ExecuteTransaction(script Script, context Context)
 	argumentCount := len(script.Arguments)
 	authorizerCount := len(authorizers)
 
-	transactionParameterCount := len(transactionType.Parameters)
-	if argumentCount != transactionParameterCount {
-		err = InvalidEntryPointParameterCountError{
-			Expected: transactionParameterCount,
-			Actual:   argumentCount,
-		}
+	err = validateArgumentCount(argumentCount, transactionType.Parameters)
+	if err != nil {
 		return newError(err, context)
 	}
 
@@ -759,6 +835,25 @@ func (r *interpreterRuntime) transactionExecutionFunction(
 	}
 }
 
+// validateArgumentCount checks that the given number of arguments
+// is valid for the given entry point parameters.
+//
+// Arguments for trailing parameters with a default argument may be omitted.
+//
+func validateArgumentCount(argumentCount int, parameters []*sema.Parameter) error {
+	parameterCount := len(parameters)
+	requiredArgumentCount := parameterCount - sema.DefaultArgumentCount(parameters)
+
+	if argumentCount > parameterCount || argumentCount < requiredArgumentCount {
+		return InvalidEntryPointParameterCountError{
+			Expected: parameterCount,
+			Actual:   argumentCount,
+		}
+	}
+
+	return nil
+}
+
 func validateArgumentParams(
 	inter *interpreter.Interpreter,
 	runtimeInterface Interface,
@@ -769,19 +864,17 @@ func validateArgumentParams(
 	error,
 ) {
 	argumentCount := len(arguments)
-	parameterCount := len(parameters)
 
-	if argumentCount != parameterCount {
-		return nil, InvalidEntryPointParameterCountError{
-			Expected: parameterCount,
-			Actual:   argumentCount,
-		}
+	err := validateArgumentCount(argumentCount, parameters)
+	if err != nil {
+		return nil, err
 	}
 
 	argumentValues := make([]interpreter.Value, len(arguments))
 
-	// Decode arguments against parameter types
-	for i, parameter := range parameters {
+	// Decode arguments against parameter types.
+	// Parameters without an argument are bound to their default argument
+	for i, parameter := range parameters[:argumentCount] {
 		parameterType := parameter.TypeAnnotation.Type
 		argument := arguments[i]
 
@@ -956,6 +1049,13 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 		context.SetProgram(context.Location, parse)
 	}
 
+	// Verify the hashes of pinned imports
+
+	err = r.checkImportHashes(parse, context)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
 	// Check
 
 	elaboration, err := r.check(parse, context, functions, values, checkerOptions, checkedImports)
@@ -1240,7 +1340,24 @@ func (r *interpreterRuntime) newInterpreter(
 					data,
 					tag,
 					hashAlgorithm,
-					context.Interface,
+					context.Interface.Hash,
+				)
+			},
+		),
+		interpreter.WithRecoverSecp256k1PublicKeyHandler(
+			func(
+				inter *interpreter.Interpreter,
+				getLocationRange func() interpreter.LocationRange,
+				signature *interpreter.ArrayValue,
+				hash *interpreter.ArrayValue,
+			) interpreter.OptionalValue {
+				return recoverSecp256k1PublicKey(
+					inter,
+					getLocationRange,
+					signature,
+					hash,
+					context.Interface.RecoverSecp256k1PublicKey,
+					publicKeyValidator,
 				)
 			},
 		),
@@ -1479,6 +1596,11 @@ func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interfa
 				callStackDepth--
 			},
 		),
+		interpreter.WithOnMeterComputationHandler(
+			func(_ *interpreter.Interpreter, intensity uint64) {
+				checkComputationLimit(intensity)
+			},
+		),
 		interpreter.WithExitHandler(
 			func() error {
 				return runtimeInterface.SetComputationUsed(computationUsed)
@@ -1513,7 +1635,9 @@ func (r *interpreterRuntime) standardLibraryFunctions(
 
 	switch context.Location.(type) {
 	case common.ScriptLocation:
-		// Scripts are read-only, so we can give them access to auth accounts
+		// Scripts are read-only, so we can give them access to auth accounts.
+		// If enabled, the auth accounts are also read-only themselves,
+		// i.e. their storage, keys, and contracts cannot be modified
 		builtins = append(builtins,
 			stdlib.NewStandardLibraryFunction(
 				"getAuthAccount",
@@ -1550,6 +1674,67 @@ func (r *interpreterRuntime) getCode(context Context) (code []byte, err error) {
 	return code, nil
 }
 
+// checkImportHashes ensures that the code of each location imported by
+// an import declaration which is pinned to a hash has the expected hash.
+//
+// An import declaration which resolves to multiple locations
+// requires all of them to have the expected hash.
+//
+func (r *interpreterRuntime) checkImportHashes(program *ast.Program, context Context) error {
+	for _, declaration := range program.ImportDeclarations() {
+		if declaration.Hash == nil {
+			continue
+		}
+
+		var resolvedLocations []ResolvedLocation
+		var err error
+		wrapPanic(func() {
+			resolvedLocations, err = context.Interface.ResolveLocation(
+				declaration.Identifiers,
+				declaration.Location,
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, resolvedLocation := range resolvedLocations {
+			importedLocation := resolvedLocation.Location
+
+			code, err := r.getCode(context.WithLocation(importedLocation))
+			if err != nil {
+				return err
+			}
+
+			actualHash := ImportHash(code)
+
+			if !bytes.Equal(actualHash, declaration.Hash) {
+				return &ImportHashMismatchError{
+					ImportedLocation: importedLocation,
+					ExpectedHash:     declaration.Hash,
+					ActualHash:       actualHash,
+					Range: ast.Range{
+						StartPos: declaration.HashPos,
+						EndPos:   declaration.EndPos,
+					},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ImportHash returns the hash of the given code
+// that an import declaration can be pinned to.
+//
+// It is the same SHA3-256 hash that is exposed for deployed contracts.
+//
+func ImportHash(code []byte) []byte {
+	codeHash := sha3.Sum256(code)
+	return codeHash[:]
+}
+
 // emitEvent converts an event value to native Go types and emits it to the runtime interface.
 func (r *interpreterRuntime) emitEvent(
 	inter *interpreter.Interpreter,
@@ -1615,8 +1800,7 @@ func (r *interpreterRuntime) emitAccountEvent(
 }
 
 func CodeToHashValue(inter *interpreter.Interpreter, code []byte) *interpreter.ArrayValue {
-	codeHash := sha3.Sum256(code)
-	return interpreter.ByteSliceToByteArrayValue(inter, codeHash[:])
+	return interpreter.ByteSliceToByteArrayValue(inter, ImportHash(code))
 }
 
 func (r *interpreterRuntime) newCreateAccountFunction(
@@ -2060,6 +2244,13 @@ func (r *interpreterRuntime) newGetAuthAccountFunction(
 ) interpreter.HostFunction {
 	return func(invocation interpreter.Invocation) interpreter.Value {
 		accountAddress := invocation.Arguments[0].(interpreter.AddressValue)
+		if r.readOnlyScriptAuthAccounts {
+			return r.newReadOnlyAuthAccountValue(
+				accountAddress,
+				context,
+				storage,
+			)
+		}
 		return r.newAuthAccountValue(
 			accountAddress,
 			context,
@@ -3416,7 +3607,7 @@ func hash(
 	dataValue *interpreter.ArrayValue,
 	tagValue *interpreter.StringValue,
 	hashAlgorithmValue interpreter.Value,
-	runtimeInterface Interface,
+	hashFunction func(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error),
 ) *interpreter.ArrayValue {
 
 	data, err := interpreter.ByteArrayValueToByteSlice(dataValue)
@@ -3433,7 +3624,7 @@ func hash(
 
 	var result []byte
 	wrapPanic(func() {
-		result, err = runtimeInterface.Hash(data, tag, hashAlgorithm)
+		result, err = hashFunction(data, tag, hashAlgorithm)
 	})
 	if err != nil {
 		panic(err)
@@ -3441,3 +3632,72 @@ func hash(
 
 	return interpreter.ByteSliceToByteArrayValue(inter, result)
 }
+
+func recoverSecp256k1PublicKey(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	signatureValue *interpreter.ArrayValue,
+	hashValue *interpreter.ArrayValue,
+	recoverFunction func(signature []byte, hash []byte) ([]byte, error),
+	validator interpreter.PublicKeyValidationHandlerFunc,
+) interpreter.OptionalValue {
+
+	signature, err := interpreter.ByteArrayValueToByteSlice(signatureValue)
+	if err != nil {
+		panic(fmt.Errorf("failed to get signature. %w", err))
+	}
+
+	hash, err := interpreter.ByteArrayValueToByteSlice(hashValue)
+	if err != nil {
+		panic(fmt.Errorf("failed to get hash. %w", err))
+	}
+
+	// Validate the lengths, so the host function is only called with well-formed inputs
+
+	if len(signature) != stdlib.Secp256k1SignatureLength {
+		panic(stdlib.InvalidSecp256k1RecoveryInputError{
+			Name:           "signature",
+			ExpectedLength: stdlib.Secp256k1SignatureLength,
+			ActualLength:   len(signature),
+			LocationRange:  getLocationRange(),
+		})
+	}
+
+	if len(hash) != stdlib.Secp256k1HashLength {
+		panic(stdlib.InvalidSecp256k1RecoveryInputError{
+			Name:           "hash",
+			ExpectedLength: stdlib.Secp256k1HashLength,
+			ActualLength:   len(hash),
+			LocationRange:  getLocationRange(),
+		})
+	}
+
+	var key []byte
+	wrapPanic(func() {
+		key, err = recoverFunction(signature, hash)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if key == nil {
+		return interpreter.NilValue{}
+	}
+
+	// The recovered key is a valid point on the curve by construction,
+	// so there is no need to validate it again
+
+	return interpreter.NewSomeValueNonCopying(
+		NewPublicKeyValue(
+			inter,
+			getLocationRange,
+			&PublicKey{
+				PublicKey: key,
+				SignAlgo:  SignatureAlgorithmECDSA_secp256k1,
+				Validated: true,
+				IsValid:   true,
+			},
+			validator,
+		),
+	)
+}
@@ -181,6 +181,7 @@ type testRuntimeInterface struct {
 	bLSVerifyPOP               func(pk *PublicKey, s []byte) (bool, error)
 	aggregateBLSSignatures     func(sigs [][]byte) ([]byte, error)
 	aggregateBLSPublicKeys     func(keys []*PublicKey) (*PublicKey, error)
+	recoverSecp256k1PublicKey  func(signature []byte, hash []byte) ([]byte, error)
 	getAccountContractNames    func(address Address) ([]string, error)
 	recordTrace                func(operation string, location common.Location, duration time.Duration, logs []opentracing.LogRecord)
 }
@@ -403,7 +404,7 @@ func (i *testRuntimeInterface) VerifySignature(
 
 func (i *testRuntimeInterface) Hash(data []byte, tag string, hashAlgorithm HashAlgorithm) ([]byte, error) {
 	if i.hash == nil {
-		return nil, nil
+		return stdlib.DefaultHash(data, tag, hashAlgorithm)
 	}
 	return i.hash(data, tag, hashAlgorithm)
 }
@@ -467,6 +468,13 @@ func (i *testRuntimeInterface) AggregateBLSPublicKeys(keys []*PublicKey) (*Publi
 	return i.aggregateBLSPublicKeys(keys)
 }
 
+func (i *testRuntimeInterface) RecoverSecp256k1PublicKey(signature []byte, hash []byte) ([]byte, error) {
+	if i.recoverSecp256k1PublicKey == nil {
+		return stdlib.DefaultRecoverSecp256k1PublicKey(signature, hash)
+	}
+	return i.recoverSecp256k1PublicKey(signature, hash)
+}
+
 func (i *testRuntimeInterface) GetAccountContractNames(address Address) ([]string, error) {
 	if i.getAccountContractNames == nil {
 		return []string{}, nil
@@ -910,6 +918,26 @@ func TestRuntimeTransactionWithArguments(t *testing.T) {
 			},
 			expectedLogs: []string{"42", `"foo"`},
 		},
+		{
+			label: "Default argument, omitted, with authorizer",
+			script: `
+              transaction(x: Int, y: String = "bar") {
+                prepare(signer: AuthAccount) {
+                  log(signer.address)
+                }
+
+                execute {
+                  log(x)
+                  log(y)
+                }
+              }
+            `,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewInt(42)),
+			},
+			authorizers:  []Address{common.MustBytesToAddress([]byte{42})},
+			expectedLogs: []string{"0x000000000000002a", "42", `"bar"`},
+		},
 		{
 			label: "Invalid bytes",
 			script: `
@@ -1211,6 +1239,44 @@ func TestRuntimeScriptArguments(t *testing.T) {
 			},
 			expectedLogs: []string{"42", `"foo"`},
 		},
+		{
+			name: "Default argument, omitted",
+			script: `
+                pub fun main(x: Int, y: String = "bar") {
+                    log(x)
+                    log(y)
+                }
+            `,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewInt(42)),
+			},
+			expectedLogs: []string{"42", `"bar"`},
+		},
+		{
+			name: "Default argument, given",
+			script: `
+                pub fun main(x: Int, y: String = "bar") {
+                    log(x)
+                    log(y)
+                }
+            `,
+			args: [][]byte{
+				jsoncdc.MustEncode(cadence.NewInt(42)),
+				jsoncdc.MustEncode(cadence.String("foo")),
+			},
+			expectedLogs: []string{"42", `"foo"`},
+		},
+		{
+			name: "Default argument, missing required argument",
+			script: `
+                pub fun main(x: Int, y: String = "bar") {}
+            `,
+			args: nil,
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.ErrorAs(t, err, &InvalidEntryPointParameterCountError{})
+			},
+		},
 		{
 			name: "Invalid bytes",
 			script: `
@@ -3264,28 +3330,27 @@ func TestRuntimeInvokeContractFunction(t *testing.T) {
 		assert.Equal(tt, `"Hello number 42 from 0x0000000000000001"`, loggedMessage)
 	})
 
-	t.Run("function with not enough arguments panics", func(tt *testing.T) {
-		assert.Panics(tt, func() {
-			_, _ = runtime.InvokeContractFunction(
-				common.AddressLocation{
-					Address: addressValue,
-					Name:    "Test",
-				},
-				"helloMultiArg",
-				[]interpreter.Value{
-					interpreter.NewStringValue("number"),
-					interpreter.NewIntValueFromInt64(42),
-				},
-				[]sema.Type{
-					sema.StringType,
-					sema.IntType,
-				},
-				Context{
-					Interface: runtimeInterface,
-					Location:  nextTransactionLocation(),
-				},
-			)
-		})
+	t.Run("function with not enough arguments errors", func(tt *testing.T) {
+		_, err = runtime.InvokeContractFunction(
+			common.AddressLocation{
+				Address: addressValue,
+				Name:    "Test",
+			},
+			"helloMultiArg",
+			[]interpreter.Value{
+				interpreter.NewStringValue("number"),
+				interpreter.NewIntValueFromInt64(42),
+			},
+			[]sema.Type{
+				sema.StringType,
+				sema.IntType,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.ErrorAs(tt, err, &interpreter.ArgumentCountError{})
 	})
 
 	t.Run("function with incorrect argument type errors", func(tt *testing.T) {
@@ -0,0 +1,120 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const ABITypeName = "ABI"
+const ABITypeEncodeFunctionName = "encode"
+const ABITypeDecodeFunctionName = "decode"
+
+// ABIType is the type of the built-in value `ABI`,
+// which provides functions to encode and decode data
+// according to the Solidity contract ABI specification
+//
+var ABIType = func() *CompositeType {
+
+	abiType := &CompositeType{
+		Identifier: ABITypeName,
+		Kind:       common.CompositeKindStructure,
+		importable: false,
+	}
+
+	var members = []*Member{
+		NewPublicFunctionMember(
+			abiType,
+			ABITypeEncodeFunctionName,
+			ABITypeEncodeFunctionType,
+			abiTypeEncodeFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			abiType,
+			ABITypeDecodeFunctionName,
+			ABITypeDecodeFunctionType,
+			abiTypeDecodeFunctionDocString,
+		),
+	}
+
+	abiType.Members = GetMembersAsMap(members)
+	abiType.Fields = getFieldNames(members)
+	return abiType
+}()
+
+var ABITypeEncodeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "values",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					Type: AnyStructType,
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(ByteArrayType),
+}
+
+const abiTypeEncodeFunctionDocString = `
+Returns the ABI encoding of the given values, i.e. the encoding of a tuple of the values.
+
+The ABI type of each value is derived from its run-time type:
+integer types correspond to the integer types of the same size, e.g. UInt64 corresponds to uint64,
+UInt and Int correspond to uint256 and int256,
+Bool corresponds to bool, String corresponds to string,
+[UInt8] corresponds to bytes, [UInt8; N] corresponds to bytesN,
+and other arrays correspond to arrays of the corresponding element type.
+
+The program aborts if a value has a type which has no corresponding ABI type, or is out of range
+`
+
+var ABITypeDecodeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "data",
+			TypeAnnotation: NewTypeAnnotation(ByteArrayType),
+		},
+		{
+			Identifier: "types",
+			TypeAnnotation: NewTypeAnnotation(
+				&VariableSizedType{
+					Type: MetaType,
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&VariableSizedType{
+			Type: AnyStructType,
+		},
+	),
+}
+
+const abiTypeDecodeFunctionDocString = `
+Decodes the given ABI encoded data as a tuple of values of the given types.
+
+The types correspond to ABI types as described for ABI.encode.
+
+The program aborts if a type has no corresponding ABI type, or if the data is invalid
+`
@@ -34,6 +34,8 @@ const AuthAccountSaveField = "save"
 const AuthAccountLoadField = "load"
 const AuthAccountTypeField = "type"
 const AuthAccountCopyField = "copy"
+const AuthAccountCheckField = "check"
+const AuthAccountReplaceField = "replace"
 const AuthAccountBorrowField = "borrow"
 const AuthAccountLinkField = "link"
 const AuthAccountUnlinkField = "unlink"
@@ -41,6 +43,11 @@ const AuthAccountGetCapabilityField = "getCapability"
 const AuthAccountGetLinkTargetField = "getLinkTarget"
 const AuthAccountContractsField = "contracts"
 const AuthAccountKeysField = "keys"
+const AuthAccountStoragePathsField = "storagePaths"
+const AuthAccountPublicPathsField = "publicPaths"
+const AuthAccountPrivatePathsField = "privatePaths"
+const AuthAccountForEachStoredField = "forEachStored"
+const AuthAccountForEachPublicField = "forEachPublic"
 
 // AuthAccountType represents the authorized access to an account.
 // Access to an AuthAccount means having full access to its storage, public keys, and code.
@@ -129,6 +136,18 @@ var AuthAccountType = func() *CompositeType {
 			AuthAccountTypeCopyFunctionType,
 			authAccountTypeCopyFunctionDocString,
 		),
+		NewPublicFunctionMember(
+			authAccountType,
+			AuthAccountCheckField,
+			AuthAccountTypeCheckFunctionType,
+			authAccountTypeCheckFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			authAccountType,
+			AuthAccountReplaceField,
+			AuthAccountTypeReplaceFunctionType,
+			authAccountTypeReplaceFunctionDocString,
+		),
 		NewPublicFunctionMember(
 			authAccountType,
 			AuthAccountBorrowField,
@@ -171,6 +190,42 @@ var AuthAccountType = func() *CompositeType {
 			AuthAccountKeysType,
 			accountTypeKeysFieldDocString,
 		),
+		NewPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountStoragePathsField,
+			&VariableSizedType{
+				Type: StoragePathType,
+			},
+			authAccountTypeStoragePathsFieldDocString,
+		),
+		NewPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountPublicPathsField,
+			&VariableSizedType{
+				Type: PublicPathType,
+			},
+			authAccountTypePublicPathsFieldDocString,
+		),
+		NewPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountPrivatePathsField,
+			&VariableSizedType{
+				Type: PrivatePathType,
+			},
+			authAccountTypePrivatePathsFieldDocString,
+		),
+		NewPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachStoredField,
+			AuthAccountTypeForEachStoredFunctionType,
+			authAccountTypeForEachStoredFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachPublicField,
+			AuthAccountTypeForEachPublicFunctionType,
+			authAccountTypeForEachPublicFunctionDocString,
+		),
 	}
 
 	authAccountType.Members = GetMembersAsMap(members)
@@ -293,6 +348,7 @@ The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is al
 `
 
 var AuthAccountTypeTypeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          "at",
@@ -329,6 +385,7 @@ var AuthAccountTypeCopyFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -362,6 +419,86 @@ The given type must not necessarily be exactly the same as the type of the copie
 The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
 `
 
+var AuthAccountTypeCheckFunctionType = func() *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:      "T",
+		TypeBound: StorableType,
+	}
+
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:          "from",
+				Identifier:     "path",
+				TypeAnnotation: NewTypeAnnotation(StoragePathType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+	}
+}()
+
+const authAccountTypeCheckFunctionDocString = `
+Returns true if an object is stored in account storage under the given path and the type of the object is a subtype of the given type, and false otherwise.
+
+The stored object is neither moved nor copied.
+
+The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
+`
+
+var AuthAccountTypeReplaceFunctionType = func() *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:      "T",
+		TypeBound: StorableType,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "value",
+				TypeAnnotation: NewTypeAnnotation(
+					&GenericType{
+						TypeParameter: typeParameter,
+					},
+				),
+			},
+			{
+				Label:          "at",
+				Identifier:     "path",
+				TypeAnnotation: NewTypeAnnotation(StoragePathType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: &GenericType{
+					TypeParameter: typeParameter,
+				},
+			},
+		),
+	}
+}()
+
+const authAccountTypeReplaceFunctionDocString = `
+Saves the given object into the account's storage at the given path, and returns the object previously stored under the given path, or nil if no object was stored under the given path.
+
+Resources are moved into storage, and structures are copied.
+The previously stored resource or structure is moved out of storage.
+
+The given type must be a supertype of the type of the previously stored object.
+If it is not, the program aborts and the storage is left unchanged.
+
+The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
+`
+
 var AuthAccountTypeBorrowFunctionType = func() *FunctionType {
 
 	typeParameter := &TypeParameter{
@@ -372,6 +509,7 @@ var AuthAccountTypeBorrowFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -482,6 +620,7 @@ var AuthAccountTypeGetCapabilityFunctionType = func() *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -507,6 +646,7 @@ Returns the capability at the given private or public path, or nil if it does no
 `
 
 var AccountTypeGetLinkTargetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -521,6 +661,68 @@ var AccountTypeGetLinkTargetFunctionType = &FunctionType{
 	),
 }
 
+const authAccountTypeStoragePathsFieldDocString = `
+All storage paths of the account under which an object is stored, in lexicographical order
+`
+
+const authAccountTypePublicPathsFieldDocString = `
+All public paths of the account under which a capability is linked, in lexicographical order
+`
+
+const authAccountTypePrivatePathsFieldDocString = `
+All private paths of the account under which a capability is linked, in lexicographical order
+`
+
+// accountIterationFunctionType returns the type of a function
+// which iterates over the paths of the given path type in an account.
+//
+func accountIterationFunctionType(pathType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "path",
+								TypeAnnotation: NewTypeAnnotation(pathType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "type",
+								TypeAnnotation: NewTypeAnnotation(MetaType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
+var AuthAccountTypeForEachStoredFunctionType = accountIterationFunctionType(StoragePathType)
+
+const authAccountTypeForEachStoredFunctionDocString = `
+Calls the given function for each object stored in the account, in lexicographical order of the storage paths.
+
+The function is called with the storage path and the type of the stored object.
+Iteration stops when the function returns false
+`
+
+var AuthAccountTypeForEachPublicFunctionType = accountIterationFunctionType(PublicPathType)
+
+const authAccountTypeForEachPublicFunctionDocString = `
+Calls the given function for each capability linked in the public domain of the account, in lexicographical order of the public paths.
+
+The function is called with the public path and the type of the capability.
+Iteration stops when the function returns false
+`
+
 // AuthAccountKeysType represents the keys associated with an auth account.
 var AuthAccountKeysType = func() *CompositeType {
 
@@ -576,6 +778,7 @@ var AuthAccountKeysTypeAddFunctionType = &FunctionType{
 }
 
 var AccountKeysTypeGetFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier:     AccountKeyKeyIndexField,
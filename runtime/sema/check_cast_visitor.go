@@ -96,6 +96,35 @@ func (d *CheckCastVisitor) VisitArrayExpression(expr *ast.ArrayExpression) ast.R
 	return true
 }
 
+func (d *CheckCastVisitor) VisitTupleExpression(expr *ast.TupleExpression) ast.Repr {
+	targetTupleType, ok := d.targetType.(*TupleType)
+	if !ok {
+		return false
+	}
+
+	inferredTupleType, ok := d.exprInferredType.(*TupleType)
+	if !ok ||
+		len(inferredTupleType.ElementTypes) != len(expr.Elements) ||
+		len(targetTupleType.ElementTypes) != len(expr.Elements) {
+
+		return false
+	}
+
+	for i, element := range expr.Elements {
+		// If at-least one element uses the target-type to infer the expression type,
+		// then the casting is not redundant.
+		if !d.IsRedundantCast(
+			element,
+			inferredTupleType.ElementTypes[i],
+			targetTupleType.ElementTypes[i],
+		) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (d *CheckCastVisitor) VisitDictionaryExpression(expr *ast.DictionaryExpression) ast.Repr {
 	targetDictionaryType, ok := d.targetType.(*DictionaryType)
 	if !ok {
@@ -39,14 +39,37 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 	leftHandExpression := expression.Expression
 
 	// In simple casting expression, type annotation is used to infer the type for the expression.
+	//
+	// In failable and force casting expressions, the type annotation is used
+	// to infer the type of literals, so they are converted like in simple casting expressions,
+	// e.g. `1 as? UInt8` is an `UInt8` literal.
+	// However, the expression is not required to have the type.
+	//
+	// Like in simple casting expressions, a literal which is out of range
+	// for the inferred type is a static error, e.g. `300 as? UInt8`:
+	// the cast does not evaluate to nil.
+
 	var expectedType Type
-	if expression.Operation == ast.OperationCast {
+	forceType := true
+
+	switch expression.Operation {
+	case ast.OperationCast:
 		expectedType = rightHandType
+
+	case ast.OperationFailableCast, ast.OperationForceCast:
+		if isConvertibleLiteral(leftHandExpression) {
+			expectedType = rightHandType
+			forceType = false
+		}
 	}
 
 	beforeErrors := len(checker.errors)
 
-	leftHandType, exprActualType := checker.visitExpression(leftHandExpression, expectedType)
+	leftHandType, exprActualType := checker.visitExpressionWithForceType(
+		leftHandExpression,
+		expectedType,
+		forceType,
+	)
 
 	hasErrors := len(checker.errors) > beforeErrors
 
@@ -180,6 +203,25 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 	}
 }
 
+// isConvertibleLiteral returns true if the given expression is a literal
+// which has its type inferred from the contextually expected type,
+// i.e. an integer or fixed-point literal.
+//
+// NOTE: string literals are not converted to characters,
+// as there is no separate run-time representation for characters
+//
+func isConvertibleLiteral(expression ast.Expression) bool {
+	switch expression.(type) {
+	case *ast.IntegerExpression,
+		*ast.FixedPointExpression:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
 // FailableCastCanSucceed checks a failable (dynamic) cast, i.e. a cast that might succeed at run-time.
 // It returns true if the cast from subType to superType could potentially succeed at run-time,
 // and returns false if the cast will definitely always fail.
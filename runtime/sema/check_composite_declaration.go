@@ -804,6 +804,7 @@ func (checker *Checker) declareEnumConstructor(
 func EnumConstructorType(compositeType *CompositeType) *FunctionType {
 	return &FunctionType{
 		IsConstructor: true,
+		Purity:        FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Identifier:     EnumRawValueFieldName,
@@ -1011,6 +1012,8 @@ func (checker *Checker) checkCompositeConformance(
 				InterfaceParameters: interfaceType.InitializerParameters,
 			}
 		}
+
+		checker.checkDefaultArgumentConformance(compositeType.ConstructorParameters, interfaceType)
 	}
 
 	// Determine missing members and member conformance
@@ -1040,6 +1043,12 @@ func (checker *Checker) checkCompositeConformance(
 				},
 			)
 		}
+
+		if compositeFunctionType, ok := compositeMember.TypeAnnotation.Type.(*FunctionType); ok &&
+			compositeMember.DeclarationKind == common.DeclarationKindFunction {
+
+			checker.checkDefaultArgumentConformance(compositeFunctionType.Parameters, interfaceType)
+		}
 	})
 
 	// Determine missing nested composite type definitions
@@ -1083,6 +1092,27 @@ func (checker *Checker) checkCompositeConformance(
 	}
 }
 
+// checkDefaultArgumentConformance checks that the given parameters of a function or initializer,
+// which implements a requirement of the given interface, have no default arguments.
+//
+// Invocations through the interface must provide all arguments,
+// and the interface's conditions can't refer to the implementation's default arguments.
+//
+func (checker *Checker) checkDefaultArgumentConformance(parameters []*Parameter, interfaceType *InterfaceType) {
+	for _, parameter := range parameters {
+		if parameter.DefaultArgument == nil {
+			continue
+		}
+
+		checker.report(
+			&DefaultArgumentConformanceError{
+				InterfaceType: interfaceType,
+				Range:         ast.NewRangeFromPositioned(parameter.DefaultArgument),
+			},
+		)
+	}
+}
+
 // TODO: return proper error
 func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member) bool {
 
@@ -1129,6 +1159,14 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 				return false
 			}
 
+			// A view function requirement must be implemented by a view function
+
+			if interfaceMemberFunctionType.Purity == FunctionPurityView &&
+				compositeMemberFunctionType.Purity != FunctionPurityView {
+
+				return false
+			}
+
 			// Functions are invariant in their parameter types
 
 			for i, subParameter := range compositeMemberFunctionType.Parameters {
@@ -1316,6 +1354,13 @@ func (checker *Checker) compositeConstructorType(
 		ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
 	}
 
+	// Events have no initializer function body,
+	// so constructing an event has no side effects
+
+	if compositeType.Kind == common.CompositeKindEvent {
+		constructorFunctionType.Purity = FunctionPurityView
+	}
+
 	// TODO: support multiple overloaded initializers
 
 	initializers := compositeDeclaration.Members.Initializers()
@@ -1478,7 +1523,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 
 		identifier := function.Identifier.Identifier
 
-		functionType := checker.functionType(function.ParameterList, function.ReturnTypeAnnotation)
+		functionType := checker.functionType(function.Purity, function.ParameterList, function.ReturnTypeAnnotation)
 
 		argumentLabels := function.ParameterList.EffectiveArgumentLabels()
 
@@ -1757,6 +1802,11 @@ func (checker *Checker) checkSpecialFunction(
 
 	switch containerKind {
 	case ContainerKindInterface:
+		checker.reportUnsupportedDefaultArguments(
+			specialFunction.FunctionDeclaration.ParameterList,
+			containerDeclarationKind,
+		)
+
 		if specialFunction.FunctionDeclaration.FunctionBlock != nil {
 
 			checker.checkInterfaceSpecialFunctionBlock(
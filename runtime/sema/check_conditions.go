@@ -40,6 +40,17 @@ func (checker *Checker) visitConditions(conditions []*ast.Condition) {
 	}
 }
 
+func (checker *Checker) visitBeforeStatements(statements []ast.Statement) {
+
+	wasInCondition := checker.inCondition
+	checker.inCondition = true
+	defer func() {
+		checker.inCondition = wasInCondition
+	}()
+
+	checker.visitStatements(statements)
+}
+
 func (checker *Checker) checkCondition(condition *ast.Condition) ast.Repr {
 
 	// check test expression is boolean
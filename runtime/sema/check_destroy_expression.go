@@ -25,6 +25,12 @@ import (
 func (checker *Checker) VisitDestroyExpression(expression *ast.DestroyExpression) (resultType ast.Repr) {
 	resultType = VoidType
 
+	// Destroying a resource invokes its destructor, which might have side effects
+
+	if checker.isViewContext() {
+		checker.reportImpureOperation(ImpureOperationDestroy, expression)
+	}
+
 	valueType := checker.VisitExpression(expression.Expression, nil)
 
 	checker.recordResourceInvalidation(
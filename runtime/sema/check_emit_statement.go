@@ -26,6 +26,10 @@ import (
 func (checker *Checker) VisitEmitStatement(statement *ast.EmitStatement) ast.Repr {
 	invocation := statement.InvocationExpression
 
+	if checker.isViewContext() {
+		checker.reportImpureOperation(ImpureOperationEmit, statement)
+	}
+
 	ty := checker.checkInvocationExpression(invocation)
 
 	if ty.IsInvalidType() {
@@ -31,6 +31,11 @@ func (checker *Checker) checkEventParameters(
 	parameters []*Parameter,
 ) {
 
+	// Events are constructed from the arguments in the order of the parameters,
+	// so default arguments are not supported
+
+	checker.reportUnsupportedDefaultArguments(parameterList, common.DeclarationKindEvent)
+
 	parameterTypeValidationResults := map[*Member]bool{}
 
 	for i, parameter := range parameterList.Parameters {
@@ -65,7 +65,7 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
 	if functionType == nil {
-		functionType = checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+		functionType = checker.functionType(declaration.Purity, declaration.ParameterList, declaration.ReturnTypeAnnotation)
 
 		if options.declareFunction {
 			checker.declareFunctionDeclaration(declaration, functionType)
@@ -230,6 +230,8 @@ func (checker *Checker) checkFunctionExits(functionBlock *ast.FunctionBlock, ret
 }
 
 func (checker *Checker) checkParameters(parameterList *ast.ParameterList, parameters []*Parameter) {
+	hasDefaultArgument := false
+
 	for i, parameter := range parameterList.Parameters {
 		parameterTypeAnnotation := parameters[i].TypeAnnotation
 
@@ -237,6 +239,114 @@ func (checker *Checker) checkParameters(parameterList *ast.ParameterList, parame
 			parameterTypeAnnotation,
 			parameter.TypeAnnotation,
 		)
+
+		// Parameters with a default argument must be trailing,
+		// i.e. all parameters following a parameter with a default argument
+		// must also have a default argument
+
+		if parameter.DefaultArgument != nil {
+			hasDefaultArgument = true
+
+			checker.checkDefaultArgument(parameter.DefaultArgument, parameterTypeAnnotation.Type)
+
+		} else if hasDefaultArgument {
+			checker.report(
+				&MissingDefaultArgumentError{
+					Name:  parameter.Identifier.Identifier,
+					Range: ast.NewRangeFromPositioned(parameter),
+				},
+			)
+		}
+	}
+}
+
+// checkDefaultArgument checks that the given default argument of a parameter
+// is a constant expression of the parameter type.
+//
+// Resources can't be defaulted, as each invocation would create a new resource.
+//
+func (checker *Checker) checkDefaultArgument(defaultArgument ast.Expression, parameterType Type) {
+
+	if parameterType.IsResourceType() {
+		checker.report(
+			&InvalidResourceDefaultArgumentError{
+				Range: ast.NewRangeFromPositioned(defaultArgument),
+			},
+		)
+		return
+	}
+
+	if !isConstantExpression(defaultArgument) {
+		checker.report(
+			&NonConstantDefaultArgumentError{
+				Range: ast.NewRangeFromPositioned(defaultArgument),
+			},
+		)
+		return
+	}
+
+	checker.VisitExpression(defaultArgument, parameterType)
+}
+
+// isConstantExpression returns true if the given expression is a constant expression,
+// i.e. a literal, or an array or dictionary literal of constant expressions.
+//
+func isConstantExpression(expression ast.Expression) bool {
+	switch expression := expression.(type) {
+	case *ast.BoolExpression,
+		*ast.NilExpression,
+		*ast.IntegerExpression,
+		*ast.FixedPointExpression,
+		*ast.StringExpression,
+		*ast.PathExpression:
+
+		return true
+
+	case *ast.ArrayExpression:
+		for _, value := range expression.Values {
+			if !isConstantExpression(value) {
+				return false
+			}
+		}
+		return true
+
+	case *ast.DictionaryExpression:
+		for _, entry := range expression.Entries {
+			if !isConstantExpression(entry.Key) ||
+				!isConstantExpression(entry.Value) {
+
+				return false
+			}
+		}
+		return true
+
+	default:
+		return false
+	}
+}
+
+// reportUnsupportedDefaultArguments reports all default arguments in the given parameter list
+// as unsupported in declarations of the given kind.
+//
+func (checker *Checker) reportUnsupportedDefaultArguments(
+	parameterList *ast.ParameterList,
+	declarationKind common.DeclarationKind,
+) {
+	if parameterList == nil {
+		return
+	}
+
+	for _, parameter := range parameterList.Parameters {
+		if parameter.DefaultArgument == nil {
+			continue
+		}
+
+		checker.report(
+			&UnsupportedDefaultArgumentError{
+				DeclarationKind: declarationKind,
+				Range:           ast.NewRangeFromPositioned(parameter.DefaultArgument),
+			},
+		)
 	}
 }
 
@@ -333,7 +443,10 @@ func (checker *Checker) visitWithPostConditions(postConditions *ast.Conditions,
 
 		checker.Elaboration.PostConditionsRewrite[postConditions] = rewriteResult
 
-		checker.visitStatements(rewriteResult.BeforeStatements)
+		// The extracted `before` expressions are part of the post-conditions,
+		// so they are checked like conditions
+
+		checker.visitBeforeStatements(rewriteResult.BeforeStatements)
 	}
 
 	body()
@@ -416,7 +529,7 @@ func (checker *Checker) declareBefore() {
 func (checker *Checker) VisitFunctionExpression(expression *ast.FunctionExpression) ast.Repr {
 
 	// TODO: infer
-	functionType := checker.functionType(expression.ParameterList, expression.ReturnTypeAnnotation)
+	functionType := checker.functionType(expression.Purity, expression.ParameterList, expression.ReturnTypeAnnotation)
 
 	checker.Elaboration.FunctionExpressionFunctionType[expression] = functionType
 
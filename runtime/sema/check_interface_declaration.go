@@ -186,6 +186,8 @@ func (checker *Checker) checkInterfaceFunctions(
 				},
 			)
 
+			checker.reportUnsupportedDefaultArguments(function.ParameterList, declarationKind)
+
 			if function.FunctionBlock != nil {
 				checker.checkInterfaceSpecialFunctionBlock(
 					function.FunctionBlock,
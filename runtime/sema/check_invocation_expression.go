@@ -107,6 +107,8 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 		return InvalidType
 	}
 
+	checker.checkInvocationPurity(invocationExpression, functionType)
+
 	// The invoked expression has a function type,
 	// check the invocation including all arguments.
 	//
@@ -116,9 +118,16 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 
 	var returnType Type
 
+	// Arguments for parameters with a default argument may only be omitted
+	// if the invocation refers directly to a declared function or initializer:
+	// Function types do not declare default arguments,
+	// so the invoked function value might not have them
+
+	defaultArgumentsAllowed := checker.isDeclaredFunctionInvocation(invokedExpression)
+
 	checkInvocation := func() {
 		argumentTypes, returnType =
-			checker.checkInvocation(invocationExpression, functionType)
+			checker.checkInvocation(invocationExpression, functionType, defaultArgumentsAllowed)
 	}
 
 	if isOptionalChainingResult {
@@ -192,6 +201,36 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 	return returnType
 }
 
+// isDeclaredFunctionInvocation returns true if the given invoked expression
+// refers directly to the name of a function or composite as stated in the declaration,
+// or to a function or nested composite of a composite (member)
+//
+func (checker *Checker) isDeclaredFunctionInvocation(invokedExpression ast.Expression) bool {
+	var declarationKind common.DeclarationKind
+
+	switch typedInvokedExpression := invokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		variable := checker.findAndCheckValueVariable(typedInvokedExpression, false)
+		if variable == nil {
+			return false
+		}
+		declarationKind = variable.DeclarationKind
+
+	case *ast.MemberExpression:
+		_, member, _ := checker.visitMember(typedInvokedExpression)
+		if member == nil {
+			return false
+		}
+		declarationKind = member.DeclarationKind
+
+	default:
+		return false
+	}
+
+	return declarationKind == common.DeclarationKindFunction ||
+		declarationKind.IsTypeDeclaration()
+}
+
 func (checker *Checker) checkMemberInvocationResourceInvalidation(invokedExpression ast.Expression) {
 	// If the invocation is on a resource, i.e., a member expression where the accessed expression
 	// is an identifier which refers to a resource, then the resource is temporarily "moved into"
@@ -364,12 +403,18 @@ func (checker *Checker) checkInvocationArgumentLabels(
 func (checker *Checker) checkInvocation(
 	invocationExpression *ast.InvocationExpression,
 	functionType *FunctionType,
+	defaultArgumentsAllowed bool,
 ) (
 	argumentTypes []Type,
 	returnType Type,
 ) {
 	parameterCount := len(functionType.Parameters)
 	requiredArgumentCount := functionType.RequiredArgumentCount
+
+	defaultArgumentCount := 0
+	if defaultArgumentsAllowed {
+		defaultArgumentCount = DefaultArgumentCount(functionType.Parameters)
+	}
 	typeParameterCount := len(functionType.TypeParameters)
 
 	// Check the type arguments and bind them to type parameters
@@ -417,6 +462,7 @@ func (checker *Checker) checkInvocation(
 		argumentCount,
 		parameterCount,
 		requiredArgumentCount,
+		defaultArgumentCount,
 		invocationExpression,
 	)
 
@@ -572,6 +618,7 @@ func (checker *Checker) checkInvocationArgumentCount(
 	argumentCount int,
 	parameterCount int,
 	requiredArgumentCount *int,
+	defaultArgumentCount int,
 	pos ast.HasPosition,
 ) {
 
@@ -579,6 +626,14 @@ func (checker *Checker) checkInvocationArgumentCount(
 		return
 	}
 
+	// Trailing arguments for parameters with a default argument may be omitted
+
+	if argumentCount < parameterCount &&
+		argumentCount >= parameterCount-defaultArgumentCount {
+
+		return
+	}
+
 	// TODO: improve
 	if requiredArgumentCount == nil ||
 		argumentCount < *requiredArgumentCount {
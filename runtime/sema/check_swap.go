@@ -34,6 +34,9 @@ func (checker *Checker) VisitSwapStatement(swap *ast.SwapStatement) ast.Repr {
 	lhsValid := checker.checkSwapStatementExpression(swap.Left, leftType, common.OperandSideLeft)
 	rhsValid := checker.checkSwapStatementExpression(swap.Right, rightType, common.OperandSideRight)
 
+	checker.checkWritePurity(swap.Left)
+	checker.checkWritePurity(swap.Right)
+
 	// The types of both sides must be subtypes of each other,
 	// so that assignment can be performed in both directions.
 	// i.e: The two types have to be equal.
@@ -0,0 +1,80 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import "github.com/onflow/cadence/runtime/ast"
+
+func (checker *Checker) VisitTupleExpression(expression *ast.TupleExpression) ast.Repr {
+
+	// If a tuple type with the same number of elements is expected,
+	// then the elements are expected to have the element types.
+	// Otherwise, infer the type from the elements.
+
+	var expectedElementTypes []Type
+
+	expectedTupleType, ok := UnwrapOptionalType(checker.expectedType).(*TupleType)
+	if ok {
+		expectedElementTypes = expectedTupleType.ElementTypes
+
+		elementCount := len(expression.Elements)
+		if len(expectedElementTypes) != elementCount {
+			checker.report(
+				&TupleElementCountMismatchError{
+					ExpectedCount: len(expectedElementTypes),
+					ActualCount:   elementCount,
+					Range:         expression.Range,
+				},
+			)
+
+			expectedElementTypes = nil
+		}
+	}
+
+	elementTypes := make([]Type, len(expression.Elements))
+	resultElementTypes := make([]Type, len(expression.Elements))
+
+	for i, element := range expression.Elements {
+		var expectedElementType Type
+		if expectedElementTypes != nil {
+			expectedElementType = expectedElementTypes[i]
+		}
+
+		elementType := checker.VisitExpression(element, expectedElementType)
+
+		elementTypes[i] = elementType
+
+		if expectedElementType != nil {
+			resultElementTypes[i] = expectedElementType
+		} else {
+			resultElementTypes[i] = elementType
+		}
+
+		checker.checkVariableMove(element)
+		checker.checkResourceMoveOperation(element, elementType)
+	}
+
+	resultType := &TupleType{
+		ElementTypes: resultElementTypes,
+	}
+
+	checker.Elaboration.TupleExpressionElementTypes[expression] = elementTypes
+	checker.Elaboration.TupleExpressionTupleType[expression] = resultType
+
+	return resultType
+}
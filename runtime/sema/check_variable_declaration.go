@@ -194,6 +194,100 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 	}
 }
 
+func (checker *Checker) VisitTupleVariableDeclaration(declaration *ast.TupleVariableDeclaration) ast.Repr {
+
+	// Determine the type of the value of the declaration
+
+	var expectedValueType Type
+
+	if declaration.TypeAnnotation != nil {
+		typeAnnotation := checker.ConvertTypeAnnotation(declaration.TypeAnnotation)
+		checker.checkTypeAnnotation(typeAnnotation, declaration.TypeAnnotation)
+		expectedValueType = typeAnnotation.Type
+	}
+
+	valueType := checker.VisitExpression(declaration.Value, expectedValueType)
+
+	declarationType := valueType
+	if expectedValueType != nil {
+		declarationType = expectedValueType
+	}
+
+	checker.checkTransfer(declaration.Transfer, declarationType)
+
+	checker.checkVariableMove(declaration.Value)
+
+	// The value is invalidated (if it has a resource type),
+	// as its elements are moved into the new variables
+
+	checker.recordResourceInvalidation(
+		declaration.Value,
+		declarationType,
+		ResourceInvalidationKindMoveDefinite,
+	)
+
+	// Determine the types of the declared variables.
+	// If the value is not a tuple of matching size, declare the variables
+	// with the invalid type, to avoid further errors
+
+	identifierCount := len(declaration.Identifiers)
+
+	elementTypes := make([]Type, identifierCount)
+
+	tupleType, ok := declarationType.(*TupleType)
+	if !ok {
+		if !declarationType.IsInvalidType() {
+			checker.report(
+				&NonTupleTypeError{
+					ActualType: declarationType,
+					Range:      ast.NewRangeFromPositioned(declaration.Value),
+				},
+			)
+		}
+	} else if len(tupleType.ElementTypes) != identifierCount {
+		checker.report(
+			&TupleElementCountMismatchError{
+				ExpectedCount: identifierCount,
+				ActualCount:   len(tupleType.ElementTypes),
+				Range:         ast.NewRangeFromPositioned(declaration.Value),
+			},
+		)
+	} else {
+		copy(elementTypes, tupleType.ElementTypes)
+
+		checker.Elaboration.TupleVariableDeclarationTypes[declaration] = tupleType
+		checker.Elaboration.TupleVariableDeclarationValueTypes[declaration] = valueType
+	}
+
+	// Finally, declare the variables in the current value activation
+
+	for i, identifier := range declaration.Identifiers {
+
+		elementType := elementTypes[i]
+		if elementType == nil {
+			elementType = InvalidType
+		}
+
+		variable, err := checker.valueActivations.Declare(variableDeclaration{
+			identifier:               identifier.Identifier,
+			ty:                       elementType,
+			access:                   ast.AccessNotSpecified,
+			kind:                     declaration.DeclarationKind(),
+			pos:                      identifier.Pos,
+			isConstant:               declaration.IsConstant,
+			argumentLabels:           nil,
+			allowOuterScopeShadowing: true,
+		})
+		checker.report(err)
+
+		if checker.positionInfoEnabled {
+			checker.recordVariableDeclarationOccurrence(identifier.Identifier, variable)
+		}
+	}
+
+	return nil
+}
+
 func (checker *Checker) recordVariableDeclarationRange(
 	declaration *ast.VariableDeclaration,
 	identifier string,
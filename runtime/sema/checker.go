@@ -49,6 +49,7 @@ var beforeType = func() *FunctionType {
 	)
 
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		TypeParameters: []*TypeParameter{
 			typeParameter,
 		},
@@ -561,7 +562,7 @@ func (checker *Checker) checkTopLevelDeclarationValidity(declarations []ast.Decl
 }
 
 func (checker *Checker) declareGlobalFunctionDeclaration(declaration *ast.FunctionDeclaration) {
-	functionType := checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	functionType := checker.functionType(declaration.Purity, declaration.ParameterList, declaration.ReturnTypeAnnotation)
 	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
 	checker.declareFunctionDeclaration(declaration, functionType)
 }
@@ -920,6 +921,9 @@ func (checker *Checker) ConvertType(t ast.Type) Type {
 	case *ast.FunctionType:
 		return checker.convertFunctionType(t)
 
+	case *ast.TupleType:
+		return checker.convertTupleType(t)
+
 	case *ast.OptionalType:
 		return checker.convertOptionalType(t)
 
@@ -1223,11 +1227,30 @@ func (checker *Checker) convertFunctionType(t *ast.FunctionType) Type {
 	returnTypeAnnotation := checker.ConvertTypeAnnotation(t.ReturnTypeAnnotation)
 
 	return &FunctionType{
+		Purity:               PurityFromAnnotation(t.Purity),
 		Parameters:           parameters,
 		ReturnTypeAnnotation: returnTypeAnnotation,
 	}
 }
 
+func (checker *Checker) convertTupleType(t *ast.TupleType) Type {
+	elementTypes := make([]Type, len(t.ElementTypeAnnotations))
+
+	for i, elementTypeAnnotation := range t.ElementTypeAnnotations {
+		convertedElementTypeAnnotation := checker.ConvertTypeAnnotation(elementTypeAnnotation)
+
+		// Each element type must be annotated as a resource, if it is one
+
+		checker.checkResourceAnnotation(convertedElementTypeAnnotation, elementTypeAnnotation)
+
+		elementTypes[i] = convertedElementTypeAnnotation.Type
+	}
+
+	return &TupleType{
+		ElementTypes: elementTypes,
+	}
+}
+
 func (checker *Checker) convertConstantSizedType(t *ast.ConstantSizedType) Type {
 	elementType := checker.ConvertType(t.Type)
 
@@ -1369,6 +1392,7 @@ func (checker *Checker) ConvertTypeAnnotation(typeAnnotation *ast.TypeAnnotation
 }
 
 func (checker *Checker) functionType(
+	purity ast.FunctionPurity,
 	parameterList *ast.ParameterList,
 	returnTypeAnnotation *ast.TypeAnnotation,
 ) *FunctionType {
@@ -1378,6 +1402,7 @@ func (checker *Checker) functionType(
 		checker.ConvertTypeAnnotation(returnTypeAnnotation)
 
 	return &FunctionType{
+		Purity:               PurityFromAnnotation(purity),
 		Parameters:           convertedParameters,
 		ReturnTypeAnnotation: convertedReturnTypeAnnotation,
 	}
@@ -1400,6 +1425,7 @@ func (checker *Checker) parameters(parameterList *ast.ParameterList) []*Paramete
 				IsResource: parameter.TypeAnnotation.IsResource,
 				Type:       convertedParameterType,
 			},
+			DefaultArgument: parameter.DefaultArgument,
 		}
 	}
 
@@ -2197,6 +2223,14 @@ func (checker *Checker) rewritePostConditions(postConditions []*ast.Condition) P
 }
 
 func (checker *Checker) checkTypeAnnotation(typeAnnotation *TypeAnnotation, pos ast.HasPosition) {
+	checker.checkResourceAnnotation(typeAnnotation, pos)
+	checker.checkInvalidInterfaceAsType(typeAnnotation.Type, pos)
+}
+
+// checkResourceAnnotation checks that the given type annotation
+// is annotated as a resource if and only if the type is a resource type
+//
+func (checker *Checker) checkResourceAnnotation(typeAnnotation *TypeAnnotation, pos ast.HasPosition) {
 
 	switch typeAnnotation.TypeAnnotationState() {
 	case TypeAnnotationStateMissingResourceAnnotation:
@@ -2213,8 +2247,6 @@ func (checker *Checker) checkTypeAnnotation(typeAnnotation *TypeAnnotation, pos
 			},
 		)
 	}
-
-	checker.checkInvalidInterfaceAsType(typeAnnotation.Type, pos)
 }
 
 func (checker *Checker) checkInvalidInterfaceAsType(ty Type, pos ast.HasPosition) {
@@ -38,6 +38,8 @@ var HashAlgorithms = []CryptoAlgorithm{
 	HashAlgorithmSHA3_256,
 	HashAlgorithmSHA3_384,
 	HashAlgorithmKMAC128_BLS_BLS12_381,
+	HashAlgorithmKECCAK_256,
+	HashAlgorithmBLAKE2B_256,
 }
 
 var SignatureAlgorithmType = newNativeEnumType(
@@ -110,6 +112,7 @@ func (algo SignatureAlgorithm) DocString() string {
 const HashAlgorithmTypeHashFunctionName = "hash"
 
 var HashAlgorithmTypeHashFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -129,6 +132,7 @@ Returns the hash of the given data
 const HashAlgorithmTypeHashWithTagFunctionName = "hashWithTag"
 
 var HashAlgorithmTypeHashWithTagFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -182,6 +186,8 @@ const (
 	HashAlgorithmSHA3_256
 	HashAlgorithmSHA3_384
 	HashAlgorithmKMAC128_BLS_BLS12_381
+	HashAlgorithmKECCAK_256
+	HashAlgorithmBLAKE2B_256
 )
 
 func (algo HashAlgorithm) Name() string {
@@ -198,6 +204,10 @@ func (algo HashAlgorithm) Name() string {
 		return "SHA3_384"
 	case HashAlgorithmKMAC128_BLS_BLS12_381:
 		return "KMAC128_BLS_BLS12_381"
+	case HashAlgorithmKECCAK_256:
+		return "KECCAK_256"
+	case HashAlgorithmBLAKE2B_256:
+		return "BLAKE2B_256"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -222,6 +232,10 @@ func (algo HashAlgorithm) RawValue() uint8 {
 		return 4
 	case HashAlgorithmKMAC128_BLS_BLS12_381:
 		return 5
+	case HashAlgorithmKECCAK_256:
+		return 6
+	case HashAlgorithmBLAKE2B_256:
+		return 7
 	}
 
 	panic(errors.NewUnreachableError())
@@ -241,6 +255,10 @@ func (algo HashAlgorithm) DocString() string {
 		return HashAlgorithmDocStringSHA3_384
 	case HashAlgorithmKMAC128_BLS_BLS12_381:
 		return HashAlgorithmDocStringKMAC128_BLS_BLS12_381
+	case HashAlgorithmKECCAK_256:
+		return HashAlgorithmDocStringKECCAK_256
+	case HashAlgorithmBLAKE2B_256:
+		return HashAlgorithmDocStringBLAKE2B_256
 	}
 
 	panic(errors.NewUnreachableError())
@@ -322,3 +340,12 @@ that can be used as the hashing algorithm for BLS signature scheme on the curve
 This is a customized version of KMAC128 that is compatible with the hashing to curve 
 used in BLS signatures.
 `
+
+const HashAlgorithmDocStringKECCAK_256 = `
+KECCAK_256 is the legacy Keccak algorithm with a 256-bit digest, as used by Ethereum.
+It differs from SHA3_256 in the padding of the input
+`
+
+const HashAlgorithmDocStringBLAKE2B_256 = `
+BLAKE2B_256 is the BLAKE2b algorithm with a 256-bit digest
+`
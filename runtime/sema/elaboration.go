@@ -58,6 +58,10 @@ type Elaboration struct {
 	MemberExpressionExpectedTypes       map[*ast.MemberExpression]Type
 	ArrayExpressionArgumentTypes        map[*ast.ArrayExpression][]Type
 	ArrayExpressionArrayType            map[*ast.ArrayExpression]ArrayType
+	TupleExpressionElementTypes         map[*ast.TupleExpression][]Type
+	TupleExpressionTupleType            map[*ast.TupleExpression]*TupleType
+	TupleVariableDeclarationTypes       map[*ast.TupleVariableDeclaration]*TupleType
+	TupleVariableDeclarationValueTypes  map[*ast.TupleVariableDeclaration]Type
 	DictionaryExpressionType            map[*ast.DictionaryExpression]*DictionaryType
 	DictionaryExpressionEntryTypes      map[*ast.DictionaryExpression][]DictionaryEntryType
 	IntegerExpressionType               map[*ast.IntegerExpression]Type
@@ -114,6 +118,10 @@ func NewElaboration() *Elaboration {
 		MemberExpressionExpectedTypes:       map[*ast.MemberExpression]Type{},
 		ArrayExpressionArgumentTypes:        map[*ast.ArrayExpression][]Type{},
 		ArrayExpressionArrayType:            map[*ast.ArrayExpression]ArrayType{},
+		TupleExpressionElementTypes:         map[*ast.TupleExpression][]Type{},
+		TupleExpressionTupleType:            map[*ast.TupleExpression]*TupleType{},
+		TupleVariableDeclarationTypes:       map[*ast.TupleVariableDeclaration]*TupleType{},
+		TupleVariableDeclarationValueTypes:  map[*ast.TupleVariableDeclaration]Type{},
 		DictionaryExpressionType:            map[*ast.DictionaryExpression]*DictionaryType{},
 		DictionaryExpressionEntryTypes:      map[*ast.DictionaryExpression][]DictionaryEntryType{},
 		IntegerExpressionType:               map[*ast.IntegerExpression]Type{},
@@ -2062,6 +2062,48 @@ func (e *NonResourceTypeError) SecondaryError() string {
 
 func (*NonResourceTypeError) isSemanticError() {}
 
+// NonTupleTypeError
+
+type NonTupleTypeError struct {
+	ActualType Type
+	ast.Range
+}
+
+func (e *NonTupleTypeError) Error() string {
+	return "invalid type"
+}
+
+func (e *NonTupleTypeError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected tuple type, got `%s`",
+		e.ActualType.QualifiedString(),
+	)
+}
+
+func (*NonTupleTypeError) isSemanticError() {}
+
+// TupleElementCountMismatchError
+
+type TupleElementCountMismatchError struct {
+	ExpectedCount int
+	ActualCount   int
+	ast.Range
+}
+
+func (e *TupleElementCountMismatchError) Error() string {
+	return "incorrect number of tuple elements"
+}
+
+func (e *TupleElementCountMismatchError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected %d, got %d",
+		e.ExpectedCount,
+		e.ActualCount,
+	)
+}
+
+func (*TupleElementCountMismatchError) isSemanticError() {}
+
 // InvalidAssignmentTargetError
 
 type InvalidAssignmentTargetError struct {
@@ -2966,3 +3008,106 @@ func (e *InvalidEntryPointTypeError) Error() string {
 		e.Type.QualifiedString(),
 	)
 }
+
+// MissingDefaultArgumentError
+
+type MissingDefaultArgumentError struct {
+	Name string
+	ast.Range
+}
+
+func (e *MissingDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"missing default argument for parameter `%s`",
+		e.Name,
+	)
+}
+
+func (*MissingDefaultArgumentError) isSemanticError() {}
+
+func (e *MissingDefaultArgumentError) SecondaryError() string {
+	return "parameters following a parameter with a default argument must also have a default argument"
+}
+
+// NonConstantDefaultArgumentError
+
+type NonConstantDefaultArgumentError struct {
+	ast.Range
+}
+
+func (e *NonConstantDefaultArgumentError) Error() string {
+	return "default argument must be a constant expression"
+}
+
+func (*NonConstantDefaultArgumentError) isSemanticError() {}
+
+func (e *NonConstantDefaultArgumentError) SecondaryError() string {
+	return "only literals, and array and dictionary literals of constant expressions, are allowed"
+}
+
+// InvalidResourceDefaultArgumentError
+
+type InvalidResourceDefaultArgumentError struct {
+	ast.Range
+}
+
+func (e *InvalidResourceDefaultArgumentError) Error() string {
+	return "resource parameters cannot have a default argument"
+}
+
+func (*InvalidResourceDefaultArgumentError) isSemanticError() {}
+
+// UnsupportedDefaultArgumentError
+
+type UnsupportedDefaultArgumentError struct {
+	DeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *UnsupportedDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"default arguments are not supported in %s declarations",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (*UnsupportedDefaultArgumentError) isSemanticError() {}
+
+// DefaultArgumentConformanceError
+
+type DefaultArgumentConformanceError struct {
+	InterfaceType *InterfaceType
+	ast.Range
+}
+
+func (e *DefaultArgumentConformanceError) Error() string {
+	return fmt.Sprintf(
+		"default arguments are not supported for implementations of requirements of `%s`",
+		e.InterfaceType.QualifiedString(),
+	)
+}
+
+func (*DefaultArgumentConformanceError) isSemanticError() {}
+
+// PurityError
+
+type PurityError struct {
+	Operation   ImpureOperation
+	InCondition bool
+	ast.Range
+}
+
+func (e *PurityError) Error() string {
+	context := "view function"
+	if e.InCondition {
+		context = "condition"
+	}
+
+	return fmt.Sprintf(
+		"cannot %s in %s",
+		e.Operation.Description(),
+		context,
+	)
+}
+
+func (*PurityError) isSemanticError() {}
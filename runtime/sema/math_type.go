@@ -0,0 +1,297 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// RoundingMode
+
+const RoundingModeTypeName = "RoundingMode"
+
+// RoundingModeType is the type of the rounding modes of the math functions.
+// Rounding modes are not importable, e.g. as script arguments
+//
+var RoundingModeType = func() *CompositeType {
+	ty := newNativeEnumType(
+		RoundingModeTypeName,
+		UInt8Type,
+		nil,
+	)
+	ty.importable = false
+	return ty
+}()
+
+type RoundingMode uint8
+
+const (
+	RoundingModeTowardZero RoundingMode = iota
+	RoundingModeAwayFromZero
+	RoundingModeNearestHalfAway
+	RoundingModeNearestHalfEven
+)
+
+var RoundingModes = []NativeEnumCase{
+	RoundingModeTowardZero,
+	RoundingModeAwayFromZero,
+	RoundingModeNearestHalfAway,
+	RoundingModeNearestHalfEven,
+}
+
+func (mode RoundingMode) Name() string {
+	switch mode {
+	case RoundingModeTowardZero:
+		return "towardZero"
+	case RoundingModeAwayFromZero:
+		return "awayFromZero"
+	case RoundingModeNearestHalfAway:
+		return "nearestHalfAway"
+	case RoundingModeNearestHalfEven:
+		return "nearestHalfEven"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (mode RoundingMode) RawValue() uint8 {
+	// NOTE: only add new rounding modes, do *NOT* change existing items,
+	// reuse raw values for other items, swap the order, etc.
+	//
+	// Existing stored values use these raw values and should not change
+
+	switch mode {
+	case RoundingModeTowardZero:
+		return 0
+	case RoundingModeAwayFromZero:
+		return 1
+	case RoundingModeNearestHalfAway:
+		return 2
+	case RoundingModeNearestHalfEven:
+		return 3
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (mode RoundingMode) DocString() string {
+	switch mode {
+	case RoundingModeTowardZero:
+		return RoundingModeDocStringTowardZero
+	case RoundingModeAwayFromZero:
+		return RoundingModeDocStringAwayFromZero
+	case RoundingModeNearestHalfAway:
+		return RoundingModeDocStringNearestHalfAway
+	case RoundingModeNearestHalfEven:
+		return RoundingModeDocStringNearestHalfEven
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+const RoundingModeDocStringTowardZero = `
+Rounds toward zero, i.e. truncates the digits which are not representable
+`
+
+const RoundingModeDocStringAwayFromZero = `
+Rounds away from zero
+`
+
+const RoundingModeDocStringNearestHalfAway = `
+Rounds to the nearest representable value. Ties are rounded away from zero
+`
+
+const RoundingModeDocStringNearestHalfEven = `
+Rounds to the nearest representable value. Ties are rounded to the value with an even last digit
+`
+
+// Math
+
+const MathTypeName = "Math"
+const MathTypeSqrtFunctionName = "sqrt"
+const MathTypePowFunctionName = "pow"
+const MathTypeLnFunctionName = "ln"
+const MathTypeExpFunctionName = "exp"
+const MathTypeMulDivFunctionName = "mulDiv"
+
+// MathType is the type of the built-in value `Math`,
+// which provides deterministic fixed-point math functions
+//
+var MathType = func() *CompositeType {
+
+	mathType := &CompositeType{
+		Identifier: MathTypeName,
+		Kind:       common.CompositeKindStructure,
+		importable: false,
+	}
+
+	var members = []*Member{
+		NewPublicFunctionMember(
+			mathType,
+			MathTypeSqrtFunctionName,
+			MathTypeSqrtFunctionType,
+			mathTypeSqrtFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			mathType,
+			MathTypePowFunctionName,
+			MathTypePowFunctionType,
+			mathTypePowFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			mathType,
+			MathTypeLnFunctionName,
+			MathTypeLnFunctionType,
+			mathTypeLnFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			mathType,
+			MathTypeExpFunctionName,
+			MathTypeExpFunctionType,
+			mathTypeExpFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			mathType,
+			MathTypeMulDivFunctionName,
+			MathTypeMulDivFunctionType,
+			mathTypeMulDivFunctionDocString,
+		),
+	}
+
+	mathType.Members = GetMembersAsMap(members)
+	mathType.Fields = getFieldNames(members)
+	return mathType
+}()
+
+// mathFunctionType returns the type of a math function
+// with the given parameters, plus a trailing rounding mode parameter
+//
+func mathFunctionType(parameters []*Parameter, returnType Type) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: append(
+			parameters,
+			&Parameter{
+				Identifier:     "rounding",
+				TypeAnnotation: NewTypeAnnotation(RoundingModeType),
+			},
+		),
+		ReturnTypeAnnotation: NewTypeAnnotation(returnType),
+	}
+}
+
+var MathTypeSqrtFunctionType = mathFunctionType(
+	[]*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "x",
+			TypeAnnotation: NewTypeAnnotation(UFix64Type),
+		},
+	},
+	UFix64Type,
+)
+
+const mathTypeSqrtFunctionDocString = `
+Returns the square root of the given value, rounded using the given rounding mode
+`
+
+var MathTypePowFunctionType = mathFunctionType(
+	[]*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "base",
+			TypeAnnotation: NewTypeAnnotation(UFix64Type),
+		},
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "exponent",
+			TypeAnnotation: NewTypeAnnotation(UInt64Type),
+		},
+	},
+	UFix64Type,
+)
+
+const mathTypePowFunctionDocString = `
+Returns the given base raised to the power of the given exponent, rounded using the given rounding mode.
+
+The program aborts if the result overflows
+`
+
+var MathTypeLnFunctionType = mathFunctionType(
+	[]*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "x",
+			TypeAnnotation: NewTypeAnnotation(UFix64Type),
+		},
+	},
+	Fix64Type,
+)
+
+const mathTypeLnFunctionDocString = `
+Returns the natural logarithm of the given value, rounded using the given rounding mode.
+
+The program aborts if the value is zero
+`
+
+var MathTypeExpFunctionType = mathFunctionType(
+	[]*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "x",
+			TypeAnnotation: NewTypeAnnotation(Fix64Type),
+		},
+	},
+	UFix64Type,
+)
+
+const mathTypeExpFunctionDocString = `
+Returns e raised to the power of the given value, rounded using the given rounding mode.
+
+The program aborts if the result overflows
+`
+
+var MathTypeMulDivFunctionType = mathFunctionType(
+	[]*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "x",
+			TypeAnnotation: NewTypeAnnotation(UFix64Type),
+		},
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "y",
+			TypeAnnotation: NewTypeAnnotation(UFix64Type),
+		},
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "z",
+			TypeAnnotation: NewTypeAnnotation(UFix64Type),
+		},
+	},
+	UFix64Type,
+)
+
+const mathTypeMulDivFunctionDocString = `
+Returns x * y / z, rounded using the given rounding mode.
+The intermediate product is not rounded and cannot overflow.
+
+The program aborts if z is zero, or if the result overflows
+`
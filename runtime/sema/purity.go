@@ -0,0 +1,208 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// ImpureOperation is an operation which is not allowed
+// in view functions and in conditions
+//
+type ImpureOperation int
+
+const (
+	ImpureOperationUnknown ImpureOperation = iota
+	ImpureOperationInvocation
+	ImpureOperationEmit
+	ImpureOperationDestroy
+	ImpureOperationWrite
+)
+
+func (o ImpureOperation) Description() string {
+	switch o {
+	case ImpureOperationInvocation:
+		return "call non-view function"
+	case ImpureOperationEmit:
+		return "emit event"
+	case ImpureOperationDestroy:
+		return "destroy resource"
+	case ImpureOperationWrite:
+		return "modify outer state"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+// isViewContext returns true if the checker is currently
+// checking a view function or a condition.
+//
+func (checker *Checker) isViewContext() bool {
+	if checker.inCondition {
+		return true
+	}
+
+	functionActivation := checker.functionActivations.Current()
+	return functionActivation != nil && functionActivation.IsView()
+}
+
+func (checker *Checker) reportImpureOperation(operation ImpureOperation, hasPosition ast.HasPosition) {
+	checker.report(
+		&PurityError{
+			Operation:   operation,
+			InCondition: checker.inCondition,
+			Range:       ast.NewRangeFromPositioned(hasPosition),
+		},
+	)
+}
+
+// checkInvocationPurity checks that the invoked function is a view function,
+// if the invocation occurs in a view function or in a condition.
+//
+func (checker *Checker) checkInvocationPurity(
+	invocationExpression *ast.InvocationExpression,
+	functionType *FunctionType,
+) {
+	if functionType.Purity == FunctionPurityView ||
+		!checker.isViewContext() {
+
+		return
+	}
+
+	checker.reportImpureOperation(ImpureOperationInvocation, invocationExpression)
+}
+
+// checkWritePurity checks that the target of an assignment or swap
+// does not refer to state outside of the current view function.
+//
+// The target may only be a variable declared in the view function,
+// or a field or element of such a variable, if it is not a reference.
+//
+func (checker *Checker) checkWritePurity(target ast.Expression) {
+	if !checker.isViewContext() {
+		return
+	}
+
+	if checker.isLocalWriteTarget(target) {
+		return
+	}
+
+	checker.reportImpureOperation(ImpureOperationWrite, target)
+}
+
+func (checker *Checker) isLocalWriteTarget(target ast.Expression) bool {
+	switch target := target.(type) {
+	case *ast.IdentifierExpression:
+		return checker.isLocalVariable(target)
+
+	case *ast.MemberExpression:
+		return checker.isLocalContainer(target.Expression)
+
+	case *ast.IndexExpression:
+		return checker.isLocalContainer(target.TargetExpression)
+
+	default:
+		return false
+	}
+}
+
+// isLocalVariable returns true if the given identifier expression
+// refers to a variable declared in the current function.
+//
+func (checker *Checker) isLocalVariable(expression *ast.IdentifierExpression) bool {
+	variable := checker.valueActivations.Find(expression.Identifier.Identifier)
+	if variable == nil {
+		// An undeclared variable is already reported
+		return true
+	}
+
+	// `self` is declared in the function, but refers to state outside of it
+
+	if variable.DeclarationKind == common.DeclarationKindSelf {
+		return false
+	}
+
+	functionActivation := checker.functionActivations.Current()
+
+	return functionActivation != nil &&
+		variable.ActivationDepth > functionActivation.ValueActivationDepth
+}
+
+// isLocalContainer returns true if the given expression, which is written to
+// through a member or index, only refers to state of the current function.
+//
+// References might refer to state outside of the function,
+// so containers which might contain references are not local.
+//
+func (checker *Checker) isLocalContainer(expression ast.Expression) bool {
+	switch expression := expression.(type) {
+	case *ast.IdentifierExpression:
+		if !checker.isLocalVariable(expression) {
+			return false
+		}
+
+		variable := checker.valueActivations.Find(expression.Identifier.Identifier)
+		return variable == nil || !containsReferenceType(variable.Type)
+
+	case *ast.MemberExpression:
+		memberInfo := checker.Elaboration.MemberExpressionMemberInfos[expression]
+		if memberInfo.Member != nil &&
+			containsReferenceType(memberInfo.Member.TypeAnnotation.Type) {
+
+			return false
+		}
+
+		return checker.isLocalContainer(expression.Expression)
+
+	case *ast.IndexExpression:
+		return checker.isLocalContainer(expression.TargetExpression)
+
+	default:
+		return false
+	}
+}
+
+func containsReferenceType(ty Type) bool {
+	switch ty := ty.(type) {
+	case *ReferenceType:
+		return true
+
+	case *OptionalType:
+		return containsReferenceType(ty.Type)
+
+	case ArrayType:
+		return containsReferenceType(ty.ElementType(false))
+
+	case *DictionaryType:
+		return containsReferenceType(ty.ValueType)
+
+	case *TupleType:
+		for _, elementType := range ty.ElementTypes {
+			if containsReferenceType(elementType) {
+				return true
+			}
+		}
+		return false
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const RLPTypeName = "RLP"
+const RLPTypeEncodeFunctionName = "encode"
+const RLPTypeDecodeFunctionName = "decode"
+
+// RLPType is the type of the built-in value `RLP`,
+// which provides functions to encode and decode data
+// in the Recursive Length Prefix (RLP) format used by Ethereum
+//
+var RLPType = func() *CompositeType {
+
+	rlpType := &CompositeType{
+		Identifier: RLPTypeName,
+		Kind:       common.CompositeKindStructure,
+		importable: false,
+	}
+
+	var members = []*Member{
+		NewPublicFunctionMember(
+			rlpType,
+			RLPTypeEncodeFunctionName,
+			RLPTypeEncodeFunctionType,
+			rlpTypeEncodeFunctionDocString,
+		),
+		NewPublicFunctionMember(
+			rlpType,
+			RLPTypeDecodeFunctionName,
+			RLPTypeDecodeFunctionType,
+			rlpTypeDecodeFunctionDocString,
+		),
+	}
+
+	rlpType.Members = GetMembersAsMap(members)
+	rlpType.Fields = getFieldNames(members)
+	return rlpType
+}()
+
+var RLPTypeEncodeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "item",
+			TypeAnnotation: NewTypeAnnotation(AnyStructType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(ByteArrayType),
+}
+
+const rlpTypeEncodeFunctionDocString = `
+Returns the RLP encoding of the given item.
+
+An item is either a string, given as a byte array [UInt8],
+or a list of items, given as an array of any other type, e.g. [AnyStruct].
+
+The program aborts if the item, or any nested item, is neither a byte array nor an array
+`
+
+var RLPTypeDecodeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "input",
+			TypeAnnotation: NewTypeAnnotation(ByteArrayType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(AnyStructType),
+}
+
+const rlpTypeDecodeFunctionDocString = `
+Decodes the given RLP encoded item.
+
+Strings are returned as byte arrays [UInt8], lists are returned as [AnyStruct].
+
+The program aborts if the input is not the canonical encoding of exactly one item
+`
@@ -28,6 +28,21 @@ const StringTypeEncodeHexFunctionDocString = `
 Returns a hexadecimal string for the given byte array
 `
 
+const StringTypeJoinFunctionName = "join"
+const StringTypeJoinFunctionDocString = `
+Returns a string containing the given strings, with the separator inserted between each of them
+`
+
+const StringTypeFromCharactersFunctionName = "fromCharacters"
+const StringTypeFromCharactersFunctionDocString = `
+Returns a string containing the given characters
+`
+
+const StringTypeFromUTF8FunctionName = "fromUTF8"
+const StringTypeFromUTF8FunctionDocString = `
+Returns the string represented by the given UTF-8 encoded byte array, or nil if the bytes are not valid UTF-8
+`
+
 // StringType represents the string type
 //
 var StringType = &SimpleType{
@@ -120,11 +135,111 @@ func init() {
 					)
 				},
 			},
+			"toUpper": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeToUpperFunctionType,
+						stringTypeToUpperFunctionDocString,
+					)
+				},
+			},
+			"trim": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeTrimFunctionType,
+						stringTypeTrimFunctionDocString,
+					)
+				},
+			},
+			"contains": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeContainsFunctionType,
+						stringTypeContainsFunctionDocString,
+					)
+				},
+			},
+			"index": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeIndexFunctionType,
+						stringTypeIndexFunctionDocString,
+					)
+				},
+			},
+			"hasPrefix": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeHasPrefixFunctionType,
+						stringTypeHasPrefixFunctionDocString,
+					)
+				},
+			},
+			"hasSuffix": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeHasSuffixFunctionType,
+						stringTypeHasSuffixFunctionDocString,
+					)
+				},
+			},
+			"split": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeSplitFunctionType,
+						stringTypeSplitFunctionDocString,
+					)
+				},
+			},
+			"replaceAll": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeReplaceAllFunctionType,
+						stringTypeReplaceAllFunctionDocString,
+					)
+				},
+			},
+			"toCharacters": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						t,
+						identifier,
+						StringTypeToCharactersFunctionType,
+						stringTypeToCharactersFunctionDocString,
+					)
+				},
+			},
 		}
 	}
 }
 
 var StringTypeConcatFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:          ArgumentLabelNotRequired,
@@ -142,6 +257,7 @@ Returns a new string which contains the given string concatenated to the end of
 `
 
 var StringTypeSliceFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Identifier:     "from",
@@ -170,6 +286,7 @@ var ByteArrayType = &VariableSizedType{
 }
 
 var StringTypeDecodeHexFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(ByteArrayType),
 }
 
@@ -189,9 +306,206 @@ The byte array of the UTF-8 encoding
 `
 
 var StringTypeToLowerFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(StringType),
 }
 
 const stringTypeToLowerFunctionDocString = `
 Returns the string with upper case letters replaced with lowercase
 `
+
+var StringTypeToUpperFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(StringType),
+}
+
+const stringTypeToUpperFunctionDocString = `
+Returns the string with lower case letters replaced with uppercase
+`
+
+var StringTypeTrimFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(StringType),
+}
+
+const stringTypeTrimFunctionDocString = `
+Returns the string with all leading and trailing whitespace removed
+`
+
+var StringTypeContainsFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "other",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const stringTypeContainsFunctionDocString = `
+Returns true if the given string is contained in the string, i.e. it occurs at character boundaries
+`
+
+var StringTypeIndexFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Identifier:     "of",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		IntType,
+	),
+}
+
+const stringTypeIndexFunctionDocString = `
+Returns the character index of the first occurrence of the given string, or -1 if the string does not contain it
+`
+
+var StringTypeHasPrefixFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "prefix",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const stringTypeHasPrefixFunctionDocString = `
+Returns true if the string begins with the given prefix
+`
+
+var StringTypeHasSuffixFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "suffix",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		BoolType,
+	),
+}
+
+const stringTypeHasSuffixFunctionDocString = `
+Returns true if the string ends with the given suffix
+`
+
+var StringArrayType = &VariableSizedType{
+	Type: StringType,
+}
+
+var StringTypeSplitFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Identifier:     "separator",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringArrayType,
+	),
+}
+
+const stringTypeSplitFunctionDocString = `
+Returns the substrings of the string which are separated by the given separator.
+
+If the separator is empty, the string is split into its characters
+`
+
+var StringTypeReplaceAllFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Identifier:     "of",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+		{
+			Identifier:     "with",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+const stringTypeReplaceAllFunctionDocString = `
+Returns a new string with all occurrences of ` + "`of`" + ` replaced with ` + "`with`" + `.
+
+If ` + "`of`" + ` is empty, ` + "`with`" + ` is inserted at the beginning of the string and after each character
+`
+
+var CharacterArrayType = &VariableSizedType{
+	Type: CharacterType,
+}
+
+var StringTypeToCharactersFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(CharacterArrayType),
+}
+
+const stringTypeToCharactersFunctionDocString = `
+Returns an array containing the characters of the string
+`
+
+var StringTypeJoinFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "strings",
+			TypeAnnotation: NewTypeAnnotation(StringArrayType),
+		},
+		{
+			Identifier:     "separator",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+var StringTypeFromCharactersFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "characters",
+			TypeAnnotation: NewTypeAnnotation(CharacterArrayType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		StringType,
+	),
+}
+
+var StringTypeFromUTF8FunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "bytes",
+			TypeAnnotation: NewTypeAnnotation(ByteArrayType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: StringType,
+		},
+	),
+}
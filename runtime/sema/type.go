@@ -309,6 +309,7 @@ func NewTypeAnnotation(ty Type) *TypeAnnotation {
 const IsInstanceFunctionName = "isInstance"
 
 var IsInstanceFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -332,6 +333,7 @@ Returns true if the object conforms to the given type at runtime
 const GetTypeFunctionName = "getType"
 
 var GetTypeFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		MetaType,
 	),
@@ -346,6 +348,7 @@ Returns the type of the value
 const ToStringFunctionName = "toString"
 
 var ToStringFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		StringType,
 	),
@@ -360,6 +363,7 @@ A textual representation of this object
 const ToBigEndianBytesFunctionName = "toBigEndianBytes"
 
 var toBigEndianBytesFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		ByteArrayType,
 	),
@@ -794,6 +798,7 @@ self / other, saturating at the numeric bounds instead of overflowing.
 func addSaturatingArithmeticFunctions(t SaturatingArithmeticType, members map[string]MemberResolver) {
 
 	arithmeticFunctionType := &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -1387,6 +1392,29 @@ var (
 			WithSaturatingAdd().
 			WithSaturatingSubtract().
 			WithSaturatingMultiply()
+
+	// Fix128Type represents the 128-bit signed decimal fixed-point type `Fix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	Fix128Type = NewFixedPointNumericType(Fix128TypeName).
+			WithTag(Fix128TypeTag).
+			WithIntRange(Fix128TypeMinIntBig, Fix128TypeMaxIntBig).
+			WithFractionalRange(Fix128TypeMinFractionalBig, Fix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply().
+			WithSaturatingDivide()
+
+	// UFix128Type represents the 128-bit unsigned decimal fixed-point type `UFix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	UFix128Type = NewFixedPointNumericType(UFix128TypeName).
+			WithTag(UFix128TypeTag).
+			WithIntRange(UFix128TypeMinIntBig, UFix128TypeMaxIntBig).
+			WithFractionalRange(UFix128TypeMinFractionalBig, UFix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply()
 )
 
 // Numeric type ranges
@@ -1487,6 +1515,26 @@ var (
 
 	UFix64TypeMinFractionalBig = fixedpoint.UFix64TypeMinFractionalBig
 	UFix64TypeMaxFractionalBig = fixedpoint.UFix64TypeMaxFractionalBig
+
+	Fix128FactorBig = fixedpoint.Fix128FactorBig
+
+	Fix128TypeMinBig = fixedpoint.Fix128TypeMinBig
+	Fix128TypeMaxBig = fixedpoint.Fix128TypeMaxBig
+
+	Fix128TypeMinIntBig = fixedpoint.Fix128TypeMinIntBig
+	Fix128TypeMaxIntBig = fixedpoint.Fix128TypeMaxIntBig
+
+	Fix128TypeMinFractionalBig = fixedpoint.Fix128TypeMinFractionalBig
+	Fix128TypeMaxFractionalBig = fixedpoint.Fix128TypeMaxFractionalBig
+
+	UFix128TypeMinBig = fixedpoint.UFix128TypeMinBig
+	UFix128TypeMaxBig = fixedpoint.UFix128TypeMaxBig
+
+	UFix128TypeMinIntBig = fixedpoint.UFix128TypeMinIntBig
+	UFix128TypeMaxIntBig = fixedpoint.UFix128TypeMaxIntBig
+
+	UFix128TypeMinFractionalBig = fixedpoint.UFix128TypeMinFractionalBig
+	UFix128TypeMaxFractionalBig = fixedpoint.UFix128TypeMaxFractionalBig
 )
 
 const Fix64Scale = fixedpoint.Fix64Scale
@@ -1504,6 +1552,8 @@ const UFix64TypeMaxInt = fixedpoint.UFix64TypeMaxInt
 const UFix64TypeMinFractional = fixedpoint.UFix64TypeMinFractional
 const UFix64TypeMaxFractional = fixedpoint.UFix64TypeMaxFractional
 
+const Fix128Scale = fixedpoint.Fix128Scale
+
 // ArrayType
 
 type ArrayType interface {
@@ -1569,6 +1619,42 @@ It does not modify the original array.
 If either of the parameters are out of the bounds of the array, or the indices are invalid (` + "`from > upTo`" + `), then the function will fail.
 `
 
+const arrayTypeFirstIndexFunctionDocString = `
+Returns the index of the first element in the array that is equal to the given element, or nil if the array does not contain the element
+`
+
+const arrayTypeReverseFunctionDocString = `
+Returns a new array containing the elements of the array in reverse order.
+
+It does not modify the original array
+`
+
+const arrayTypeMapFunctionDocString = `
+Returns a new variable-sized array containing the results of calling the given function on each element of the array, in order.
+
+It does not modify the original array
+`
+
+const arrayTypeFilterFunctionDocString = `
+Returns a new variable-sized array containing the elements of the array for which the given function returns true, in order.
+
+It does not modify the original array
+`
+
+const arrayTypeReduceFunctionDocString = `
+Returns the result of combining the elements of the array, in order, using the given function.
+
+The function is called with the result of the previous call, or the initial value for the first element, and the element
+`
+
+const arrayTypeSortFunctionDocString = `
+Returns a new array containing the elements of the array sorted using the given function.
+
+The function must return true if its first argument should be ordered before its second argument.
+The sort is stable, i.e. equal elements retain their relative order.
+It does not modify the original array
+`
+
 func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 
 	members := map[string]MemberResolver{
@@ -1621,6 +1707,162 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
+		"firstIndex": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// Like for the `contains` function,
+				// the element cannot be inside the array if it is a resource
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				if !elementType.IsEquatable() {
+					report(
+						&NotEquatableTypeError{
+							Type:  elementType,
+							Range: targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayFirstIndexFunctionType(elementType),
+					arrayTypeFirstIndexFunctionDocString,
+				)
+			},
+		},
+		"reverse": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayReverseFunctionType(arrayType),
+					arrayTypeReverseFunctionDocString,
+				)
+			},
+		},
+		"map": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayMapFunctionType(elementType),
+					arrayTypeMapFunctionDocString,
+				)
+			},
+		},
+		"filter": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayFilterFunctionType(elementType),
+					arrayTypeFilterFunctionDocString,
+				)
+			},
+		},
+		"reduce": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArrayReduceFunctionType(elementType),
+					arrayTypeReduceFunctionDocString,
+				)
+			},
+		},
+		"sort": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					ArraySortFunctionType(arrayType),
+					arrayTypeSortFunctionDocString,
+				)
+			},
+		},
 	}
 
 	// TODO: maybe still return members but report a helpful error?
@@ -1834,6 +2076,7 @@ func ArrayInsertFunctionType(elementType Type) *FunctionType {
 func ArrayConcatFunctionType(arrayType Type) *FunctionType {
 	typeAnnotation := NewTypeAnnotation(arrayType)
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -1847,6 +2090,7 @@ func ArrayConcatFunctionType(arrayType Type) *FunctionType {
 
 func ArrayContainsFunctionType(elementType Type) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -1860,6 +2104,179 @@ func ArrayContainsFunctionType(elementType Type) *FunctionType {
 	}
 }
 
+func ArrayFirstIndexFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		Parameters: []*Parameter{
+			{
+				Identifier:     "of",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: IntType,
+			},
+		),
+	}
+}
+
+func ArrayReverseFunctionType(arrayType ArrayType) *FunctionType {
+	return &FunctionType{
+		Purity: FunctionPurityView,
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			arrayType,
+		),
+	}
+}
+
+func ArrayMapFunctionType(elementType Type) *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:      "U",
+		TypeBound: AnyStructType,
+	}
+
+	resultType := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "transform",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&VariableSizedType{
+				Type: resultType,
+			},
+		),
+	}
+}
+
+func ArrayFilterFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "isIncluded",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&VariableSizedType{
+				Type: elementType,
+			},
+		),
+	}
+}
+
+func ArrayReduceFunctionType(elementType Type) *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:      "U",
+		TypeBound: AnyStructType,
+	}
+
+	resultType := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Identifier:     "initial",
+				TypeAnnotation: NewTypeAnnotation(resultType),
+			},
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "combine",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "result",
+								TypeAnnotation: NewTypeAnnotation(resultType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+	}
+}
+
+func ArraySortFunctionType(arrayType ArrayType) *FunctionType {
+	elementType := arrayType.ElementType(false)
+
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Identifier: "by",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "a",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "b",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			arrayType,
+		),
+	}
+}
+
 func ArrayAppendAllFunctionType(arrayType Type) *FunctionType {
 	return &FunctionType{
 		Parameters: []*Parameter{
@@ -1890,6 +2307,7 @@ func ArrayAppendFunctionType(elementType Type) *FunctionType {
 
 func ArraySliceFunctionType(elementType Type) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Identifier:     "from",
@@ -2201,6 +2619,9 @@ type Parameter struct {
 	Label          string
 	Identifier     string
 	TypeAnnotation *TypeAnnotation
+	// DefaultArgument is the optional constant expression
+	// which is used when no argument is passed for the parameter
+	DefaultArgument ast.Expression
 }
 
 func (p *Parameter) String() string {
@@ -2233,6 +2654,21 @@ func (p *Parameter) EffectiveArgumentLabel() string {
 	return p.Identifier
 }
 
+// DefaultArgumentCount returns the number of parameters which have a default argument.
+//
+// Parameters with a default argument must be trailing,
+// so this is also the number of arguments that may be omitted in an invocation.
+//
+func DefaultArgumentCount(parameters []*Parameter) int {
+	count := 0
+	for _, parameter := range parameters {
+		if parameter.DefaultArgument != nil {
+			count++
+		}
+	}
+	return count
+}
+
 // TypeParameter
 
 type TypeParameter struct {
@@ -2304,8 +2740,33 @@ func (p TypeParameter) checkTypeBound(ty Type, typeRange ast.Range) error {
 
 // Function types
 
+// FunctionPurity
+type FunctionPurity int
+
+const (
+	FunctionPurityImpure FunctionPurity = iota
+	FunctionPurityView
+)
+
+func (p FunctionPurity) String() string {
+	if p == FunctionPurityView {
+		return "view"
+	}
+	return ""
+}
+
+// PurityFromAnnotation returns the function purity
+// for the given purity annotation
+func PurityFromAnnotation(purity ast.FunctionPurity) FunctionPurity {
+	if purity == ast.FunctionPurityView {
+		return FunctionPurityView
+	}
+	return FunctionPurityImpure
+}
+
 func formatFunctionType(
 	spaces bool,
+	purity string,
 	typeParameters []string,
 	parameters []string,
 	returnTypeAnnotation string,
@@ -2314,6 +2775,11 @@ func formatFunctionType(
 	var builder strings.Builder
 	builder.WriteRune('(')
 
+	if len(purity) > 0 {
+		builder.WriteString(purity)
+		builder.WriteRune(' ')
+	}
+
 	if len(typeParameters) > 0 {
 		builder.WriteRune('<')
 		for i, typeParameter := range typeParameters {
@@ -2350,6 +2816,7 @@ func formatFunctionType(
 //
 type FunctionType struct {
 	IsConstructor            bool
+	Purity                   FunctionPurity
 	TypeParameters           []*TypeParameter
 	Parameters               []*Parameter
 	ReturnTypeAnnotation     *TypeAnnotation
@@ -2397,6 +2864,7 @@ func (t *FunctionType) String() string {
 
 	return formatFunctionType(
 		true,
+		t.Purity.String(),
 		typeParameters,
 		parameters,
 		returnTypeAnnotation,
@@ -2421,6 +2889,7 @@ func (t *FunctionType) QualifiedString() string {
 
 	return formatFunctionType(
 		true,
+		t.Purity.String(),
 		typeParameters,
 		parameters,
 		returnTypeAnnotation,
@@ -2447,6 +2916,7 @@ func (t *FunctionType) ID() TypeID {
 	return TypeID(
 		formatFunctionType(
 			false,
+			t.Purity.String(),
 			typeParameters,
 			parameters,
 			returnTypeAnnotation,
@@ -2494,6 +2964,12 @@ func (t *FunctionType) Equal(other Type) bool {
 		return false
 	}
 
+	// purity
+
+	if t.Purity != otherFunction.Purity {
+		return false
+	}
+
 	// return type
 
 	if !t.ReturnTypeAnnotation.Type.
@@ -2648,9 +3124,10 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 				rewrittenParameterType, ok := rewrittenParameterTypes[parameter]
 				if ok {
 					rewrittenParameters[i] = &Parameter{
-						Label:          parameter.Label,
-						Identifier:     parameter.Identifier,
-						TypeAnnotation: NewTypeAnnotation(rewrittenParameterType),
+						Label:           parameter.Label,
+						Identifier:      parameter.Identifier,
+						TypeAnnotation:  NewTypeAnnotation(rewrittenParameterType),
+						DefaultArgument: parameter.DefaultArgument,
 					}
 				} else {
 					rewrittenParameters[i] = parameter
@@ -2659,6 +3136,7 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 		}
 
 		return &FunctionType{
+			Purity:                t.Purity,
 			TypeParameters:        rewrittenTypeParameters,
 			Parameters:            rewrittenParameters,
 			ReturnTypeAnnotation:  NewTypeAnnotation(rewrittenReturnType),
@@ -2755,9 +3233,10 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 
 		newParameters = append(newParameters,
 			&Parameter{
-				Label:          parameter.Label,
-				Identifier:     parameter.Identifier,
-				TypeAnnotation: NewTypeAnnotation(newParameterType),
+				Label:           parameter.Label,
+				Identifier:      parameter.Identifier,
+				TypeAnnotation:  NewTypeAnnotation(newParameterType),
+				DefaultArgument: parameter.DefaultArgument,
 			},
 		)
 	}
@@ -2770,6 +3249,7 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 	}
 
 	return &FunctionType{
+		Purity:                t.Purity,
 		Parameters:            newParameters,
 		ReturnTypeAnnotation:  NewTypeAnnotation(newReturnType),
 		RequiredArgumentCount: t.RequiredArgumentCount,
@@ -2836,6 +3316,10 @@ func init() {
 		PublicKeyType,
 		SignatureAlgorithmType,
 		HashAlgorithmType,
+		RoundingModeType,
+		MathType,
+		RLPType,
+		ABIType,
 	)
 
 	for _, ty := range types {
@@ -2879,10 +3363,12 @@ var BaseValueActivation = NewVariableActivation(nil)
 
 var AllSignedFixedPointTypes = []Type{
 	Fix64Type,
+	Fix128Type,
 }
 
 var AllUnsignedFixedPointTypes = []Type{
 	UFix64Type,
+	UFix128Type,
 }
 
 var AllFixedPointTypes = append(
@@ -2973,6 +3459,7 @@ func init() {
 			}
 
 			functionType := &FunctionType{
+				Purity: FunctionPurityView,
 				Parameters: []*Parameter{
 					{
 						Label:          ArgumentLabelNotRequired,
@@ -3095,6 +3582,7 @@ func init() {
 	}
 
 	functionType := &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -3166,6 +3654,7 @@ func init() {
 	}
 
 	functionType := &FunctionType{
+		Purity:               FunctionPurityView,
 		ReturnTypeAnnotation: NewTypeAnnotation(StringType),
 	}
 
@@ -3188,6 +3677,27 @@ func init() {
 		StringTypeEncodeHexFunctionDocString,
 	))
 
+	addMember(NewPublicFunctionMember(
+		functionType,
+		StringTypeJoinFunctionName,
+		StringTypeJoinFunctionType,
+		StringTypeJoinFunctionDocString,
+	))
+
+	addMember(NewPublicFunctionMember(
+		functionType,
+		StringTypeFromCharactersFunctionName,
+		StringTypeFromCharactersFunctionType,
+		StringTypeFromCharactersFunctionDocString,
+	))
+
+	addMember(NewPublicFunctionMember(
+		functionType,
+		StringTypeFromUTF8FunctionName,
+		StringTypeFromUTF8FunctionType,
+		StringTypeFromUTF8FunctionDocString,
+	))
+
 	BaseValueActivation.Set(
 		typeName,
 		baseFunctionVariable(
@@ -3199,6 +3709,7 @@ func init() {
 }
 
 var StringTypeEncodeHexFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	Parameters: []*Parameter{
 		{
 			Label:      ArgumentLabelNotRequired,
@@ -3338,6 +3849,7 @@ func init() {
 		baseFunctionVariable(
 			typeName,
 			&FunctionType{
+				Purity:               FunctionPurityView,
 				TypeParameters:       []*TypeParameter{{Name: "T"}},
 				ReturnTypeAnnotation: NewTypeAnnotation(MetaType),
 			},
@@ -3350,6 +3862,7 @@ func init() {
 		baseFunctionVariable(
 			PublicPathType.String(),
 			&FunctionType{
+				Purity: FunctionPurityView,
 				Parameters: []*Parameter{{
 					Identifier:     "identifier",
 					TypeAnnotation: NewTypeAnnotation(StringType),
@@ -3365,6 +3878,7 @@ func init() {
 		baseFunctionVariable(
 			PrivatePathType.String(),
 			&FunctionType{
+				Purity: FunctionPurityView,
 				Parameters: []*Parameter{{
 					Identifier:     "identifier",
 					TypeAnnotation: NewTypeAnnotation(StringType),
@@ -3380,6 +3894,7 @@ func init() {
 		baseFunctionVariable(
 			StoragePathType.String(),
 			&FunctionType{
+				Purity: FunctionPurityView,
 				Parameters: []*Parameter{{
 					Identifier:     "identifier",
 					TypeAnnotation: NewTypeAnnotation(StringType),
@@ -4258,6 +4773,24 @@ Removes the value for the given key from the dictionary.
 Returns the value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
 `
 
+const dictionaryTypeForEachKeyFunctionDocString = `
+Calls the given function for each key of the dictionary.
+
+Iteration stops when the function returns false
+`
+
+const dictionaryTypeFilterFunctionDocString = `
+Returns a new dictionary containing the entries of the dictionary for which the given function returns true.
+
+It does not modify the original dictionary
+`
+
+const dictionaryTypeMapValuesFunctionDocString = `
+Returns a new dictionary containing the keys of the dictionary, and the results of calling the given function on the associated values.
+
+It does not modify the original dictionary
+`
+
 func (t *DictionaryType) GetMembers() map[string]MemberResolver {
 	t.initializeMemberResolvers()
 	return t.memberResolvers
@@ -4322,37 +4855,100 @@ func (t *DictionaryType) initializeMemberResolvers() {
 						report(
 							&InvalidResourceDictionaryMemberError{
 								Name:            identifier,
-								DeclarationKind: common.DeclarationKindField,
+								DeclarationKind: common.DeclarationKindField,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicConstantFieldMember(
+						t,
+						identifier,
+						&VariableSizedType{Type: t.ValueType},
+						dictionaryTypeValuesFieldDocString,
+					)
+				},
+			},
+			"insert": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(t,
+						identifier,
+						DictionaryInsertFunctionType(t),
+						dictionaryTypeInsertFunctionDocString,
+					)
+				},
+			},
+			"remove": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(t,
+						identifier,
+						DictionaryRemoveFunctionType(t),
+						dictionaryTypeRemoveFunctionDocString,
+					)
+				},
+			},
+			"forEachKey": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					if t.KeyType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicFunctionMember(t,
+						identifier,
+						DictionaryForEachKeyFunctionType(t),
+						dictionaryTypeForEachKeyFunctionDocString,
+					)
+				},
+			},
+			"filter": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					if t.ValueType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
 								Range:           targetRange,
 							},
 						)
 					}
 
-					return NewPublicConstantFieldMember(
-						t,
-						identifier,
-						&VariableSizedType{Type: t.ValueType},
-						dictionaryTypeValuesFieldDocString,
-					)
-				},
-			},
-			"insert": {
-				Kind: common.DeclarationKindFunction,
-				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
 					return NewPublicFunctionMember(t,
 						identifier,
-						DictionaryInsertFunctionType(t),
-						dictionaryTypeInsertFunctionDocString,
+						DictionaryFilterFunctionType(t),
+						dictionaryTypeFilterFunctionDocString,
 					)
 				},
 			},
-			"remove": {
+			"mapValues": {
 				Kind: common.DeclarationKindFunction,
-				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					if t.ValueType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
 					return NewPublicFunctionMember(t,
 						identifier,
-						DictionaryRemoveFunctionType(t),
-						dictionaryTypeRemoveFunctionDocString,
+						DictionaryMapValuesFunctionType(t),
+						dictionaryTypeMapValuesFunctionDocString,
 					)
 				},
 			},
@@ -4362,6 +4958,7 @@ func (t *DictionaryType) initializeMemberResolvers() {
 
 func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
 	return &FunctionType{
+		Purity: FunctionPurityView,
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
@@ -4412,6 +5009,105 @@ func DictionaryRemoveFunctionType(t *DictionaryType) *FunctionType {
 	}
 }
 
+func DictionaryForEachKeyFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "key",
+								TypeAnnotation: NewTypeAnnotation(t.KeyType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			VoidType,
+		),
+	}
+}
+
+func DictionaryFilterFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "isIncluded",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "key",
+								TypeAnnotation: NewTypeAnnotation(t.KeyType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "value",
+								TypeAnnotation: NewTypeAnnotation(t.ValueType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			t,
+		),
+	}
+}
+
+func DictionaryMapValuesFunctionType(t *DictionaryType) *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:      "U",
+		TypeBound: AnyStructType,
+	}
+
+	resultType := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "transform",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "value",
+								TypeAnnotation: NewTypeAnnotation(t.ValueType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&DictionaryType{
+				KeyType:   t.KeyType,
+				ValueType: resultType,
+			},
+		),
+	}
+}
+
 func (*DictionaryType) isValueIndexableType() bool {
 	return true
 }
@@ -4467,6 +5163,203 @@ func (t *DictionaryType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Typ
 	}
 }
 
+// TupleType represents a fixed-size, heterogeneous sequence of elements,
+// e.g. `(Int, String)`
+
+type TupleType struct {
+	ElementTypes []Type
+}
+
+func (*TupleType) IsType() {}
+
+func (t *TupleType) Tag() TypeTag {
+	return TupleTypeTag
+}
+
+func (t *TupleType) string(typeFormatter func(Type) string) string {
+	var builder strings.Builder
+	builder.WriteRune('(')
+	for i, elementType := range t.ElementTypes {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(typeFormatter(elementType))
+	}
+	builder.WriteRune(')')
+	return builder.String()
+}
+
+func (t *TupleType) String() string {
+	return t.string(func(t Type) string {
+		return t.String()
+	})
+}
+
+func (t *TupleType) QualifiedString() string {
+	return t.string(func(t Type) string {
+		return t.QualifiedString()
+	})
+}
+
+func (t *TupleType) ID() TypeID {
+	var builder strings.Builder
+	builder.WriteRune('(')
+	for i, elementType := range t.ElementTypes {
+		if i > 0 {
+			builder.WriteRune(',')
+		}
+		builder.WriteString(string(elementType.ID()))
+	}
+	builder.WriteRune(')')
+	return TypeID(builder.String())
+}
+
+func (t *TupleType) Equal(other Type) bool {
+	otherTuple, ok := other.(*TupleType)
+	if !ok {
+		return false
+	}
+
+	if len(otherTuple.ElementTypes) != len(t.ElementTypes) {
+		return false
+	}
+
+	for i, elementType := range t.ElementTypes {
+		if !elementType.Equal(otherTuple.ElementTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *TupleType) IsResourceType() bool {
+	for _, elementType := range t.ElementTypes {
+		if elementType.IsResourceType() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TupleType) IsInvalidType() bool {
+	for _, elementType := range t.ElementTypes {
+		if elementType.IsInvalidType() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TupleType) IsStorable(results map[*Member]bool) bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsStorable(results) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *TupleType) IsExternallyReturnable(results map[*Member]bool) bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsExternallyReturnable(results) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *TupleType) IsImportable(results map[*Member]bool) bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsImportable(results) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *TupleType) IsEquatable() bool {
+	for _, elementType := range t.ElementTypes {
+		if !elementType.IsEquatable() {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *TupleType) TypeAnnotationState() TypeAnnotationState {
+	for _, elementType := range t.ElementTypes {
+		elementTypeAnnotationState := elementType.TypeAnnotationState()
+		if elementTypeAnnotationState != TypeAnnotationStateValid {
+			return elementTypeAnnotationState
+		}
+	}
+
+	return TypeAnnotationStateValid
+}
+
+func (t *TupleType) RewriteWithRestrictedTypes() (Type, bool) {
+	rewritten := false
+	rewrittenElementTypes := make([]Type, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		rewrittenElementType, elementTypeRewritten := elementType.RewriteWithRestrictedTypes()
+		rewrittenElementTypes[i] = rewrittenElementType
+		rewritten = rewritten || elementTypeRewritten
+	}
+
+	if rewritten {
+		return &TupleType{
+			ElementTypes: rewrittenElementTypes,
+		}, true
+	} else {
+		return t, false
+	}
+}
+
+func (t *TupleType) GetMembers() map[string]MemberResolver {
+	return withBuiltinMembers(t, nil)
+}
+
+func (t *TupleType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+
+	otherTuple, ok := other.(*TupleType)
+	if !ok || len(otherTuple.ElementTypes) != len(t.ElementTypes) {
+		return false
+	}
+
+	result := false
+
+	for i, elementType := range t.ElementTypes {
+		if elementType.Unify(otherTuple.ElementTypes[i], typeParameters, report, outerRange) {
+			result = true
+		}
+	}
+
+	return result
+}
+
+func (t *TupleType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newElementTypes := make([]Type, len(t.ElementTypes))
+
+	for i, elementType := range t.ElementTypes {
+		newElementType := elementType.Resolve(typeArguments)
+		if newElementType == nil {
+			return nil
+		}
+		newElementTypes[i] = newElementType
+	}
+
+	return &TupleType{
+		ElementTypes: newElementTypes,
+	}
+}
+
 // ReferenceType represents the reference to a value
 type ReferenceType struct {
 	Authorized bool
@@ -4691,6 +5584,7 @@ func (t *AddressType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
 const AddressTypeToBytesFunctionName = `toBytes`
 
 var AddressTypeToBytesFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		ByteArrayType,
 	),
@@ -4847,7 +5741,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 	case FixedPointType:
 		switch subType {
 		case FixedPointType, SignedFixedPointType,
-			UFix64Type:
+			UFix64Type, UFix128Type:
 
 			return true
 
@@ -4857,7 +5751,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 	case SignedFixedPointType:
 		switch subType {
-		case SignedFixedPointType, Fix64Type:
+		case SignedFixedPointType, Fix64Type, Fix128Type:
 			return true
 
 		default:
@@ -4895,6 +5789,26 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 			typedSuperType.ElementType(false),
 		)
 
+	case *TupleType:
+		typedSubType, ok := subType.(*TupleType)
+		if !ok {
+			return false
+		}
+
+		if len(typedSubType.ElementTypes) != len(typedSuperType.ElementTypes) {
+			return false
+		}
+
+		// Tuples are covariant: (T1, T2) <: (U1, U2) if T1 <: U1 and T2 <: U2
+
+		for i, elementType := range typedSubType.ElementTypes {
+			if !IsSubType(elementType, typedSuperType.ElementTypes[i]) {
+				return false
+			}
+		}
+
+		return true
+
 	case *ConstantSizedType:
 		typedSubType, ok := subType.(*ConstantSizedType)
 		if !ok {
@@ -5101,6 +6015,15 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 			return false
 		}
 
+		// A view function is a subtype of an impure function,
+		// but an impure function is not a subtype of a view function
+
+		if typedSuperType.Purity == FunctionPurityView &&
+			typedSubType.Purity != FunctionPurityView {
+
+			return false
+		}
+
 		// Functions are contravariant in their parameter types
 
 		for i, subParameter := range typedSubType.Parameters {
@@ -5951,6 +6874,7 @@ func CapabilityTypeBorrowFunctionType(borrowType Type) *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity:         FunctionPurityView,
 		TypeParameters: typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(
 			&OptionalType{
@@ -5971,6 +6895,7 @@ func CapabilityTypeCheckFunctionType(borrowType Type) *FunctionType {
 	}
 
 	return &FunctionType{
+		Purity:               FunctionPurityView,
 		TypeParameters:       typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
 	}
@@ -6047,6 +6972,10 @@ func init() {
 		PublicAccountType,
 		PublicAccountKeysType,
 		PublicAccountContractsType,
+		RoundingModeType,
+		MathType,
+		RLPType,
+		ABIType,
 	}
 
 	for _, semaType := range types {
@@ -6194,6 +7123,7 @@ var PublicKeyType = func() *CompositeType {
 }()
 
 var PublicKeyVerifyFunctionType = &FunctionType{
+	Purity:         FunctionPurityView,
 	TypeParameters: []*TypeParameter{},
 	Parameters: []*Parameter{
 		{
@@ -6221,6 +7151,7 @@ var PublicKeyVerifyFunctionType = &FunctionType{
 }
 
 var PublicKeyVerifyPoPFunctionType = &FunctionType{
+	Purity:         FunctionPurityView,
 	TypeParameters: []*TypeParameter{},
 	Parameters: []*Parameter{
 		{
@@ -6234,12 +7165,16 @@ var PublicKeyVerifyPoPFunctionType = &FunctionType{
 	ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
 }
 
-type CryptoAlgorithm interface {
+// NativeEnumCase is a case of a native enum type, e.g. a hash algorithm
+//
+type NativeEnumCase interface {
 	RawValue() uint8
 	Name() string
 	DocString() string
 }
 
+type CryptoAlgorithm = NativeEnumCase
+
 func GetMembersAsMap(members []*Member) *StringMemberOrderedMap {
 	membersMap := NewStringMemberOrderedMap()
 	for _, member := range members {
@@ -172,14 +172,14 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	fix64TypeMask
-	_ // future: Fix128
+	fix128TypeMask
 	_ // future: Fix256
 
 	_ // future: UFix8
 	_ // future: UFix16
 	_ // future: UFix32
 	ufix64TypeMask
-	_ // future: UFix128
+	ufix128TypeMask
 	_ // future: UFix256
 
 	stringTypeMask
@@ -219,6 +219,7 @@ const (
 	capabilityTypeMask uint64 = 1 << iota
 	restrictedTypeMask
 	transactionTypeMask
+	tupleTypeMask
 
 	invalidTypeMask
 )
@@ -254,10 +255,12 @@ var (
 			Or(UnsignedIntegerTypeTag)
 
 	SignedFixedPointTypeTag = newTypeTagFromLowerMask(signedFixedPointTypeMask).
-				Or(Fix64TypeTag)
+				Or(Fix64TypeTag).
+				Or(Fix128TypeTag)
 
 	UnsignedFixedPointTypeTag = newTypeTagFromLowerMask(unsignedFixedPointTypeMask).
-					Or(UFix64TypeTag)
+					Or(UFix64TypeTag).
+					Or(UFix128TypeTag)
 
 	FixedPointTypeTag = newTypeTagFromLowerMask(fixedPointTypeMask).
 				Or(SignedFixedPointTypeTag).
@@ -293,8 +296,10 @@ var (
 	Word32TypeTag = newTypeTagFromLowerMask(word32TypeMask)
 	Word64TypeTag = newTypeTagFromLowerMask(word64TypeMask)
 
-	Fix64TypeTag  = newTypeTagFromLowerMask(fix64TypeMask)
-	UFix64TypeTag = newTypeTagFromLowerMask(ufix64TypeMask)
+	Fix64TypeTag   = newTypeTagFromLowerMask(fix64TypeMask)
+	Fix128TypeTag  = newTypeTagFromLowerMask(fix128TypeMask)
+	UFix64TypeTag  = newTypeTagFromLowerMask(ufix64TypeMask)
+	UFix128TypeTag = newTypeTagFromLowerMask(ufix128TypeMask)
 
 	StringTypeTag           = newTypeTagFromLowerMask(stringTypeMask)
 	CharacterTypeTag        = newTypeTagFromLowerMask(characterTypeMask)
@@ -332,6 +337,7 @@ var (
 	CapabilityTypeTag  = newTypeTagFromUpperMask(capabilityTypeMask)
 	InvalidTypeTag     = newTypeTagFromUpperMask(invalidTypeMask)
 	TransactionTypeTag = newTypeTagFromUpperMask(transactionTypeMask)
+	TupleTypeTag       = newTypeTagFromUpperMask(tupleTypeMask)
 
 	// AnyStructTypeTag only includes the types that are pre-known
 	// to belong to AnyStruct type. This is more of an optimization.
@@ -366,7 +372,8 @@ var (
 			Or(GenericTypeTag).
 			Or(InterfaceTypeTag).
 			Or(TransactionTypeTag).
-			Or(RestrictedTypeTag)
+			Or(RestrictedTypeTag).
+			Or(TupleTypeTag)
 )
 
 // Methods
@@ -523,8 +530,12 @@ func findSuperTypeFromLowerMask(joinedTypeTag TypeTag, types []Type) Type {
 
 	case fix64TypeMask:
 		return Fix64Type
+	case fix128TypeMask:
+		return Fix128Type
 	case ufix64TypeMask:
 		return UFix64Type
+	case ufix128TypeMask:
+		return UFix128Type
 
 	case stringTypeMask:
 		return StringType
@@ -618,7 +629,8 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 	// All derived types goes here.
 	case capabilityTypeMask,
 		restrictedTypeMask,
-		transactionTypeMask:
+		transactionTypeMask,
+		tupleTypeMask:
 		return getSuperTypeOfDerivedTypes(types)
 	default:
 		return nil
@@ -0,0 +1,845 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// ABIError is reported when values cannot be ABI encoded,
+// or when ABI encoded data is invalid
+//
+type ABIError struct {
+	Err error
+	interpreter.LocationRange
+}
+
+func (e ABIError) Error() string {
+	return fmt.Sprintf("ABI error: %s", e.Err)
+}
+
+func (e ABIError) Unwrap() error {
+	return e.Err
+}
+
+var abiValue = interpreter.NewSimpleCompositeValue(
+	sema.ABIType.ID(),
+	interpreter.ConvertSemaToStaticType(sema.ABIType),
+	interpreter.CompositeDynamicType{
+		StaticType: sema.ABIType,
+	},
+	nil,
+	map[string]interpreter.Value{
+		sema.ABITypeEncodeFunctionName: abiEncodeFunction,
+		sema.ABITypeDecodeFunctionName: abiDecodeFunction,
+	},
+	nil,
+	nil,
+	nil,
+)
+
+var ABIValue = StandardLibraryValue{
+	Name: sema.ABITypeName,
+	Type: sema.ABIType,
+	ValueFactory: func(_ *interpreter.Interpreter) interpreter.Value {
+		return abiValue
+	},
+	Kind: common.DeclarationKindConstant,
+}
+
+var abiEncodeFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+
+		valuesArray, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.New("invalid values"))
+		}
+
+		var values []interpreter.Value
+		var types []sema.Type
+
+		var err error
+		valuesArray.Iterate(func(value interpreter.Value) (resume bool) {
+			var ty sema.Type
+			ty, err = inter.ConvertStaticToSemaType(value.StaticType())
+			if err != nil {
+				return false
+			}
+
+			values = append(values, value)
+			types = append(types, ty)
+			return true
+		})
+
+		var encoded []byte
+		if err == nil {
+			encoded, err = encodeABITuple(values, types)
+		}
+		if err != nil {
+			panic(ABIError{
+				Err:           err,
+				LocationRange: invocation.GetLocationRange(),
+			})
+		}
+
+		inter.MeterComputation(uint64(len(encoded)))
+
+		return interpreter.ByteSliceToByteArrayValue(inter, encoded)
+	},
+	sema.ABITypeEncodeFunctionType,
+)
+
+var abiDecodeFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+
+		data, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+		if err != nil {
+			panic(err)
+		}
+
+		typesArray, ok := invocation.Arguments[1].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.New("invalid types"))
+		}
+
+		inter.MeterComputation(uint64(len(data)))
+
+		var types []sema.Type
+
+		typesArray.Iterate(func(element interpreter.Value) (resume bool) {
+			typeValue, ok := element.(interpreter.TypeValue)
+			if !ok || typeValue.Type == nil {
+				err = errors.New("invalid type")
+				return false
+			}
+
+			var ty sema.Type
+			ty, err = inter.ConvertStaticToSemaType(typeValue.Type)
+			if err != nil {
+				return false
+			}
+
+			types = append(types, ty)
+			return true
+		})
+
+		var values []interpreter.Value
+		if err == nil {
+			values, err = newABIDecoder(inter, data).decodeTuple(data, types, 0)
+		}
+		if err != nil {
+			panic(ABIError{
+				Err:           err,
+				LocationRange: invocation.GetLocationRange(),
+			})
+		}
+
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeAnyStruct,
+			},
+			common.Address{},
+			values...,
+		)
+	},
+	sema.ABITypeDecodeFunctionType,
+)
+
+// abiWordLength is the length of the words ABI encoded data consists of
+//
+const abiWordLength = 32
+
+// abiMaxFixedBytesLength is the maximum length of fixed-size byte arrays (bytes1 to bytes32)
+//
+const abiMaxFixedBytesLength = 32
+
+type abiIntegerType struct {
+	bits   uint
+	signed bool
+}
+
+// abiIntegerTypes maps the integer types to the corresponding ABI integer types.
+// The arbitrary precision integer types correspond to the largest ABI integer types
+//
+var abiIntegerTypes = map[sema.Type]abiIntegerType{
+	sema.UInt8Type:   {bits: 8},
+	sema.UInt16Type:  {bits: 16},
+	sema.UInt32Type:  {bits: 32},
+	sema.UInt64Type:  {bits: 64},
+	sema.UInt128Type: {bits: 128},
+	sema.UInt256Type: {bits: 256},
+	sema.UIntType:    {bits: 256},
+	sema.Word8Type:   {bits: 8},
+	sema.Word16Type:  {bits: 16},
+	sema.Word32Type:  {bits: 32},
+	sema.Word64Type:  {bits: 64},
+	sema.Int8Type:    {bits: 8, signed: true},
+	sema.Int16Type:   {bits: 16, signed: true},
+	sema.Int32Type:   {bits: 32, signed: true},
+	sema.Int64Type:   {bits: 64, signed: true},
+	sema.Int128Type:  {bits: 128, signed: true},
+	sema.Int256Type:  {bits: 256, signed: true},
+	sema.IntType:     {bits: 256, signed: true},
+}
+
+func (t abiIntegerType) min() *big.Int {
+	if !t.signed {
+		return big.NewInt(0)
+	}
+	result := new(big.Int).Lsh(big.NewInt(1), t.bits-1)
+	return result.Neg(result)
+}
+
+func (t abiIntegerType) max() *big.Int {
+	bits := t.bits
+	if t.signed {
+		bits--
+	}
+	result := new(big.Int).Lsh(big.NewInt(1), bits)
+	return result.Sub(result, big.NewInt(1))
+}
+
+// abiFixedBytesLength returns the length of the ABI type bytesN corresponding to the given type,
+// i.e. N for the constant-sized byte array type [UInt8; N], or 0 if the type is not such a type
+//
+func abiFixedBytesLength(ty sema.Type) int {
+	constantSizedType, ok := ty.(*sema.ConstantSizedType)
+	if !ok ||
+		constantSizedType.Type != sema.UInt8Type ||
+		constantSizedType.Size < 1 ||
+		constantSizedType.Size > abiMaxFixedBytesLength {
+
+		return 0
+	}
+	return int(constantSizedType.Size)
+}
+
+// isABIDynamicType returns true if the ABI type corresponding to the given type is dynamic,
+// i.e. if values of the type are encoded in the tail of the enclosing tuple
+//
+func isABIDynamicType(ty sema.Type) bool {
+	switch ty := ty.(type) {
+	case *sema.VariableSizedType:
+		return true
+	case *sema.ConstantSizedType:
+		if abiFixedBytesLength(ty) > 0 {
+			return false
+		}
+		return isABIDynamicType(ty.Type)
+	}
+	return ty == sema.StringType
+}
+
+// abiMaxInt is the maximum value of an int
+//
+const abiMaxInt = int(^uint(0) >> 1)
+
+// abiHeadLength returns the length of the head of the encoding of a value of the given type
+//
+func abiHeadLength(ty sema.Type) (int, error) {
+	if isABIDynamicType(ty) {
+		return abiWordLength, nil
+	}
+
+	constantSizedType, ok := ty.(*sema.ConstantSizedType)
+	if ok && abiFixedBytesLength(ty) == 0 {
+		elementLength, err := abiHeadLength(constantSizedType.Type)
+		if err != nil {
+			return 0, err
+		}
+
+		size := constantSizedType.Size
+		if elementLength > 0 && size > int64(abiMaxInt/elementLength) {
+			return 0, fmt.Errorf(
+				"unsupported type %s: array is too large",
+				ty.QualifiedString(),
+			)
+		}
+
+		return int(size) * elementLength, nil
+	}
+
+	return abiWordLength, nil
+}
+
+func checkABIType(ty sema.Type) error {
+	if _, ok := abiIntegerTypes[ty]; ok {
+		return nil
+	}
+
+	switch ty := ty.(type) {
+	case *sema.VariableSizedType:
+		if ty.Type == sema.UInt8Type {
+			return nil
+		}
+		return checkABIType(ty.Type)
+
+	case *sema.ConstantSizedType:
+		if ty.Type == sema.UInt8Type {
+			if abiFixedBytesLength(ty) == 0 {
+				return fmt.Errorf(
+					"unsupported type %s: byte arrays must have at most %d elements",
+					ty.QualifiedString(),
+					abiMaxFixedBytesLength,
+				)
+			}
+			return nil
+		}
+		if ty.Size < 1 {
+			return fmt.Errorf(
+				"unsupported type %s: arrays must have at least one element",
+				ty.QualifiedString(),
+			)
+		}
+		return checkABIType(ty.Type)
+	}
+
+	if ty == sema.BoolType || ty == sema.StringType {
+		return nil
+	}
+
+	return fmt.Errorf("unsupported type %s", ty.QualifiedString())
+}
+
+func arrayElements(array *interpreter.ArrayValue) []interpreter.Value {
+	elements := make([]interpreter.Value, 0, array.Count())
+	array.Iterate(func(element interpreter.Value) (resume bool) {
+		elements = append(elements, element)
+		return true
+	})
+	return elements
+}
+
+func repeatedType(ty sema.Type, count int) []sema.Type {
+	types := make([]sema.Type, count)
+	for i := range types {
+		types[i] = ty
+	}
+	return types
+}
+
+// encodeABITuple returns the ABI encoding of the tuple of the given values of the given types
+//
+func encodeABITuple(values []interpreter.Value, types []sema.Type) ([]byte, error) {
+
+	headLength := 0
+	for _, ty := range types {
+		err := checkABIType(ty)
+		if err != nil {
+			return nil, err
+		}
+		length, err := abiHeadLength(ty)
+		if err != nil {
+			return nil, err
+		}
+		headLength += length
+	}
+
+	head := make([]byte, 0, headLength)
+	var tail []byte
+
+	for i, value := range values {
+		ty := types[i]
+
+		encoded, err := encodeABIValue(value, ty)
+		if err != nil {
+			return nil, err
+		}
+
+		if isABIDynamicType(ty) {
+			offset := big.NewInt(int64(headLength + len(tail)))
+			head = append(head, abiWord(offset)...)
+			tail = append(tail, encoded...)
+		} else {
+			head = append(head, encoded...)
+		}
+	}
+
+	return append(head, tail...), nil
+}
+
+func encodeABIValue(value interpreter.Value, ty sema.Type) ([]byte, error) {
+
+	if integerType, ok := abiIntegerTypes[ty]; ok {
+		var integer *big.Int
+		switch value := value.(type) {
+		case interpreter.BigNumberValue:
+			integer = value.ToBigInt()
+		case interpreter.NumberValue:
+			integer = big.NewInt(int64(value.ToInt()))
+		default:
+			return nil, fmt.Errorf("invalid %s value", ty.QualifiedString())
+		}
+
+		if integer.Cmp(integerType.min()) < 0 || integer.Cmp(integerType.max()) > 0 {
+			return nil, fmt.Errorf("%s value out of range", ty.QualifiedString())
+		}
+
+		return abiWord(integer), nil
+	}
+
+	switch value := value.(type) {
+	case interpreter.BoolValue:
+		if value {
+			return abiWord(big.NewInt(1)), nil
+		}
+		return abiWord(big.NewInt(0)), nil
+
+	case *interpreter.StringValue:
+		return encodeABIBytes([]byte(value.Str)), nil
+
+	case *interpreter.ArrayValue:
+		switch ty := ty.(type) {
+		case *sema.VariableSizedType:
+			if ty.Type == sema.UInt8Type {
+				bytes, err := interpreter.ByteArrayValueToByteSlice(value)
+				if err != nil {
+					return nil, err
+				}
+				return encodeABIBytes(bytes), nil
+			}
+
+			count := value.Count()
+			encoded, err := encodeABITuple(arrayElements(value), repeatedType(ty.Type, count))
+			if err != nil {
+				return nil, err
+			}
+			return append(abiWord(big.NewInt(int64(count))), encoded...), nil
+
+		case *sema.ConstantSizedType:
+			if abiFixedBytesLength(ty) > 0 {
+				bytes, err := interpreter.ByteArrayValueToByteSlice(value)
+				if err != nil {
+					return nil, err
+				}
+				return abiPadRight(bytes), nil
+			}
+
+			return encodeABITuple(arrayElements(value), repeatedType(ty.Type, value.Count()))
+		}
+	}
+
+	return nil, fmt.Errorf("invalid %s value", ty.QualifiedString())
+}
+
+// abiWord returns the 32-byte big-endian two's complement representation of the given integer
+//
+func abiWord(integer *big.Int) []byte {
+	word := make([]byte, abiWordLength)
+
+	if integer.Sign() < 0 {
+		// Two's complement: 2^256 + integer
+		integer = new(big.Int).Add(
+			new(big.Int).Lsh(big.NewInt(1), abiWordLength*8),
+			integer,
+		)
+	}
+
+	integer.FillBytes(word)
+	return word
+}
+
+// abiPadRight returns the given bytes, right-padded with zeros to a multiple of the word length
+//
+func abiPadRight(bytes []byte) []byte {
+	length := (len(bytes) + abiWordLength - 1) / abiWordLength * abiWordLength
+	padded := make([]byte, length)
+	copy(padded, bytes)
+	return padded
+}
+
+func encodeABIBytes(bytes []byte) []byte {
+	return append(
+		abiWord(big.NewInt(int64(len(bytes)))),
+		abiPadRight(bytes)...,
+	)
+}
+
+var abiUnexpectedEndError = errors.New("unexpected end of data")
+
+// abiMaxDepth is the maximum nesting depth of decoded ABI values
+//
+const abiMaxDepth = 1024
+
+var abiMaxDepthError = fmt.Errorf("maximum nesting depth of %d exceeded", abiMaxDepth)
+
+var abiOverlappingDataError = errors.New("decoded values exceed the data: overlapping offsets")
+
+// abiDecoder decodes ABI encoded data.
+//
+// The offsets of dynamic values are not required to be distinct, so the encodings of values may overlap,
+// and small data could decode into a huge number of values.
+// In a valid encoding, each decoded word is a different word of the data,
+// so the decoder rejects data which decodes into more words than it contains
+//
+type abiDecoder struct {
+	inter          *interpreter.Interpreter
+	remainingWords int
+}
+
+func newABIDecoder(inter *interpreter.Interpreter, data []byte) *abiDecoder {
+	return &abiDecoder{
+		inter:          inter,
+		remainingWords: len(data) / abiWordLength,
+	}
+}
+
+// decodeWords accounts for the decoding of the given number of words
+//
+func (d *abiDecoder) decodeWords(count int) error {
+	if count > d.remainingWords {
+		return abiOverlappingDataError
+	}
+	d.remainingWords -= count
+	return nil
+}
+
+// decodeTuple decodes the tuple of values of the given types from the given data
+//
+func (d *abiDecoder) decodeTuple(data []byte, types []sema.Type, depth int) ([]interpreter.Value, error) {
+	return d.decodeElements(
+		data,
+		len(types),
+		func(i int) sema.Type {
+			return types[i]
+		},
+		depth,
+	)
+}
+
+// decodeArray decodes the given number of elements of the given type from the given data
+//
+func (d *abiDecoder) decodeArray(
+	data []byte,
+	elementType sema.Type,
+	count int,
+	depth int,
+) ([]interpreter.Value, error) {
+
+	err := checkABIType(elementType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each element decodes into at least one word,
+	// so the count is bounded by the number of remaining words
+
+	if count > d.remainingWords {
+		return nil, abiUnexpectedEndError
+	}
+
+	return d.decodeElements(
+		data,
+		count,
+		func(_ int) sema.Type {
+			return elementType
+		},
+		depth,
+	)
+}
+
+// decodeElements decodes the given number of values from the given data.
+// The type of each value is determined by the given function
+//
+func (d *abiDecoder) decodeElements(
+	data []byte,
+	count int,
+	elementType func(index int) sema.Type,
+	depth int,
+) ([]interpreter.Value, error) {
+
+	if depth > abiMaxDepth {
+		return nil, abiMaxDepthError
+	}
+
+	values := make([]interpreter.Value, 0, count)
+
+	offset := 0
+
+	for i := 0; i < count; i++ {
+		ty := elementType(i)
+
+		err := checkABIType(ty)
+		if err != nil {
+			return nil, err
+		}
+
+		headLength, err := abiHeadLength(ty)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data)-offset < headLength {
+			return nil, abiUnexpectedEndError
+		}
+
+		var value interpreter.Value
+
+		if isABIDynamicType(ty) {
+			err = d.decodeWords(1)
+			if err != nil {
+				return nil, err
+			}
+
+			tailOffset, err := decodeABILength(data[offset:], len(data))
+			if err != nil {
+				return nil, err
+			}
+
+			value, err = d.decodeValue(data[tailOffset:], ty, depth+1)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			value, err = d.decodeValue(data[offset:offset+headLength], ty, depth+1)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		values = append(values, value)
+
+		offset += headLength
+	}
+
+	return values, nil
+}
+
+// decodeABILength decodes the length or offset at the start of the given data,
+// which must be at most the given maximum
+//
+func decodeABILength(data []byte, max int) (int, error) {
+	if len(data) < abiWordLength {
+		return 0, abiUnexpectedEndError
+	}
+
+	length := new(big.Int).SetBytes(data[:abiWordLength])
+	if !length.IsInt64() || length.Int64() > int64(max) {
+		return 0, abiUnexpectedEndError
+	}
+
+	return int(length.Int64()), nil
+}
+
+func (d *abiDecoder) decodeBytes(data []byte) ([]byte, error) {
+	length, err := decodeABILength(data, len(data)-abiWordLength)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.decodeWords(1 + (length+abiWordLength-1)/abiWordLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return data[abiWordLength : abiWordLength+length], nil
+}
+
+func (d *abiDecoder) decodeValue(data []byte, ty sema.Type, depth int) (interpreter.Value, error) {
+
+	inter := d.inter
+
+	if integerType, ok := abiIntegerTypes[ty]; ok {
+		if len(data) < abiWordLength {
+			return nil, abiUnexpectedEndError
+		}
+
+		err := d.decodeWords(1)
+		if err != nil {
+			return nil, err
+		}
+
+		integer := new(big.Int).SetBytes(data[:abiWordLength])
+		if integerType.signed && data[0]&0x80 != 0 {
+			// Two's complement: integer - 2^256
+			integer.Sub(integer, new(big.Int).Lsh(big.NewInt(1), abiWordLength*8))
+		}
+
+		if integer.Cmp(integerType.min()) < 0 || integer.Cmp(integerType.max()) > 0 {
+			return nil, fmt.Errorf("%s value out of range", ty.QualifiedString())
+		}
+
+		return abiIntegerValue(ty, integer), nil
+	}
+
+	switch ty := ty.(type) {
+	case *sema.VariableSizedType:
+		if ty.Type == sema.UInt8Type {
+			bytes, err := d.decodeBytes(data)
+			if err != nil {
+				return nil, err
+			}
+			return interpreter.ByteSliceToByteArrayValue(inter, bytes), nil
+		}
+
+		// Each element has a head of at least one word,
+		// so the count is bounded by the length of the data
+
+		count, err := decodeABILength(data, (len(data)-abiWordLength)/abiWordLength)
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.decodeWords(1)
+		if err != nil {
+			return nil, err
+		}
+
+		elements, err := d.decodeArray(data[abiWordLength:], ty.Type, count, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.ConvertSemaToStaticType(ty).(interpreter.ArrayStaticType),
+			common.Address{},
+			elements...,
+		), nil
+
+	case *sema.ConstantSizedType:
+		if length := abiFixedBytesLength(ty); length > 0 {
+			if len(data) < abiWordLength {
+				return nil, abiUnexpectedEndError
+			}
+
+			err := d.decodeWords(1)
+			if err != nil {
+				return nil, err
+			}
+
+			elements := make([]interpreter.Value, length)
+			for i, b := range data[:length] {
+				elements[i] = interpreter.UInt8Value(b)
+			}
+
+			return interpreter.NewArrayValue(
+				inter,
+				interpreter.ConvertSemaToStaticType(ty).(interpreter.ArrayStaticType),
+				common.Address{},
+				elements...,
+			), nil
+		}
+
+		if ty.Size > int64(d.remainingWords) {
+			return nil, abiUnexpectedEndError
+		}
+
+		elements, err := d.decodeArray(data, ty.Type, int(ty.Size), depth)
+		if err != nil {
+			return nil, err
+		}
+
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.ConvertSemaToStaticType(ty).(interpreter.ArrayStaticType),
+			common.Address{},
+			elements...,
+		), nil
+	}
+
+	switch ty {
+	case sema.BoolType:
+		if len(data) < abiWordLength {
+			return nil, abiUnexpectedEndError
+		}
+
+		err := d.decodeWords(1)
+		if err != nil {
+			return nil, err
+		}
+
+		word := new(big.Int).SetBytes(data[:abiWordLength])
+		switch {
+		case word.Sign() == 0:
+			return interpreter.BoolValue(false), nil
+		case word.Cmp(big.NewInt(1)) == 0:
+			return interpreter.BoolValue(true), nil
+		}
+
+		return nil, errors.New("invalid Bool value")
+
+	case sema.StringType:
+		bytes, err := d.decodeBytes(data)
+		if err != nil {
+			return nil, err
+		}
+
+		if !utf8.Valid(bytes) {
+			return nil, errors.New("invalid String value: invalid UTF-8")
+		}
+
+		return interpreter.NewStringValue(string(bytes)), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", ty.QualifiedString())
+}
+
+func abiIntegerValue(ty sema.Type, integer *big.Int) interpreter.Value {
+	switch ty {
+	case sema.UInt8Type:
+		return interpreter.UInt8Value(integer.Uint64())
+	case sema.UInt16Type:
+		return interpreter.UInt16Value(integer.Uint64())
+	case sema.UInt32Type:
+		return interpreter.UInt32Value(integer.Uint64())
+	case sema.UInt64Type:
+		return interpreter.UInt64Value(integer.Uint64())
+	case sema.UInt128Type:
+		return interpreter.NewUInt128ValueFromBigInt(integer)
+	case sema.UInt256Type:
+		return interpreter.NewUInt256ValueFromBigInt(integer)
+	case sema.UIntType:
+		return interpreter.NewUIntValueFromBigInt(integer)
+	case sema.Word8Type:
+		return interpreter.Word8Value(integer.Uint64())
+	case sema.Word16Type:
+		return interpreter.Word16Value(integer.Uint64())
+	case sema.Word32Type:
+		return interpreter.Word32Value(integer.Uint64())
+	case sema.Word64Type:
+		return interpreter.Word64Value(integer.Uint64())
+	case sema.Int8Type:
+		return interpreter.Int8Value(integer.Int64())
+	case sema.Int16Type:
+		return interpreter.Int16Value(integer.Int64())
+	case sema.Int32Type:
+		return interpreter.Int32Value(integer.Int64())
+	case sema.Int64Type:
+		return interpreter.Int64Value(integer.Int64())
+	case sema.Int128Type:
+		return interpreter.NewInt128ValueFromBigInt(integer)
+	case sema.Int256Type:
+		return interpreter.NewInt256ValueFromBigInt(integer)
+	case sema.IntType:
+		return interpreter.NewIntValueFromBigInt(integer)
+	}
+
+	panic(fmt.Errorf("unsupported integer type %s", ty.QualifiedString()))
+}
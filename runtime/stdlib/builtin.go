@@ -39,6 +39,7 @@ The message argument is optional.
 var AssertFunction = NewStandardLibraryFunction(
 	"assert",
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Label:          sema.ArgumentLabelNotRequired,
@@ -92,6 +93,7 @@ Terminates the program unconditionally and reports a message which explains why
 var PanicFunction = NewStandardLibraryFunction(
 	"panic",
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Label:          sema.ArgumentLabelNotRequired,
@@ -121,6 +123,8 @@ var BuiltinFunctions = StandardLibraryFunctions{
 	CreatePublicKeyFunction,
 	AggregateBLSSignaturesFunction,
 	AggregateBLSPublicKeysFunction,
+	RecoverSecp256k1PublicKeyFunction,
+	EVMAddressFunction,
 }
 
 // LogFunction
@@ -152,6 +156,7 @@ Constructs a new public key
 var CreatePublicKeyFunction = NewStandardLibraryFunction(
 	sema.PublicKeyTypeName,
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Identifier:     sema.PublicKeyPublicKeyField,
@@ -196,6 +201,7 @@ The function errors if the array is empty or if decoding one of the signature fa
 var AggregateBLSSignaturesFunction = NewStandardLibraryFunction(
 	"AggregateBLSSignatures",
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Label:          sema.ArgumentLabelNotRequired,
@@ -224,6 +230,7 @@ The function errors if the array is empty or any of the input keys is not a BLS
 var AggregateBLSPublicKeysFunction = NewStandardLibraryFunction(
 	"AggregateBLSPublicKeys",
 	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []*sema.Parameter{
 			{
 				Label:          sema.ArgumentLabelNotRequired,
@@ -244,6 +251,145 @@ var AggregateBLSPublicKeysFunction = NewStandardLibraryFunction(
 	},
 )
 
+const recoverSecp256k1PublicKeyFunctionDocString = `
+Recovers the ECDSA_secp256k1 public key which produced the given signature for the given hash.
+
+The signature must be 65 bytes long: the 32-byte r value, the 32-byte s value,
+and the recovery ID, which is either 0 or 1, or 27 or 28 (Ethereum style).
+The hash must be 32 bytes long.
+
+Returns nil if no public key can be recovered from the signature.
+`
+
+// InvalidSecp256k1RecoveryInputError is reported when a secp256k1 public key
+// is to be recovered from a signature or hash which has an invalid length
+//
+type InvalidSecp256k1RecoveryInputError struct {
+	Name           string
+	ExpectedLength int
+	ActualLength   int
+	interpreter.LocationRange
+}
+
+func (e InvalidSecp256k1RecoveryInputError) Error() string {
+	return fmt.Sprintf(
+		"invalid %s: length must be %d, got %d",
+		e.Name,
+		e.ExpectedLength,
+		e.ActualLength,
+	)
+}
+
+var RecoverSecp256k1PublicKeyFunction = NewStandardLibraryFunction(
+	"RecoverSecp256k1PublicKey",
+	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []*sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "signature",
+				TypeAnnotation: sema.NewTypeAnnotation(sema.ByteArrayType),
+			},
+			{
+				Identifier:     "hash",
+				TypeAnnotation: sema.NewTypeAnnotation(sema.ByteArrayType),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(
+			&sema.OptionalType{
+				Type: sema.PublicKeyType,
+			},
+		),
+	},
+	recoverSecp256k1PublicKeyFunctionDocString,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		signature := invocation.Arguments[0].(*interpreter.ArrayValue)
+		hash := invocation.Arguments[1].(*interpreter.ArrayValue)
+
+		inter := invocation.Interpreter
+
+		return inter.RecoverSecp256k1PublicKeyHandler(
+			inter,
+			invocation.GetLocationRange,
+			signature,
+			hash,
+		)
+	},
+)
+
+// InvalidEVMPublicKeyError is reported when the EVM address is requested
+// for a public key which is not an ECDSA_secp256k1 key,
+// or which is not an uncompressed key, in which case Err is the reason
+//
+type InvalidEVMPublicKeyError struct {
+	Err error
+	interpreter.LocationRange
+}
+
+func (e InvalidEVMPublicKeyError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf(
+		"invalid public key: expected %s key",
+		sema.SignatureAlgorithmECDSA_secp256k1.Name(),
+	)
+}
+
+const evmAddressFunctionDocString = `
+Returns the 20-byte EVM address of the given ECDSA_secp256k1 public key,
+i.e. the last 20 bytes of the Keccak-256 hash of the uncompressed public key
+`
+
+var EVMAddressFunction = NewStandardLibraryFunction(
+	"EVMAddress",
+	&sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []*sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "publicKey",
+				TypeAnnotation: sema.NewTypeAnnotation(sema.PublicKeyType),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.ByteArrayType),
+	},
+	evmAddressFunctionDocString,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		publicKey := invocation.Arguments[0].(interpreter.MemberAccessibleValue)
+
+		inter := invocation.Interpreter
+		getLocationRange := invocation.GetLocationRange
+
+		signAlgoValue := publicKey.GetMember(inter, getLocationRange, sema.PublicKeySignAlgoField)
+		signAlgo := signAlgoValue.(*interpreter.CompositeValue).
+			GetField(sema.EnumRawValueFieldName).(interpreter.UInt8Value)
+
+		if uint8(signAlgo) != sema.SignatureAlgorithmECDSA_secp256k1.RawValue() {
+			panic(InvalidEVMPublicKeyError{
+				LocationRange: getLocationRange(),
+			})
+		}
+
+		keyValue := publicKey.GetMember(inter, getLocationRange, sema.PublicKeyPublicKeyField)
+		key, err := interpreter.ByteArrayValueToByteSlice(keyValue)
+		if err != nil {
+			panic(err)
+		}
+
+		address, err := EVMAddress(key)
+		if err != nil {
+			panic(InvalidEVMPublicKeyError{
+				Err:           err,
+				LocationRange: getLocationRange(),
+			})
+		}
+
+		return interpreter.ByteSliceToByteArrayValue(inter, address)
+	},
+)
+
 func AggregateBLSPublicKeys(
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
@@ -310,16 +456,16 @@ func AggregateBLSSignatures(
 func BuiltinValues() StandardLibraryValues {
 	signatureAlgorithmValue := StandardLibraryValue{
 		Name: sema.SignatureAlgorithmTypeName,
-		Type: cryptoAlgorithmEnumConstructorType(
+		Type: nativeEnumConstructorType(
 			sema.SignatureAlgorithmType,
 			sema.SignatureAlgorithms,
 		),
 		ValueFactory: func(inter *interpreter.Interpreter) interpreter.Value {
-			return cryptoAlgorithmEnumValue(
+			return nativeEnumValue(
 				inter,
-				interpreter.ReturnEmptyLocationRange,
 				sema.SignatureAlgorithmType,
 				sema.SignatureAlgorithms,
+				len(sema.SignatureAlgorithms),
 				NewSignatureAlgorithmCase,
 			)
 		},
@@ -328,16 +474,16 @@ func BuiltinValues() StandardLibraryValues {
 
 	hashAlgorithmValue := StandardLibraryValue{
 		Name: sema.HashAlgorithmTypeName,
-		Type: cryptoAlgorithmEnumConstructorType(
+		Type: nativeEnumConstructorType(
 			sema.HashAlgorithmType,
 			sema.HashAlgorithms,
 		),
 		ValueFactory: func(inter *interpreter.Interpreter) interpreter.Value {
-			return cryptoAlgorithmEnumValue(
+			return nativeEnumValue(
 				inter,
-				interpreter.ReturnEmptyLocationRange,
 				sema.HashAlgorithmType,
 				sema.HashAlgorithms,
+				eagerHashAlgorithmCaseCount,
 				NewHashAlgorithmCase,
 			)
 		},
@@ -347,6 +493,10 @@ func BuiltinValues() StandardLibraryValues {
 	return StandardLibraryValues{
 		signatureAlgorithmValue,
 		hashAlgorithmValue,
+		RoundingModeValue,
+		MathValue,
+		RLPValue,
+		ABIValue,
 	}
 }
 
@@ -359,6 +509,11 @@ func NewSignatureAlgorithmCase(inter *interpreter.Interpreter, rawValue uint8) *
 	)
 }
 
+// eagerHashAlgorithmCaseCount is the number of hash algorithms which existed
+// before KECCAK_256 and BLAKE2B_256 were added. See nativeEnumValue
+//
+const eagerHashAlgorithmCaseCount = 5
+
 var hashAlgorithmFunctions = map[string]interpreter.FunctionValue{
 	sema.HashAlgorithmTypeHashFunctionName:        hashAlgorithmHashFunction,
 	sema.HashAlgorithmTypeHashWithTagFunctionName: hashAlgorithmHashWithTagFunction,
@@ -426,9 +581,9 @@ var hashAlgorithmHashWithTagFunction = interpreter.NewHostFunctionValue(
 	sema.HashAlgorithmTypeHashWithTagFunctionType,
 )
 
-func cryptoAlgorithmEnumConstructorType(
+func nativeEnumConstructorType(
 	enumType *sema.CompositeType,
-	enumCases []sema.CryptoAlgorithm,
+	enumCases []sema.NativeEnumCase,
 ) *sema.FunctionType {
 
 	members := make([]*sema.Member, len(enumCases))
@@ -442,6 +597,7 @@ func cryptoAlgorithmEnumConstructorType(
 	}
 
 	constructorType := &sema.FunctionType{
+		Purity:        sema.FunctionPurityView,
 		IsConstructor: true,
 		Parameters: []*sema.Parameter{
 			{
@@ -460,31 +616,65 @@ func cryptoAlgorithmEnumConstructorType(
 	return constructorType
 }
 
-func cryptoAlgorithmEnumValue(
+// nativeEnumValue returns the constructor function of a native enum,
+// which has the enum cases as nested values.
+//
+// Only the first eagerCaseCount cases are created when the constructor is created,
+// all other cases are created when they are first used.
+//
+// NOTE: Enum case values are stored in slabs, and the IDs of slabs determine the hash seeds,
+// and thus the iteration order, of dictionaries which are created later.
+// Cases which are added to an existing enum, or cases of a new enum,
+// must therefore be created lazily, so the iteration order of dictionaries in existing programs does not change
+//
+func nativeEnumValue(
 	inter *interpreter.Interpreter,
-	getLocationRange func() interpreter.LocationRange,
 	enumType *sema.CompositeType,
-	enumCases []sema.CryptoAlgorithm,
+	enumCases []sema.NativeEnumCase,
+	eagerCaseCount int,
 	caseConstructor func(inter *interpreter.Interpreter, rawValue uint8) *interpreter.CompositeValue,
 ) interpreter.Value {
 
-	caseCount := len(enumCases)
-	caseValues := make([]*interpreter.CompositeValue, caseCount)
+	// Prepare a lookup table based on the big-endian byte representation
+
+	lookupTable := map[string]*interpreter.Variable{}
 	constructorNestedVariables := map[string]*interpreter.Variable{}
 
 	for i, enumCase := range enumCases {
 		rawValue := enumCase.RawValue()
-		caseValue := caseConstructor(inter, rawValue)
-		caseValues[i] = caseValue
-		constructorNestedVariables[enumCase.Name()] =
-			interpreter.NewVariableWithValue(caseValue)
+
+		var variable *interpreter.Variable
+		if i < eagerCaseCount {
+			variable = interpreter.NewVariableWithValue(caseConstructor(inter, rawValue))
+		} else {
+			variable = interpreter.NewVariableWithGetter(func() interpreter.Value {
+				return caseConstructor(inter, rawValue)
+			})
+		}
+
+		rawValueBigEndianBytes := interpreter.UInt8Value(rawValue).ToBigEndianBytes()
+		lookupTable[string(rawValueBigEndianBytes)] = variable
+		constructorNestedVariables[enumCase.Name()] = variable
 	}
 
-	return interpreter.EnumConstructorFunction(
-		inter,
-		getLocationRange,
-		enumType,
-		caseValues,
-		constructorNestedVariables,
+	// Prepare the constructor function which performs a lookup in the lookup table
+
+	constructor := interpreter.NewHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+
+			rawValueArgumentBigEndianBytes := invocation.Arguments[0].(interpreter.IntegerValue).ToBigEndianBytes()
+
+			variable, ok := lookupTable[string(rawValueArgumentBigEndianBytes)]
+			if !ok {
+				return interpreter.NilValue{}
+			}
+
+			return interpreter.NewSomeValueNonCopying(variable.GetValue())
+		},
+		sema.EnumConstructorType(enumType),
 	)
+
+	constructor.NestedVariables = constructorNestedVariables
+
+	return constructor
 }
@@ -54,6 +54,7 @@ Returns the public account for the given address
 `
 
 var getAccountFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      sema.ArgumentLabelNotRequired,
@@ -69,6 +70,7 @@ var getAccountFunctionType = &sema.FunctionType{
 }
 
 var LogFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      sema.ArgumentLabelNotRequired,
@@ -88,6 +90,7 @@ Returns the current block, i.e. the block which contains the currently executed
 `
 
 var getCurrentBlockFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	ReturnTypeAnnotation: sema.NewTypeAnnotation(
 		sema.BlockType,
 	),
@@ -98,6 +101,7 @@ Returns the block at the given height. If the given block does not exist the fun
 `
 
 var getBlockFunctionType = &sema.FunctionType{
+	Purity: sema.FunctionPurityView,
 	Parameters: []*sema.Parameter{
 		{
 			Label:      "at",
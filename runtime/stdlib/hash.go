@@ -0,0 +1,88 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// HashTagLength is the length of the domain separation tag
+// which is prepended to the data by DefaultHash
+//
+const HashTagLength = 32
+
+// DefaultHash is a pure Go reference implementation of the host hash function,
+// e.g. for the REPL and tests.
+//
+// If the tag is not empty, it is right-padded with zeros to HashTagLength bytes
+// and prepended to the data.
+//
+// KMAC128_BLS_BLS12_381 is not supported, as it is specific to the BLS implementation of the host.
+//
+func DefaultHash(data []byte, tag string, hashAlgorithm sema.HashAlgorithm) ([]byte, error) {
+
+	var hasher hash.Hash
+
+	switch hashAlgorithm {
+	case sema.HashAlgorithmSHA2_256:
+		hasher = sha256.New()
+	case sema.HashAlgorithmSHA2_384:
+		hasher = sha512.New384()
+	case sema.HashAlgorithmSHA3_256:
+		hasher = sha3.New256()
+	case sema.HashAlgorithmSHA3_384:
+		hasher = sha3.New384()
+	case sema.HashAlgorithmKECCAK_256:
+		hasher = sha3.NewLegacyKeccak256()
+	case sema.HashAlgorithmBLAKE2B_256:
+		var err error
+		hasher, err = blake2b.New256(nil)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm: %s", hashAlgorithm.Name())
+	}
+
+	if tag != "" {
+		if len(tag) > HashTagLength {
+			return nil, fmt.Errorf(
+				"invalid hash tag: length must be at most %d, got %d",
+				HashTagLength,
+				len(tag),
+			)
+		}
+
+		var paddedTag [HashTagLength]byte
+		copy(paddedTag[:], tag)
+		hasher.Write(paddedTag[:])
+	}
+
+	hasher.Write(data)
+
+	return hasher.Sum(nil), nil
+}
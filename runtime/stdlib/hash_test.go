@@ -0,0 +1,113 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestDefaultHash(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		data     string
+		tag      string
+		expected string
+	}
+
+	tests := map[sema.HashAlgorithm]testCase{
+		sema.HashAlgorithmSHA2_256: {
+			data:     "abc",
+			expected: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		},
+		sema.HashAlgorithmSHA2_384: {
+			data:     "abc",
+			expected: "cb00753f45a35e8bb5a03d699ac65007272c32ab0eded1631a8b605a43ff5bed8086072ba1e7cc2358baeca134c825a7",
+		},
+		sema.HashAlgorithmSHA3_256: {
+			data:     "abc",
+			expected: "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532",
+		},
+		sema.HashAlgorithmSHA3_384: {
+			data:     "abc",
+			expected: "ec01498288516fc926459f58e2c6ad8df9b473cb0fc08c2596da7cf0e49be4b298d88cea927ac7f539f1edf228376d25",
+		},
+		sema.HashAlgorithmKECCAK_256: {
+			data:     "",
+			expected: "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		},
+		sema.HashAlgorithmBLAKE2B_256: {
+			data:     "abc",
+			expected: "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+		},
+	}
+
+	for _, algorithm := range sema.HashAlgorithms {
+		hashAlgorithm := algorithm.(sema.HashAlgorithm)
+
+		test, ok := tests[hashAlgorithm]
+		if !ok {
+			// Not supported by the reference implementation
+			continue
+		}
+
+		t.Run(hashAlgorithm.Name(), func(t *testing.T) {
+
+			result, err := DefaultHash([]byte(test.data), test.tag, hashAlgorithm)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, hex.EncodeToString(result))
+		})
+	}
+
+	t.Run("tag", func(t *testing.T) {
+
+		result, err := DefaultHash([]byte("abc"), "tag", sema.HashAlgorithmSHA2_256)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"87f7583c3d0a06c1b3da5c330132b01a16b0ab6df03b9d7647ea46b6ac02bc7d",
+			hex.EncodeToString(result),
+		)
+	})
+
+	t.Run("tag too long", func(t *testing.T) {
+
+		_, err := DefaultHash(
+			[]byte("abc"),
+			strings.Repeat("a", HashTagLength+1),
+			sema.HashAlgorithmSHA2_256,
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+
+		_, err := DefaultHash([]byte("abc"), "", sema.HashAlgorithmKMAC128_BLS_BLS12_381)
+		require.Error(t, err)
+	})
+}
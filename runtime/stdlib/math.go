@@ -0,0 +1,259 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/onflow/cadence/fixedpoint"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// MathDomainError is reported when a math function is called
+// with an argument for which the function is not defined, e.g. `Math.ln(0.0)`
+//
+type MathDomainError struct {
+	FunctionName string
+	interpreter.LocationRange
+}
+
+func (e MathDomainError) Error() string {
+	return fmt.Sprintf(
+		"argument is out of domain of function `%s`",
+		e.FunctionName,
+	)
+}
+
+var mathValue = interpreter.NewSimpleCompositeValue(
+	sema.MathType.ID(),
+	interpreter.ConvertSemaToStaticType(sema.MathType),
+	interpreter.CompositeDynamicType{
+		StaticType: sema.MathType,
+	},
+	nil,
+	map[string]interpreter.Value{
+		sema.MathTypeSqrtFunctionName:   mathSqrtFunction,
+		sema.MathTypePowFunctionName:    mathPowFunction,
+		sema.MathTypeLnFunctionName:     mathLnFunction,
+		sema.MathTypeExpFunctionName:    mathExpFunction,
+		sema.MathTypeMulDivFunctionName: mathMulDivFunction,
+	},
+	nil,
+	nil,
+	nil,
+)
+
+var MathValue = StandardLibraryValue{
+	Name: sema.MathTypeName,
+	Type: sema.MathType,
+	ValueFactory: func(_ *interpreter.Interpreter) interpreter.Value {
+		return mathValue
+	},
+	Kind: common.DeclarationKindConstant,
+}
+
+var RoundingModeValue = StandardLibraryValue{
+	Name: sema.RoundingModeTypeName,
+	Type: nativeEnumConstructorType(
+		sema.RoundingModeType,
+		sema.RoundingModes,
+	),
+	ValueFactory: func(inter *interpreter.Interpreter) interpreter.Value {
+		return nativeEnumValue(
+			inter,
+			sema.RoundingModeType,
+			sema.RoundingModes,
+			0,
+			NewRoundingModeCase,
+		)
+	},
+	Kind: common.DeclarationKindEnum,
+}
+
+func NewRoundingModeCase(inter *interpreter.Interpreter, rawValue uint8) *interpreter.CompositeValue {
+	return interpreter.NewEnumCaseValue(
+		inter,
+		sema.RoundingModeType,
+		interpreter.UInt8Value(rawValue),
+		nil,
+	)
+}
+
+func roundingModeFromValue(value interpreter.Value) fixedpoint.RoundingMode {
+	roundingModeValue, ok := value.(*interpreter.CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	rawValue, ok := roundingModeValue.GetField(sema.EnumRawValueFieldName).(interpreter.UInt8Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	switch sema.RoundingMode(rawValue) {
+	case sema.RoundingModeTowardZero:
+		return fixedpoint.RoundingModeTowardZero
+	case sema.RoundingModeAwayFromZero:
+		return fixedpoint.RoundingModeAwayFromZero
+	case sema.RoundingModeNearestHalfAway:
+		return fixedpoint.RoundingModeNearestHalfAway
+	case sema.RoundingModeNearestHalfEven:
+		return fixedpoint.RoundingModeNearestHalfEven
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+var maxUFix64Big = new(big.Int).SetUint64(math.MaxUint64)
+
+func ufix64ValueToBig(value interpreter.Value) *big.Int {
+	ufix64Value, ok := value.(interpreter.UFix64Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	return new(big.Int).SetUint64(uint64(ufix64Value))
+}
+
+func fix64ValueToBig(value interpreter.Value) *big.Int {
+	fix64Value, ok := value.(interpreter.Fix64Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	return big.NewInt(int64(fix64Value))
+}
+
+// checkMathResult panics with the interpreter error corresponding
+// to the given error of a math function, if any
+//
+func checkMathResult(
+	err error,
+	functionName string,
+	getLocationRange func() interpreter.LocationRange,
+) {
+	switch err {
+	case nil:
+		return
+	case fixedpoint.ErrOverflow:
+		panic(interpreter.OverflowError{})
+	case fixedpoint.ErrDivisionByZero:
+		panic(interpreter.DivisionByZeroError{})
+	case fixedpoint.ErrOutOfDomain:
+		panic(MathDomainError{
+			FunctionName:  functionName,
+			LocationRange: getLocationRange(),
+		})
+	default:
+		panic(err)
+	}
+}
+
+func bigToUFix64Value(value *big.Int) interpreter.UFix64Value {
+	if value.Cmp(maxUFix64Big) > 0 {
+		panic(interpreter.OverflowError{})
+	}
+	return interpreter.UFix64Value(value.Uint64())
+}
+
+func bigToFix64Value(value *big.Int) interpreter.Fix64Value {
+	if !value.IsInt64() {
+		if value.Sign() < 0 {
+			panic(interpreter.UnderflowError{})
+		}
+		panic(interpreter.OverflowError{})
+	}
+	return interpreter.Fix64Value(value.Int64())
+}
+
+var mathSqrtFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		x := ufix64ValueToBig(invocation.Arguments[0])
+		mode := roundingModeFromValue(invocation.Arguments[1])
+
+		result, err := fixedpoint.Sqrt(x, mode)
+		checkMathResult(err, sema.MathTypeSqrtFunctionName, invocation.GetLocationRange)
+
+		return bigToUFix64Value(result)
+	},
+	sema.MathTypeSqrtFunctionType,
+)
+
+var mathPowFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		base := ufix64ValueToBig(invocation.Arguments[0])
+
+		exponent, ok := invocation.Arguments[1].(interpreter.UInt64Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		mode := roundingModeFromValue(invocation.Arguments[2])
+
+		result, err := fixedpoint.Pow(base, uint64(exponent), maxUFix64Big, mode)
+		checkMathResult(err, sema.MathTypePowFunctionName, invocation.GetLocationRange)
+
+		return bigToUFix64Value(result)
+	},
+	sema.MathTypePowFunctionType,
+)
+
+var mathLnFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		x := ufix64ValueToBig(invocation.Arguments[0])
+		mode := roundingModeFromValue(invocation.Arguments[1])
+
+		result, err := fixedpoint.Ln(x, mode)
+		checkMathResult(err, sema.MathTypeLnFunctionName, invocation.GetLocationRange)
+
+		return bigToFix64Value(result)
+	},
+	sema.MathTypeLnFunctionType,
+)
+
+var mathExpFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		x := fix64ValueToBig(invocation.Arguments[0])
+		mode := roundingModeFromValue(invocation.Arguments[1])
+
+		result, err := fixedpoint.Exp(x, mode)
+		checkMathResult(err, sema.MathTypeExpFunctionName, invocation.GetLocationRange)
+
+		return bigToUFix64Value(result)
+	},
+	sema.MathTypeExpFunctionType,
+)
+
+var mathMulDivFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		x := ufix64ValueToBig(invocation.Arguments[0])
+		y := ufix64ValueToBig(invocation.Arguments[1])
+		z := ufix64ValueToBig(invocation.Arguments[2])
+		mode := roundingModeFromValue(invocation.Arguments[3])
+
+		result, err := fixedpoint.MulDiv(x, y, z, mode)
+		checkMathResult(err, sema.MathTypeMulDivFunctionName, invocation.GetLocationRange)
+
+		return bigToUFix64Value(result)
+	},
+	sema.MathTypeMulDivFunctionType,
+)
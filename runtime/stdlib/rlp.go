@@ -0,0 +1,334 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// RLPError is reported when an item cannot be RLP encoded,
+// or when RLP encoded data is invalid
+//
+type RLPError struct {
+	Err error
+	interpreter.LocationRange
+}
+
+func (e RLPError) Error() string {
+	return fmt.Sprintf("RLP error: %s", e.Err)
+}
+
+func (e RLPError) Unwrap() error {
+	return e.Err
+}
+
+var rlpValue = interpreter.NewSimpleCompositeValue(
+	sema.RLPType.ID(),
+	interpreter.ConvertSemaToStaticType(sema.RLPType),
+	interpreter.CompositeDynamicType{
+		StaticType: sema.RLPType,
+	},
+	nil,
+	map[string]interpreter.Value{
+		sema.RLPTypeEncodeFunctionName: rlpEncodeFunction,
+		sema.RLPTypeDecodeFunctionName: rlpDecodeFunction,
+	},
+	nil,
+	nil,
+	nil,
+)
+
+var RLPValue = StandardLibraryValue{
+	Name: sema.RLPTypeName,
+	Type: sema.RLPType,
+	ValueFactory: func(_ *interpreter.Interpreter) interpreter.Value {
+		return rlpValue
+	},
+	Kind: common.DeclarationKindConstant,
+}
+
+var rlpEncodeFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+
+		item, err := rlpItemFromValue(invocation.Arguments[0], 0)
+		if err != nil {
+			panic(RLPError{
+				Err:           err,
+				LocationRange: invocation.GetLocationRange(),
+			})
+		}
+
+		encoded := encodeRLP(item)
+
+		inter.MeterComputation(uint64(len(encoded)))
+
+		return interpreter.ByteSliceToByteArrayValue(inter, encoded)
+	},
+	sema.RLPTypeEncodeFunctionType,
+)
+
+var rlpDecodeFunction = interpreter.NewHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+
+		input, err := interpreter.ByteArrayValueToByteSlice(invocation.Arguments[0])
+		if err != nil {
+			panic(err)
+		}
+
+		inter.MeterComputation(uint64(len(input)))
+
+		item, err := decodeRLP(input)
+		if err != nil {
+			panic(RLPError{
+				Err:           err,
+				LocationRange: invocation.GetLocationRange(),
+			})
+		}
+
+		return rlpItemToValue(inter, item)
+	},
+	sema.RLPTypeDecodeFunctionType,
+)
+
+// rlpMaxDepth is the maximum nesting depth of RLP lists
+//
+const rlpMaxDepth = 1024
+
+var rlpMaxDepthError = fmt.Errorf("maximum nesting depth of %d exceeded", rlpMaxDepth)
+
+// rlpItemFromValue converts the given value to an RLP item:
+// byte arrays are converted to strings ([]byte),
+// and all other arrays are converted to lists ([]interface{})
+//
+func rlpItemFromValue(value interpreter.Value, depth int) (interface{}, error) {
+	if depth > rlpMaxDepth {
+		return nil, rlpMaxDepthError
+	}
+
+	array, ok := value.(*interpreter.ArrayValue)
+	if !ok {
+		return nil, fmt.Errorf("cannot encode value of type %s", value.StaticType())
+	}
+
+	if array.Type.ElementType() == interpreter.PrimitiveStaticTypeUInt8 {
+		return interpreter.ByteArrayValueToByteSlice(array)
+	}
+
+	list := make([]interface{}, 0, array.Count())
+
+	var err error
+	array.Iterate(func(element interpreter.Value) (resume bool) {
+		var item interface{}
+		item, err = rlpItemFromValue(element, depth+1)
+		if err != nil {
+			return false
+		}
+		list = append(list, item)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// rlpItemToValue converts the given RLP item to a value:
+// strings are converted to [UInt8], and lists are converted to [AnyStruct]
+//
+func rlpItemToValue(inter *interpreter.Interpreter, item interface{}) interpreter.Value {
+	switch item := item.(type) {
+	case []byte:
+		return interpreter.ByteSliceToByteArrayValue(inter, item)
+
+	case []interface{}:
+		values := make([]interpreter.Value, len(item))
+		for i, element := range item {
+			values[i] = rlpItemToValue(inter, element)
+		}
+
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeAnyStruct,
+			},
+			common.Address{},
+			values...,
+		)
+
+	default:
+		panic(fmt.Errorf("invalid RLP item: %T", item))
+	}
+}
+
+const (
+	rlpStringOffset   = 0x80
+	rlpListOffset     = 0xc0
+	rlpMaxShortLength = 55
+)
+
+// encodeRLP returns the RLP encoding of the given item,
+// which is either a string ([]byte) or a list ([]interface{})
+//
+func encodeRLP(item interface{}) []byte {
+	switch item := item.(type) {
+	case []byte:
+		if len(item) == 1 && item[0] < rlpStringOffset {
+			return []byte{item[0]}
+		}
+		return append(rlpHeader(rlpStringOffset, len(item)), item...)
+
+	case []interface{}:
+		var payload []byte
+		for _, element := range item {
+			payload = append(payload, encodeRLP(element)...)
+		}
+		return append(rlpHeader(rlpListOffset, len(payload)), payload...)
+
+	default:
+		panic(fmt.Errorf("invalid RLP item: %T", item))
+	}
+}
+
+func rlpHeader(offset byte, length int) []byte {
+	if length <= rlpMaxShortLength {
+		return []byte{offset + byte(length)}
+	}
+
+	var lengthBytes []byte
+	for l := length; l > 0; l >>= 8 {
+		lengthBytes = append([]byte{byte(l)}, lengthBytes...)
+	}
+
+	return append(
+		[]byte{offset + rlpMaxShortLength + byte(len(lengthBytes))},
+		lengthBytes...,
+	)
+}
+
+// decodeRLP decodes the given data, which must be the canonical RLP encoding of exactly one item.
+// Strings are decoded as []byte, lists are decoded as []interface{}
+//
+func decodeRLP(data []byte) (interface{}, error) {
+	item, rest, err := decodeRLPItem(data, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("unexpected %d trailing bytes", len(rest))
+	}
+
+	return item, nil
+}
+
+var rlpUnexpectedEndError = errors.New("unexpected end of input")
+
+func decodeRLPItem(data []byte, depth int) (item interface{}, rest []byte, err error) {
+	if depth > rlpMaxDepth {
+		return nil, nil, rlpMaxDepthError
+	}
+
+	if len(data) == 0 {
+		return nil, nil, rlpUnexpectedEndError
+	}
+
+	prefix := data[0]
+
+	switch {
+	case prefix < rlpStringOffset:
+		return data[:1], data[1:], nil
+
+	case prefix < rlpListOffset:
+		payload, rest, err := decodeRLPPayload(data, rlpStringOffset)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(payload) == 1 && payload[0] < rlpStringOffset {
+			return nil, nil, errors.New("non-canonical encoding of single byte")
+		}
+
+		return payload, rest, nil
+
+	default:
+		payload, rest, err := decodeRLPPayload(data, rlpListOffset)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		list := []interface{}{}
+		for len(payload) > 0 {
+			var element interface{}
+			element, payload, err = decodeRLPItem(payload, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			list = append(list, element)
+		}
+
+		return list, rest, nil
+	}
+}
+
+// decodeRLPPayload decodes the header of the string or list item at the start of the given data,
+// and returns the payload of the item and the remaining data
+//
+func decodeRLPPayload(data []byte, offset byte) (payload []byte, rest []byte, err error) {
+	prefix := data[0]
+	data = data[1:]
+
+	var length uint64
+
+	if prefix <= offset+rlpMaxShortLength {
+		length = uint64(prefix - offset)
+	} else {
+		lengthOfLength := int(prefix - offset - rlpMaxShortLength)
+		if len(data) < lengthOfLength {
+			return nil, nil, rlpUnexpectedEndError
+		}
+
+		if data[0] == 0 {
+			return nil, nil, errors.New("non-canonical length: leading zero")
+		}
+
+		for _, b := range data[:lengthOfLength] {
+			length = length<<8 | uint64(b)
+		}
+
+		if length <= rlpMaxShortLength {
+			return nil, nil, errors.New("non-canonical length: short length in long form")
+		}
+
+		data = data[lengthOfLength:]
+	}
+
+	if uint64(len(data)) < length {
+		return nil, nil, rlpUnexpectedEndError
+	}
+
+	return data[:length], data[length:], nil
+}
@@ -0,0 +1,122 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRLP(t *testing.T) {
+
+	t.Parallel()
+
+	longString := bytes.Repeat([]byte{'a'}, 56)
+
+	type testCase struct {
+		name    string
+		item    interface{}
+		encoded string
+	}
+
+	// Test vectors from the Ethereum wiki
+
+	testCases := []testCase{
+		{"empty string", []byte{}, "80"},
+		{"single byte", []byte{0x0f}, "0f"},
+		{"single byte, zero", []byte{0x00}, "00"},
+		{"single byte, prefixed", []byte{0x80}, "8180"},
+		{"string", []byte("dog"), "83646f67"},
+		{"long string", longString, "b838" + hex.EncodeToString(longString)},
+		{"empty list", []interface{}{}, "c0"},
+		{"list", []interface{}{[]byte("cat"), []byte("dog")}, "c88363617483646f67"},
+		{
+			"set theoretical representation of three",
+			[]interface{}{
+				[]interface{}{},
+				[]interface{}{[]interface{}{}},
+				[]interface{}{[]interface{}{}, []interface{}{[]interface{}{}}},
+			},
+			"c7c0c1c0c3c0c1c0",
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			encoded := encodeRLP(testCase.item)
+			assert.Equal(t, testCase.encoded, hex.EncodeToString(encoded))
+
+			decoded, err := decodeRLP(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.item, decoded)
+		})
+	}
+}
+
+func TestRLPDecodeInvalid(t *testing.T) {
+
+	t.Parallel()
+
+	for name, input := range map[string]string{
+		"empty":                        "",
+		"trailing bytes":               "8300000000",
+		"truncated string":             "83646f",
+		"truncated list":               "c883636174",
+		"truncated length":             "b9",
+		"non-canonical single byte":    "8101",
+		"non-canonical length":         "b80100",
+		"length with leading zero":     "b9003800",
+		"list with truncated element":  "c18300",
+		"list with non-canonical item": "c28101",
+	} {
+		input, err := hex.DecodeString(input)
+		require.NoError(t, err)
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := decodeRLP(input)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("maximum depth exceeded", func(t *testing.T) {
+
+		t.Parallel()
+
+		var item interface{} = []interface{}{}
+		for i := 0; i <= rlpMaxDepth; i++ {
+			item = []interface{}{item}
+		}
+
+		_, err := decodeRLP(encodeRLP(item))
+		require.ErrorIs(t, err, rlpMaxDepthError)
+	})
+}
@@ -0,0 +1,257 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Secp256k1SignatureLength is the length of a recoverable secp256k1 signature:
+// the 32-byte r value, the 32-byte s value, and the 1-byte recovery ID
+//
+const Secp256k1SignatureLength = 65
+
+// Secp256k1HashLength is the length of the hash a recoverable signature is produced for
+//
+const Secp256k1HashLength = 32
+
+// Secp256k1PublicKeyLength is the length of an uncompressed secp256k1 public key,
+// without the SEC 1 prefix byte
+//
+const Secp256k1PublicKeyLength = 64
+
+// EVMAddressLength is the length of an EVM address
+//
+const EVMAddressLength = 20
+
+// secp256k1 curve parameters, see SEC 2, section 2.4.1
+
+var secp256k1P, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+var secp256k1N, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+var secp256k1B = big.NewInt(7)
+var secp256k1Gx, _ = new(big.Int).SetString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", 16)
+var secp256k1Gy, _ = new(big.Int).SetString("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", 16)
+
+// secp256k1SqrtExponent is (p + 1) / 4, as p ≡ 3 (mod 4)
+//
+var secp256k1SqrtExponent = func() *big.Int {
+	result := new(big.Int).Add(secp256k1P, big.NewInt(1))
+	return result.Rsh(result, 2)
+}()
+
+// secp256k1Point is an affine point on the secp256k1 curve.
+// The point at infinity is represented by nil coordinates
+//
+type secp256k1Point struct {
+	x, y *big.Int
+}
+
+func (p secp256k1Point) isInfinity() bool {
+	return p.x == nil
+}
+
+func (p secp256k1Point) add(other secp256k1Point) secp256k1Point {
+	if p.isInfinity() {
+		return other
+	}
+	if other.isInfinity() {
+		return p
+	}
+
+	var slope *big.Int
+
+	if p.x.Cmp(other.x) == 0 {
+		if p.y.Cmp(other.y) != 0 || p.y.Sign() == 0 {
+			return secp256k1Point{}
+		}
+
+		// slope = 3x² / 2y
+
+		numerator := new(big.Int).Mul(p.x, p.x)
+		numerator.Mul(numerator, big.NewInt(3))
+
+		denominator := new(big.Int).Lsh(p.y, 1)
+		denominator.ModInverse(denominator, secp256k1P)
+
+		slope = numerator.Mul(numerator, denominator)
+	} else {
+
+		// slope = (y2 - y1) / (x2 - x1)
+
+		numerator := new(big.Int).Sub(other.y, p.y)
+
+		denominator := new(big.Int).Sub(other.x, p.x)
+		denominator.Mod(denominator, secp256k1P)
+		denominator.ModInverse(denominator, secp256k1P)
+
+		slope = numerator.Mul(numerator, denominator)
+	}
+	slope.Mod(slope, secp256k1P)
+
+	// x3 = slope² - x1 - x2
+
+	x := new(big.Int).Mul(slope, slope)
+	x.Sub(x, p.x)
+	x.Sub(x, other.x)
+	x.Mod(x, secp256k1P)
+
+	// y3 = slope * (x1 - x3) - y1
+
+	y := new(big.Int).Sub(p.x, x)
+	y.Mul(y, slope)
+	y.Sub(y, p.y)
+	y.Mod(y, secp256k1P)
+
+	return secp256k1Point{x: x, y: y}
+}
+
+func (p secp256k1Point) scalarMul(k *big.Int) secp256k1Point {
+	result := secp256k1Point{}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		result = result.add(result)
+		if k.Bit(i) == 1 {
+			result = result.add(p)
+		}
+	}
+	return result
+}
+
+var secp256k1G = secp256k1Point{
+	x: secp256k1Gx,
+	y: secp256k1Gy,
+}
+
+// DefaultRecoverSecp256k1PublicKey is a pure Go reference implementation
+// of the host function which recovers a secp256k1 public key, e.g. for the REPL and tests.
+//
+// The signature must consist of the 32-byte r value, the 32-byte s value,
+// and the recovery ID, which is either 0 or 1, or 27 or 28 (Ethereum style).
+//
+// The recovered public key is returned in uncompressed form, without the SEC 1 prefix byte,
+// i.e. as the 32-byte X coordinate followed by the 32-byte Y coordinate.
+// If no public key can be recovered from the signature, nil is returned.
+//
+// NOTE: This implementation is not constant-time, and must only be used with public data
+//
+func DefaultRecoverSecp256k1PublicKey(signature []byte, hash []byte) ([]byte, error) {
+
+	if len(signature) != Secp256k1SignatureLength {
+		return nil, fmt.Errorf(
+			"invalid signature: length must be %d, got %d",
+			Secp256k1SignatureLength,
+			len(signature),
+		)
+	}
+
+	if len(hash) != Secp256k1HashLength {
+		return nil, fmt.Errorf(
+			"invalid hash: length must be %d, got %d",
+			Secp256k1HashLength,
+			len(hash),
+		)
+	}
+
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:64])
+
+	recoveryID := signature[64]
+	if recoveryID >= 27 {
+		recoveryID -= 27
+	}
+	if recoveryID > 1 {
+		return nil, nil
+	}
+
+	if r.Sign() == 0 || r.Cmp(secp256k1N) >= 0 ||
+		s.Sign() == 0 || s.Cmp(secp256k1N) >= 0 {
+
+		return nil, nil
+	}
+
+	// Decompress the point R from its X coordinate r,
+	// using the parity of the Y coordinate given by the recovery ID
+
+	ySquared := new(big.Int).Exp(r, big.NewInt(3), secp256k1P)
+	ySquared.Add(ySquared, secp256k1B)
+	ySquared.Mod(ySquared, secp256k1P)
+
+	y := new(big.Int).Exp(ySquared, secp256k1SqrtExponent, secp256k1P)
+	if new(big.Int).Exp(y, big.NewInt(2), secp256k1P).Cmp(ySquared) != 0 {
+		return nil, nil
+	}
+
+	if y.Bit(0) != uint(recoveryID) {
+		y.Sub(secp256k1P, y)
+	}
+
+	point := secp256k1Point{x: r, y: y}
+
+	// Q = r⁻¹ (sR - eG)
+
+	rInverse := new(big.Int).ModInverse(r, secp256k1N)
+
+	e := new(big.Int).SetBytes(hash)
+
+	u1 := new(big.Int).Neg(e)
+	u1.Mul(u1, rInverse)
+	u1.Mod(u1, secp256k1N)
+
+	u2 := new(big.Int).Mul(s, rInverse)
+	u2.Mod(u2, secp256k1N)
+
+	publicKey := secp256k1G.scalarMul(u1).add(point.scalarMul(u2))
+	if publicKey.isInfinity() {
+		return nil, nil
+	}
+
+	result := make([]byte, Secp256k1PublicKeyLength)
+	publicKey.x.FillBytes(result[:32])
+	publicKey.y.FillBytes(result[32:])
+
+	return result, nil
+}
+
+// EVMAddress returns the EVM address for the given uncompressed secp256k1 public key,
+// i.e. the last 20 bytes of the Keccak-256 hash of the public key
+//
+func EVMAddress(publicKey []byte) ([]byte, error) {
+
+	// Allow the SEC 1 prefix byte for uncompressed keys
+
+	if len(publicKey) == Secp256k1PublicKeyLength+1 && publicKey[0] == 0x04 {
+		publicKey = publicKey[1:]
+	}
+
+	if len(publicKey) != Secp256k1PublicKeyLength {
+		return nil, fmt.Errorf(
+			"invalid public key: length must be %d, got %d",
+			Secp256k1PublicKeyLength,
+			len(publicKey),
+		)
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(publicKey)
+	hash := hasher.Sum(nil)
+
+	return hash[len(hash)-EVMAddressLength:], nil
+}
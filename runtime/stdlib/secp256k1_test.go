@@ -0,0 +1,172 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRecoverSecp256k1PublicKey(t *testing.T) {
+
+	t.Parallel()
+
+	// Signature of the message "Some data", using the Ethereum signed message prefix,
+	// by the private key 0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318
+
+	hash, err := hex.DecodeString("1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655")
+	require.NoError(t, err)
+
+	signature, err := hex.DecodeString(
+		"b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd" +
+			"6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a029" +
+			"1c",
+	)
+	require.NoError(t, err)
+
+	privateKey, ok := new(big.Int).SetString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 16)
+	require.True(t, ok)
+
+	expectedPoint := secp256k1G.scalarMul(privateKey)
+	expectedPublicKey := make([]byte, Secp256k1PublicKeyLength)
+	expectedPoint.x.FillBytes(expectedPublicKey[:32])
+	expectedPoint.y.FillBytes(expectedPublicKey[32:])
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		publicKey, err := DefaultRecoverSecp256k1PublicKey(signature, hash)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedPublicKey, publicKey)
+
+		address, err := EVMAddress(publicKey)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"2c7536e3605d9c16a7a3d7b1898e529396a65c23",
+			hex.EncodeToString(address),
+		)
+	})
+
+	t.Run("wrong recovery ID", func(t *testing.T) {
+
+		t.Parallel()
+
+		otherSignature := append([]byte{}, signature...)
+		otherSignature[64] = 27
+
+		publicKey, err := DefaultRecoverSecp256k1PublicKey(otherSignature, hash)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, expectedPublicKey, publicKey)
+	})
+
+	t.Run("invalid recovery ID", func(t *testing.T) {
+
+		t.Parallel()
+
+		otherSignature := append([]byte{}, signature...)
+		otherSignature[64] = 2
+
+		publicKey, err := DefaultRecoverSecp256k1PublicKey(otherSignature, hash)
+		require.NoError(t, err)
+
+		assert.Nil(t, publicKey)
+	})
+
+	t.Run("zero r", func(t *testing.T) {
+
+		t.Parallel()
+
+		otherSignature := make([]byte, Secp256k1SignatureLength)
+		copy(otherSignature[32:], signature[32:])
+
+		publicKey, err := DefaultRecoverSecp256k1PublicKey(otherSignature, hash)
+		require.NoError(t, err)
+
+		assert.Nil(t, publicKey)
+	})
+
+	t.Run("invalid signature length", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DefaultRecoverSecp256k1PublicKey(signature[:64], hash)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid hash length", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DefaultRecoverSecp256k1PublicKey(signature, hash[:31])
+		require.Error(t, err)
+	})
+}
+
+func TestEVMAddress(t *testing.T) {
+
+	t.Parallel()
+
+	// The public key of the private key 1 is the generator point
+
+	publicKey := make([]byte, Secp256k1PublicKeyLength)
+	secp256k1G.x.FillBytes(publicKey[:32])
+	secp256k1G.y.FillBytes(publicKey[32:])
+
+	t.Run("uncompressed", func(t *testing.T) {
+
+		t.Parallel()
+
+		address, err := EVMAddress(publicKey)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"7e5f4552091a69125d5dfcb7b8c2659029395bdf",
+			hex.EncodeToString(address),
+		)
+	})
+
+	t.Run("prefixed", func(t *testing.T) {
+
+		t.Parallel()
+
+		address, err := EVMAddress(append([]byte{0x04}, publicKey...))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"7e5f4552091a69125d5dfcb7b8c2659029395bdf",
+			hex.EncodeToString(address),
+		)
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := EVMAddress(publicKey[:32])
+		require.Error(t, err)
+	})
+}
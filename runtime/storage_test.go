@@ -732,6 +732,82 @@ func TestRuntimeStorageReadAndBorrow(t *testing.T) {
 	})
 }
 
+func TestRuntimeStorageTuple(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	storage := newTestLedger(nil, nil)
+
+	signer := common.MustBytesToAddress([]byte{0x42})
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: storage,
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{signer}, nil
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              transaction {
+                 prepare(signer: AuthAccount) {
+                     let pair: (Int, String?) = (1, "a")
+                     signer.save(pair, to: /storage/pair)
+                 }
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	value, err := runtime.ReadStored(
+		signer,
+		cadence.Path{
+			Domain:     "storage",
+			Identifier: "pair",
+		},
+		Context{
+			Interface: runtimeInterface,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		cadence.NewTuple([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewOptional(cadence.String("a")),
+		}),
+		value,
+	)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              transaction {
+                 prepare(signer: AuthAccount) {
+                     let (number, string) = signer.load<(Int, String?)>(from: /storage/pair)!
+                     assert(number == 1)
+                     assert(string == "a")
+                 }
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+}
+
 func TestRuntimeTopShotContractDeployment(t *testing.T) {
 
 	t.Parallel()
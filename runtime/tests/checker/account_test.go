@@ -405,6 +405,234 @@ func TestCheckAccount_typeAt(t *testing.T) {
 	}
 }
 
+func TestCheckAccount_paths(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckAccount(t,
+		`
+          let storagePaths = authAccount.storagePaths
+          let publicPaths = authAccount.publicPaths
+          let privatePaths = authAccount.privatePaths
+        `,
+	)
+	require.NoError(t, err)
+
+	for name, pathType := range map[string]sema.Type{
+		"storagePaths": sema.StoragePathType,
+		"publicPaths":  sema.PublicPathType,
+		"privatePaths": sema.PrivatePathType,
+	} {
+		require.Equal(t,
+			&sema.VariableSizedType{
+				Type: pathType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, name),
+		)
+	}
+}
+
+func TestCheckAccount_forEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("forEachStored", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test() {
+                  authAccount.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      return true
+                  })
+              }
+            `,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("forEachPublic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test() {
+                  authAccount.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+                      return true
+                  })
+              }
+            `,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("forEachPublic, invalid path type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test() {
+                  authAccount.forEachPublic(fun (path: StoragePath, type: Type): Bool {
+                      return true
+                  })
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("public account", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              let paths = publicAccount.storagePaths
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckAccount_check(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckAccount(t,
+			`
+              resource R {}
+
+              let exists = authAccount.check<@R>(from: /storage/r)
+            `,
+		)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			sema.BoolType,
+			RequireGlobalValue(t, checker.Elaboration, "exists"),
+		)
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              let exists = authAccount.check(from: /storage/r)
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[0])
+	})
+
+	t.Run("public path", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              let exists = authAccount.check<Int>(from: /public/r)
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckAccount_replace(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckAccount(t,
+			`
+              resource R {}
+
+              fun test(): @R? {
+                  return <-authAccount.replace(<-create R(), at: /storage/r)
+              }
+            `,
+		)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			&sema.OptionalType{
+				Type: RequireGlobalType(t, checker.Elaboration, "R"),
+			},
+			RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType).ReturnTypeAnnotation.Type,
+		)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              let old: Int? = authAccount.replace(1, at: /storage/i)
+            `,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              resource R {}
+
+              fun test() {
+                  authAccount.replace(<-create R(), at: /storage/r)
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("non-storable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              fun test(): ((Int): Int)? {
+                  return authAccount.replace(fun (x: Int): Int { return x }, at: /storage/f)
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
 func TestCheckAccount_load(t *testing.T) {
 
 	t.Parallel()
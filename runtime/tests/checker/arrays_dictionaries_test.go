@@ -1170,3 +1170,165 @@ func TestNilAssignmentToDictionary(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCheckArrayFunctionalMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let xs = [3, 1, 2]
+      let fixed: [Int; 3] = [3, 1, 2]
+
+      let firstIndex = xs.firstIndex(of: 1)
+      let reversed = xs.reverse()
+      let reversedFixed = fixed.reverse()
+      let mapped = xs.map(fun (x: Int): String { return x.toString() })
+      let filtered = xs.filter(fun (x: Int): Bool { return x > 1 })
+      let reduced = xs.reduce(initial: "", fun (string: String, x: Int): String {
+          return string.concat(x.toString())
+      })
+      let sorted = fixed.sort(by: fun (a: Int, b: Int): Bool { return a < b })
+    `)
+
+	require.NoError(t, err)
+
+	for name, expectedType := range map[string]sema.Type{
+		"firstIndex":    &sema.OptionalType{Type: sema.IntType},
+		"reversed":      &sema.VariableSizedType{Type: sema.IntType},
+		"reversedFixed": &sema.ConstantSizedType{Type: sema.IntType, Size: 3},
+		"mapped":        &sema.VariableSizedType{Type: sema.StringType},
+		"filtered":      &sema.VariableSizedType{Type: sema.IntType},
+		"reduced":       sema.StringType,
+		"sorted":        &sema.ConstantSizedType{Type: sema.IntType, Size: 3},
+	} {
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+			name,
+		)
+	}
+}
+
+func TestCheckInvalidArrayMapFunctionType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let xs = [1, 2, 3]
+      let mapped = xs.map(fun (x: String): String { return x })
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidArrayReduceInitialType(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let xs = [1, 2, 3]
+      let reduced = xs.reduce(initial: "", fun (sum: Int, x: Int): Int { return sum + x })
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 3)
+
+	assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[1])
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[2])
+}
+
+func TestCheckInvalidResourceArrayFunctionalMembers(t *testing.T) {
+
+	t.Parallel()
+
+	for _, member := range []string{"reverse", "map", "filter", "reduce", "sort"} {
+
+		t.Run(member, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource X {}
+
+                      fun test(xs: @[X]) {
+                          let f = xs.%s
+                          destroy xs
+                      }
+                    `,
+					member,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 2)
+
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+			assert.IsType(t, &sema.ResourceMethodBindingError{}, errs[1])
+		})
+	}
+}
+
+func TestCheckDictionaryFunctionalMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let xs = {"a": 1, "b": 2}
+
+      let filtered = xs.filter(fun (key: String, value: Int): Bool { return value > 1 })
+      let mapped = xs.mapValues(fun (value: Int): Bool { return value > 1 })
+
+      fun test() {
+          xs.forEachKey(fun (key: String): Bool { return true })
+      }
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.DictionaryType{
+			KeyType:   sema.StringType,
+			ValueType: sema.IntType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "filtered"),
+	)
+
+	assert.Equal(t,
+		&sema.DictionaryType{
+			KeyType:   sema.StringType,
+			ValueType: sema.BoolType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "mapped"),
+	)
+}
+
+func TestCheckInvalidResourceDictionaryFunctionalMembers(t *testing.T) {
+
+	t.Parallel()
+
+	for _, member := range []string{"filter", "mapValues"} {
+
+		t.Run(member, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource X {}
+
+                      fun test(xs: @{String: X}) {
+                          let f = xs.%s
+                          destroy xs
+                      }
+                    `,
+					member,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 2)
+
+			assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+			assert.IsType(t, &sema.ResourceMethodBindingError{}, errs[1])
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -65,6 +66,132 @@ func TestCheckCastingIntLiteralToIntegerType(t *testing.T) {
 	}
 }
 
+func TestCheckDynamicCastingIntLiteralToIntegerType(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, operation ast.Operation, integerType sema.Type) {
+
+		t.Run(integerType.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let x = 1 %s %s
+                    `,
+					operation.Symbol(),
+					integerType,
+				),
+			)
+
+			require.NoError(t, err)
+
+			xType := RequireGlobalValue(t, checker.Elaboration, "x")
+
+			expectedType := integerType
+			if operation == ast.OperationFailableCast {
+				expectedType = &sema.OptionalType{Type: integerType}
+			}
+
+			assert.Equal(t,
+				expectedType,
+				xType,
+			)
+		})
+	}
+
+	for _, operation := range []ast.Operation{
+		ast.OperationFailableCast,
+		ast.OperationForceCast,
+	} {
+		t.Run(operation.Symbol(), func(t *testing.T) {
+			for _, integerType := range sema.AllIntegerTypes {
+				test(t, operation, integerType)
+			}
+		})
+	}
+}
+
+func TestCheckDynamicCastingFixedPointLiteralToFixedPointType(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let x = 1.5 as? Fix64
+      let y = 1.5 as! Fix64
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.OptionalType{Type: sema.Fix64Type},
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+
+	assert.Equal(t,
+		sema.Fix64Type,
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+}
+
+func TestCheckDynamicCastingIntLiteralToString(t *testing.T) {
+
+	t.Parallel()
+
+	// The literal cannot be converted,
+	// but the failable cast is still valid
+
+	_, err := ParseAndCheck(t, `
+      let x = 1 as? String
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidDynamicCastingIntLiteralOutOfRange(t *testing.T) {
+
+	t.Parallel()
+
+	// An out of range literal is a static error, like for static casts,
+	// i.e. the failable cast does not result in nil
+
+	for _, operation := range []ast.Operation{
+		ast.OperationFailableCast,
+		ast.OperationForceCast,
+	} {
+		t.Run(operation.Symbol(), func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let x = 300 %s UInt8
+                    `,
+					operation.Symbol(),
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidIntegerLiteralRangeError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckInvalidDynamicCastingFixedPointLiteralOutOfRange(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let x = -1.5 as? UFix64
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidFixedPointLiteralRangeError{}, errs[0])
+}
+
 func TestCheckInvalidCastingIntLiteralToString(t *testing.T) {
 
 	t.Parallel()
@@ -370,7 +370,7 @@ func TestCheckInvalidFunctionPostConditionWithFunction(t *testing.T) {
 	_, err := ParseAndCheck(t, `
       fun test() {
           post {
-              (fun (): Int { return 2 })() == 2
+              (view fun (): Int { return 2 })() == 2
           }
       }
     `)
@@ -492,10 +492,11 @@ func TestCheckFunctionWithPostConditionAndResourceResult(t *testing.T) {
         }
     `)
 
-	errs := ExpectCheckerErrors(t, err, 2)
+	errs := ExpectCheckerErrors(t, err, 3)
 
-	require.IsType(t, &sema.InvalidMoveOperationError{}, errs[0])
-	require.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	require.IsType(t, &sema.PurityError{}, errs[0])
+	require.IsType(t, &sema.InvalidMoveOperationError{}, errs[1])
+	require.IsType(t, &sema.TypeMismatchError{}, errs[2])
 }
 
 // TestCheckConditionCreateBefore tests if the AST expression extractor properly handles
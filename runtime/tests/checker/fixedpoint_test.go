@@ -658,13 +658,24 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 					),
 				)
 
-				if i <= scale {
+				// Literals without a type annotation are inferred as UFix64
+
+				expectedErrors := 0
+				if i > scale {
+					expectedErrors++
+				}
+				if i > sema.Fix64Scale {
+					expectedErrors++
+				}
+
+				if expectedErrors == 0 {
 					assert.NoError(t, err)
 				} else {
-					errs := ExpectCheckerErrors(t, err, 2)
+					errs := ExpectCheckerErrors(t, err, expectedErrors)
 
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[1])
+					for _, err := range errs {
+						assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, err)
+					}
 				}
 			}
 		})
@@ -710,3 +721,39 @@ func TestCheckFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckInvalidMixedFixedPointArithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let x: UFix64 = 1.5
+      let y: UFix128 = 2.5
+      let z = x + y
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+}
+
+func TestCheckFix128Conversion(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let x: UFix64 = 1.5
+      let y = UFix128(x)
+      let z = Fix128(y) * 0.000000000000000000000001
+    `)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.UFix128Type,
+		RequireGlobalValue(t, checker.Elaboration, "y"),
+	)
+	assert.Equal(t,
+		sema.Fix128Type,
+		RequireGlobalValue(t, checker.Elaboration, "z"),
+	)
+}
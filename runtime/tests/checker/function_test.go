@@ -415,3 +415,248 @@ func TestCheckFunctionNonExistingField(t *testing.T) {
 
 	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
 }
+
+func TestCheckFunctionDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("omitted", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun add(_ a: Int, _ b: Int = 1): Int {
+              return a + b
+          }
+
+          let x = add(1)
+          let y = add(1, 2)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("literal type inferred from parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: UInt8 = 1, b: UFix64 = 2.0, c: [Int8] = [1, 2], d: Int? = nil) {}
+
+          let x = test()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let name: String
+
+              init(name: String = "default") {
+                  self.name = name
+              }
+          }
+
+          let s = S()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("too few arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 1) {}
+
+          let x = test()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("too many arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1) {}
+
+          let x = test(a: 1, 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = "1") {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("not trailing", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1, b: Int) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("non-constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let one = 1
+
+          fun test(a: Int = one, b: [Int] = [one]) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.NonConstantDefaultArgumentError{}, errs[0])
+		assert.IsType(t, &sema.NonConstantDefaultArgumentError{}, errs[1])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R? = nil) {
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("event", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          event E(a: Int = 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnsupportedDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("interface function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(a: Int = 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnsupportedDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("implementation of interface function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(a: Int)
+          }
+
+          struct S: I {
+              fun test(a: Int = 1) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.DefaultArgumentConformanceError{}, errs[0])
+	})
+
+	t.Run("member function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int = 1): Int {
+                  return a
+              }
+          }
+
+          let x = S().test()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("function value in array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun a(x: Int = 1) {}
+          fun b(x: Int) {}
+
+          fun test() {
+              let fs = [a, b]
+              fs[1]()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("reassigned function variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun a(x: Int = 1) {}
+          fun b(x: Int) {}
+
+          fun test() {
+              var f = a
+              f = b
+              f()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+}
@@ -0,0 +1,130 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func parseAndCheckWithBuiltinValues(t *testing.T, code string) (*sema.Checker, error) {
+	return ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithPredeclaredValues(
+					stdlib.BuiltinValues().ToSemaValueDeclarations(),
+				),
+			},
+		},
+	)
+}
+
+func TestCheckRoundingModeCases(t *testing.T) {
+
+	t.Parallel()
+
+	for _, mode := range sema.RoundingModes {
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			fmt.Sprintf(
+				`
+                  let mode: RoundingMode = RoundingMode.%s
+                `,
+				mode.Name(),
+			),
+		)
+
+		require.NoError(t, err)
+	}
+}
+
+func TestCheckMathFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			`
+              let mode = RoundingMode.nearestHalfEven
+              let a: UFix64 = Math.sqrt(2.0, rounding: mode)
+              let b: UFix64 = Math.pow(1.5, 3, rounding: mode)
+              let c: Fix64 = Math.ln(2.0, rounding: mode)
+              let d: UFix64 = Math.exp(-1.0, rounding: mode)
+              let e: UFix64 = Math.mulDiv(1.0, 2.0, 3.0, rounding: mode)
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing rounding mode", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			`
+              let a = Math.sqrt(2.0)
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("invalid argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			`
+              let x: Fix64 = 2.0
+              let a = Math.sqrt(x, rounding: RoundingMode.towardZero)
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			`
+              view fun f(): UFix64 {
+                  return Math.sqrt(2.0, rounding: RoundingMode.towardZero)
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,663 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckViewFunctionDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          view fun test(_ x: Int): Int {
+              return x + 1
+          }
+        `)
+
+		require.NoError(t, err)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test")
+		require.IsType(t, &sema.FunctionType{}, functionType)
+		assert.Equal(t,
+			sema.FunctionPurityView,
+			functionType.(*sema.FunctionType).Purity,
+		)
+		assert.Equal(t,
+			"(view (_ x: Int): Int)",
+			functionType.String(),
+		)
+	})
+
+	t.Run("function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let test = view fun (): Int {
+              return 1
+          }
+        `)
+
+		require.NoError(t, err)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test")
+		require.IsType(t, &sema.FunctionType{}, functionType)
+		assert.Equal(t,
+			sema.FunctionPurityView,
+			functionType.(*sema.FunctionType).Purity,
+		)
+	})
+
+	t.Run("composite member", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+
+              view fun getX(): Int {
+                  return self.x
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckViewFunctionType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view is subtype of impure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(): Int {
+              return 1
+          }
+
+          let f: ((): Int) = test
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(): Int {
+              return 1
+          }
+
+          let f: (view (): Int) = test
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("impure is not subtype of view", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              return 1
+          }
+
+          let f: (view (): Int) = test
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invoke view function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(_ f: (view (): Int)): Int {
+              return f()
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckViewFunctionBody(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("call view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun one(): Int {
+              return 1
+          }
+
+          view fun test(): Int {
+              return one() + [1, 2].length
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("call view builtin functions", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(_ xs: [Int], _ s: String): Bool {
+              return xs.contains(1) && s.concat("x").length > 1 && Int8(1) == 1
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("call non-view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun one(): Int {
+              return 1
+          }
+
+          view fun test(): Int {
+              return one()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			sema.ImpureOperationInvocation,
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("call non-view builtin member function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(): Int {
+              let xs = [1]
+              xs.append(2)
+              return xs.length
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("write local variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(_ y: Int): Int {
+              var x = 1
+              x = x + y
+              var xs = [1]
+              xs[0] = 2
+              var a = 1
+              var b = 2
+              a <-> b
+              return x
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("write global variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 1
+
+          view fun test() {
+              x = 2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			sema.ImpureOperationWrite,
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("write global array element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = [1]
+
+          view fun test() {
+              xs[0] = 2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("swap with outer variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 1
+
+          view fun test() {
+              var y = 2
+              x <-> y
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("write field of self", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              var x: Int
+
+              init() {
+                  self.x = 1
+              }
+
+              view fun setX() {
+                  self.x = 2
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("write field through reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              pub(set) var x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          view fun test(_ s: &S) {
+              s.x = 2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("write field of local struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              pub(set) var x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          view fun test(_ s: S): S {
+              s.x = 2
+              return s
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("write outer variable in nested view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var x = 1
+              let f = view fun () {
+                  x = 2
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("nested impure function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(): Int {
+              var x = 1
+              let f = fun () {
+                  x = 2
+              }
+              return x
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("emit", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          event E()
+
+          view fun test() {
+              emit E()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			sema.ImpureOperationEmit,
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          view fun test(_ r: @R) {
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.Equal(t,
+			sema.ImpureOperationDestroy,
+			errs[0].(*sema.PurityError).Operation,
+		)
+	})
+
+	t.Run("storage write", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(_ account: AuthAccount) {
+              account.save(1, to: /storage/one)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("storage read", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun test(_ account: AuthAccount): Int? {
+              return account.copy<Int>(from: /storage/one)
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckViewFunctionInterfaceConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view implementation of view requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              view fun test(): Int
+          }
+
+          struct S: I {
+              view fun test(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("view implementation of impure requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(): Int
+          }
+
+          struct S: I {
+              view fun test(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("impure implementation of view requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              view fun test(): Int
+          }
+
+          struct S: I {
+              fun test(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+}
+
+func TestCheckConditionPurity(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view call in pre-condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun isValid(_ x: Int): Bool {
+              return x > 0
+          }
+
+          fun test(_ x: Int) {
+              pre {
+                  isValid(x)
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-view call in pre-condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun isValid(_ x: Int): Bool {
+              return x > 0
+          }
+
+          fun test(_ x: Int) {
+              pre {
+                  isValid(x)
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+		assert.True(t, errs[0].(*sema.PurityError).InCondition)
+	})
+
+	t.Run("non-view call in post-condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun isValid(_ x: Int): Bool {
+              return x > 0
+          }
+
+          fun test(): Int {
+              post {
+                  isValid(result)
+              }
+              return 1
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("non-view call in before", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 0
+
+          fun getX(): Int {
+              return x
+          }
+
+          fun test() {
+              post {
+                  before(getX()) == x
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("view call in before", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 0
+
+          view fun getX(): Int {
+              return x
+          }
+
+          fun test() {
+              post {
+                  before(getX()) == x
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,76 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckRLPFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			`
+              let encoded: [UInt8] = RLP.encode(["dog".utf8])
+              let decoded: AnyStruct = RLP.decode(encoded)
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			`
+              let decoded = RLP.decode("c0")
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithBuiltinValues(t,
+			`
+              view fun f(): AnyStruct {
+                  return RLP.decode(RLP.encode([] as [UInt8]))
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+}
@@ -324,3 +324,83 @@ func TestCheckStringToLower(t *testing.T) {
 		RequireGlobalValue(t, checker.Elaboration, "x"),
 	)
 }
+
+func TestCheckStringFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let upper = "abc".toUpper()
+        let trimmed = " abc ".trim()
+        let contains = "abc".contains("b")
+        let index = "abc".index(of: "b")
+        let hasPrefix = "abc".hasPrefix("a")
+        let hasSuffix = "abc".hasSuffix("c")
+        let parts = "a,b,c".split(separator: ",")
+        let replaced = "abc".replaceAll(of: "b", with: "x")
+        let characters = "abc".toCharacters()
+	`)
+
+	require.NoError(t, err)
+
+	for name, expectedType := range map[string]sema.Type{
+		"upper":      sema.StringType,
+		"trimmed":    sema.StringType,
+		"contains":   sema.BoolType,
+		"index":      sema.IntType,
+		"hasPrefix":  sema.BoolType,
+		"hasSuffix":  sema.BoolType,
+		"parts":      sema.StringArrayType,
+		"replaced":   sema.StringType,
+		"characters": sema.CharacterArrayType,
+	} {
+		assert.Equal(t,
+			expectedType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+			name,
+		)
+	}
+}
+
+func TestCheckStringStaticFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let joined = String.join(["a", "b"], separator: ", ")
+        let fromCharacters = String.fromCharacters(["a", "b"])
+        let fromUTF8 = String.fromUTF8([0x61, 0x62])
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "joined"),
+	)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "fromCharacters"),
+	)
+
+	assert.Equal(t,
+		&sema.OptionalType{
+			Type: sema.StringType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "fromUTF8"),
+	)
+}
+
+func TestCheckInvalidStringFromCharacters(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        let x = String.fromCharacters(["ab"])
+	`)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidCharacterLiteralError{}, errs[0])
+}
@@ -0,0 +1,379 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckTupleExpression(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x = (1, "2", true)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.TupleType{
+				ElementTypes: []sema.Type{
+					sema.IntType,
+					sema.StringType,
+					sema.BoolType,
+				},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("expected type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: (UInt8, Int?) = (1, 2)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.TupleType{
+				ElementTypes: []sema.Type{
+					sema.UInt8Type,
+					&sema.OptionalType{
+						Type: sema.IntType,
+					},
+				},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("subtyping", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: (Int, String) = (1, "2")
+          let y: (Int?, AnyStruct) = x
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: (Int, String) = (1, 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid element count", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: (Int, Int) = (1, 2, 3)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TupleElementCountMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("multiple return values", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun divMod(_ a: Int, _ b: Int): (Int, Int) {
+              return (a / b, a % b)
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun divMod(_ a: Int, _ b: Int): (Int, Int) {
+              return (a / b, a % b)
+          }
+
+          fun test(): Int {
+              let (quotient, remainder) = divMod(7, 2)
+              return quotient + remainder
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): UInt8 {
+              let (a, b): (UInt8, String) = (1, "2")
+              return a
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid element count", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let (a, b, c) = (1, 2)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TupleElementCountMismatchError{}, errs[0])
+	})
+
+	t.Run("non-tuple", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let (a, b) = 1
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NonTupleTypeError{}, errs[0])
+	})
+
+	t.Run("constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let (a, b) = (1, 2)
+              a = 3
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var (a, b) = (1, 2)
+              a = 3
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckTupleResources(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("destructuring", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun create2(): @(@R, @R) {
+              return <-(<-create R(), <-create R())
+          }
+
+          fun test() {
+              let (a, b) <- create2()
+              destroy a
+              destroy b
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- (<-create R(), 1)
+              destroy rs
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing move operation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let r <- create R()
+              let rs <- (r, 1)
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.MissingMoveOperationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+
+	t.Run("invalid transfer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let (a, b) = (<-create R(), 1)
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[0])
+	})
+
+	t.Run("loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let (a, b) <- (<-create R(), <-create R())
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- (<-create R(), 1)
+              let (a, b) <- rs
+              destroy a
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("missing resource annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(): (@R, Int) {
+              return <-(<-create R(), 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+
+	t.Run("missing element resource annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(): @(R, Int) {
+              return <-(<-create R(), 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+}
@@ -317,6 +317,338 @@ func TestInterpretAuthAccount_type(t *testing.T) {
 	})
 }
 
+func TestInterpretAuthAccount_paths(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewAddressValueFromBytes([]byte{42})
+
+	inter, _ := testAccount(
+		t,
+		address,
+		true,
+		`
+          resource R {}
+
+          fun setup() {
+              account.save(<-create R(), to: /storage/b)
+              account.save(1, to: /storage/c)
+              account.save("a", to: /storage/a)
+              account.link<&R>(/public/r, target: /storage/b)
+              account.link<&Int>(/private/i, target: /storage/c)
+          }
+
+          fun storagePaths(): [StoragePath] {
+              return account.storagePaths
+          }
+
+          fun publicPaths(): [PublicPath] {
+              return account.publicPaths
+          }
+
+          fun privatePaths(): [PrivatePath] {
+              return account.privatePaths
+          }
+        `,
+	)
+
+	value, err := inter.Invoke("storagePaths")
+	require.NoError(t, err)
+	require.Equal(t, 0, value.(*interpreter.ArrayValue).Count())
+
+	_, err = inter.Invoke("setup")
+	require.NoError(t, err)
+
+	test := func(functionName string, expected ...interpreter.Value) {
+
+		value, err := inter.Invoke(functionName)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				value.StaticType().(interpreter.ArrayStaticType),
+				common.Address{},
+				expected...,
+			),
+			value,
+		)
+	}
+
+	test(
+		"storagePaths",
+		interpreter.PathValue{Domain: common.PathDomainStorage, Identifier: "a"},
+		interpreter.PathValue{Domain: common.PathDomainStorage, Identifier: "b"},
+		interpreter.PathValue{Domain: common.PathDomainStorage, Identifier: "c"},
+	)
+
+	test(
+		"publicPaths",
+		interpreter.PathValue{Domain: common.PathDomainPublic, Identifier: "r"},
+	)
+
+	test(
+		"privatePaths",
+		interpreter.PathValue{Domain: common.PathDomainPrivate, Identifier: "i"},
+	)
+}
+
+func TestInterpretAuthAccount_forEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("forEachStored", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              resource R {}
+
+              fun test(): [String] {
+                  account.save(<-create R(), to: /storage/b)
+                  account.save(1, to: /storage/c)
+                  account.save("a", to: /storage/a)
+                  account.save(true, to: /storage/d)
+
+                  let visited: [String] = []
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      visited.append(path.toString().concat(" ").concat(type.identifier))
+                      // Stop after the third path
+                      return visited.length < 3
+                  })
+                  return visited
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewStringValue("/storage/a String"),
+				interpreter.NewStringValue("/storage/b S.test.R"),
+				interpreter.NewStringValue("/storage/c Int"),
+			},
+			arrayElements(inter, value.(*interpreter.ArrayValue)),
+		)
+	})
+
+	t.Run("forEachStored, removal during iteration", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test(): [String] {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+
+                  let visited: [String] = []
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      visited.append(path.toString())
+                      account.load<Int>(from: /storage/b)
+                      return true
+                  })
+                  return visited
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewStringValue("/storage/a"),
+			},
+			arrayElements(inter, value.(*interpreter.ArrayValue)),
+		)
+	})
+
+	t.Run("forEachPublic", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              resource R {}
+
+              fun test(): [String] {
+                  account.save(<-create R(), to: /storage/r)
+                  account.link<&R>(/public/r, target: /storage/r)
+                  account.link<&R>(/private/r, target: /storage/r)
+
+                  let visited: [String] = []
+                  account.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+                      visited.append(path.toString().concat(" ").concat(type.identifier))
+                      return true
+                  })
+                  return visited
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewStringValue("/public/r Capability<&S.test.R>"),
+			},
+			arrayElements(inter, value.(*interpreter.ArrayValue)),
+		)
+	})
+}
+
+func TestInterpretAuthAccount_check(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewAddressValueFromBytes([]byte{42})
+
+	inter, getAccountStorables := testAccount(
+		t,
+		address,
+		true,
+		`
+          resource interface RI {}
+
+          resource R: RI {}
+
+          resource R2 {}
+
+          fun save() {
+              account.save(<-create R(), to: /storage/r)
+          }
+
+          fun checkR(): Bool {
+              return account.check<@R>(from: /storage/r)
+          }
+
+          fun checkRI(): Bool {
+              return account.check<@{RI}>(from: /storage/r)
+          }
+
+          fun checkR2(): Bool {
+              return account.check<@R2>(from: /storage/r)
+          }
+        `,
+	)
+
+	test := func(functionName string, expected bool) {
+		value, err := inter.Invoke(functionName)
+		require.NoError(t, err)
+		require.Equal(t, interpreter.BoolValue(expected), value)
+	}
+
+	// nothing stored
+
+	test("checkR", false)
+
+	_, err := inter.Invoke("save")
+	require.NoError(t, err)
+
+	test("checkR", true)
+	test("checkRI", true)
+	test("checkR2", false)
+
+	// the stored value is not modified
+
+	require.Len(t, getAccountStorables(), 1)
+}
+
+func TestInterpretAuthAccount_replace(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewAddressValueFromBytes([]byte{42})
+
+	inter, getAccountStorables := testAccount(
+		t,
+		address,
+		true,
+		`
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          resource R2 {}
+
+          fun replaceEmpty(): Bool {
+              let old <- account.replace(<-create R(id: 1), at: /storage/r)
+              let empty = old == nil
+              destroy old
+              return empty
+          }
+
+          fun replace(): Int {
+              let old <- account.replace(<-create R(id: 2), at: /storage/r)!
+              let id = old.id
+              destroy old
+              return id
+          }
+
+          fun storedID(): Int {
+              return account.borrow<&R>(from: /storage/r)!.id
+          }
+
+          fun replaceWrongType() {
+              let old <- account.replace(<-create R2(), at: /storage/r)
+              destroy old
+          }
+        `,
+	)
+
+	value, err := inter.Invoke("replaceEmpty")
+	require.NoError(t, err)
+	require.Equal(t, interpreter.BoolValue(true), value)
+	require.Len(t, getAccountStorables(), 1)
+
+	value, err = inter.Invoke("replace")
+	require.NoError(t, err)
+	require.Equal(t, interpreter.NewIntValueFromInt64(1), value)
+	require.Len(t, getAccountStorables(), 1)
+
+	value, err = inter.Invoke("storedID")
+	require.NoError(t, err)
+	require.Equal(t, interpreter.NewIntValueFromInt64(2), value)
+
+	// replacing a value of another type aborts,
+	// and leaves the storage unchanged
+
+	_, err = inter.Invoke("replaceWrongType")
+	require.Error(t, err)
+
+	require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+
+	value, err = inter.Invoke("storedID")
+	require.NoError(t, err)
+	require.Equal(t, interpreter.NewIntValueFromInt64(2), value)
+}
+
 func TestInterpretAuthAccount_load(t *testing.T) {
 
 	t.Parallel()
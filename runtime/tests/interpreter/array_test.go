@@ -19,7 +19,13 @@
 package interpreter_test
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func arrayElements(inter *interpreter.Interpreter, array *interpreter.ArrayValue) []interpreter.Value {
@@ -44,3 +50,289 @@ func dictionaryKeyValues(dict *interpreter.DictionaryValue) []interpreter.Value
 	})
 	return result
 }
+
+func TestInterpretArrayFirstIndex(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3, 2]
+      let found = xs.firstIndex(of: 2)
+      let missing = xs.firstIndex(of: 4)
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewSomeValueNonCopying(interpreter.NewIntValueFromInt64(1)),
+		inter.Globals["found"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NilValue{},
+		inter.Globals["missing"].GetValue(),
+	)
+}
+
+func TestInterpretArrayReverse(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3]
+      let reversed = xs.reverse()
+      let empty = ([] as [Int]).reverse()
+    `)
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewIntValueFromInt64(3),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(1),
+		},
+		arrayElements(inter, inter.Globals["reversed"].GetValue().(*interpreter.ArrayValue)),
+	)
+
+	// The original array is not modified
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		},
+		arrayElements(inter, inter.Globals["xs"].GetValue().(*interpreter.ArrayValue)),
+	)
+
+	assert.Empty(t,
+		arrayElements(inter, inter.Globals["empty"].GetValue().(*interpreter.ArrayValue)),
+	)
+}
+
+func TestInterpretArrayMap(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3]
+      let mapped = xs.map(fun (x: Int): String { return x.toString() })
+    `)
+
+	mapped := inter.Globals["mapped"].GetValue().(*interpreter.ArrayValue)
+
+	assert.Equal(t,
+		interpreter.VariableSizedStaticType{
+			Type: interpreter.PrimitiveStaticTypeString,
+		},
+		mapped.Type,
+	)
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewStringValue("1"),
+			interpreter.NewStringValue("2"),
+			interpreter.NewStringValue("3"),
+		},
+		arrayElements(inter, mapped),
+	)
+}
+
+func TestInterpretArrayFilter(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3, 4]
+      let filtered = xs.filter(fun (x: Int): Bool { return x % 2 == 0 })
+    `)
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(4),
+		},
+		arrayElements(inter, inter.Globals["filtered"].GetValue().(*interpreter.ArrayValue)),
+	)
+}
+
+func TestInterpretArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3, 4]
+      let sum = xs.reduce(initial: 0, fun (sum: Int, x: Int): Int { return sum + x })
+      let joined = xs.reduce(initial: "", fun (string: String, x: Int): String {
+          return string.concat(x.toString())
+      })
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewIntValueFromInt64(10),
+		inter.Globals["sum"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewStringValue("1234"),
+		inter.Globals["joined"].GetValue(),
+	)
+}
+
+func TestInterpretArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct Entry {
+          let key: Int
+          let value: String
+
+          init(key: Int, value: String) {
+              self.key = key
+              self.value = value
+          }
+      }
+
+      let entries = [
+          Entry(key: 2, value: "a"),
+          Entry(key: 1, value: "b"),
+          Entry(key: 2, value: "c"),
+          Entry(key: 1, value: "d")
+      ]
+
+      let sorted = entries
+          .sort(by: fun (a: Entry, b: Entry): Bool { return a.key < b.key })
+          .map(fun (entry: Entry): String { return entry.value })
+    `)
+
+	// The sort is stable
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewStringValue("b"),
+			interpreter.NewStringValue("d"),
+			interpreter.NewStringValue("a"),
+			interpreter.NewStringValue("c"),
+		},
+		arrayElements(inter, inter.Globals["sorted"].GetValue().(*interpreter.ArrayValue)),
+	)
+}
+
+func TestInterpretArrayFunctionalMemberModification(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [Int] {
+          let xs = [1, 2, 3]
+          return xs.map(fun (x: Int): Int {
+              xs.append(x)
+              return x * 2
+          })
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	// Elements appended during the iteration are not visited
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(4),
+			interpreter.NewIntValueFromInt64(6),
+		},
+		arrayElements(inter, result.(*interpreter.ArrayValue)),
+	)
+}
+
+func TestInterpretDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): Int {
+          let xs = {"a": 1, "b": 2, "c": 3}
+          var count = 0
+          xs.forEachKey(fun (key: String): Bool {
+              count = count + 1
+              return count < 2
+          })
+          return count
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewIntValueFromInt64(2),
+		result,
+	)
+}
+
+func TestInterpretDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = {"a": 1, "b": 2, "c": 3}
+      let filtered = xs.filter(fun (key: String, value: Int): Bool {
+          return key != "a" && value < 3
+      })
+    `)
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewStringValue("b"),
+			interpreter.NewIntValueFromInt64(2),
+		},
+		dictionaryKeyValues(inter.Globals["filtered"].GetValue().(*interpreter.DictionaryValue)),
+	)
+}
+
+func TestInterpretDictionaryMapValues(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = {"a": 1}
+      let mapped = xs.mapValues(fun (value: Int): [Int] { return [value, value] })
+    `)
+
+	mapped := inter.Globals["mapped"].GetValue().(*interpreter.DictionaryValue)
+
+	assert.Equal(t,
+		interpreter.DictionaryStaticType{
+			KeyType: interpreter.PrimitiveStaticTypeString,
+			ValueType: interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+		},
+		mapped.Type,
+	)
+
+	value, ok := mapped.Get(
+		inter,
+		interpreter.ReturnEmptyLocationRange,
+		interpreter.NewStringValue("a"),
+	)
+	require.True(t, ok)
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(1),
+		},
+		arrayElements(inter, value.(*interpreter.ArrayValue)),
+	)
+}
@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/onflow/cadence/runtime/common"
@@ -81,12 +82,19 @@ func TestInterpretToString(t *testing.T) {
 
 			isSigned := sema.IsSubType(ty, sema.SignedFixedPointType)
 
+			fractional := "34"
+			if ranged, ok := ty.(sema.FractionalRangedType); ok {
+				fractional += strings.Repeat("0", int(ranged.Scale())-2)
+			} else {
+				fractional += "000000"
+			}
+
 			if isSigned {
 				literal = "-12.34"
-				expected = interpreter.NewStringValue("-12.34000000")
+				expected = interpreter.NewStringValue("-12." + fractional)
 			} else {
 				literal = "12.34"
-				expected = interpreter.NewStringValue("12.34000000")
+				expected = interpreter.NewStringValue("12." + fractional)
 			}
 
 			inter := parseCheckAndInterpret(t,
@@ -300,12 +308,23 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 			"-1.0":  {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			"0.0":   {0},
+			"42.0":  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			"-1.0":  {255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
 			"42.0":  {0, 0, 0, 0, 250, 86, 234, 0},
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			"0.0":   {0},
+			"42.0":  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+		},
 	}
 
 	// Ensure the test cases are complete
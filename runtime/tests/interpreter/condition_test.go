@@ -1071,6 +1071,7 @@ func TestInterpretFunctionWithPostConditionAndResourceResult(t *testing.T) {
 		{
 			Name: "check",
 			Type: &sema.FunctionType{
+				Purity: sema.FunctionPurityView,
 				Parameters: []*sema.Parameter{
 					{
 						Label:      sema.ArgumentLabelNotRequired,
@@ -1123,7 +1124,7 @@ func TestInterpretFunctionWithPostConditionAndResourceResult(t *testing.T) {
                   return <- self.resources.remove(key: "original")!
               }
 
-              fun use(_ r: &R): Bool {
+              view fun use(_ r: &R): Bool {
                   check(r)
                   return true
               }
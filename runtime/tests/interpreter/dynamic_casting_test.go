@@ -177,6 +177,98 @@ func TestInterpretDynamicCastingNumber(t *testing.T) {
 	}
 }
 
+func TestInterpretDynamicCastingLiteralConversion(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		ty       sema.Type
+		value    string
+		expected interpreter.Value
+	}
+
+	tests := []test{
+		{sema.UInt8Type, "42", interpreter.UInt8Value(42)},
+		{sema.Int256Type, "-42", interpreter.NewInt256ValueFromInt64(-42)},
+		{sema.Word64Type, "42", interpreter.Word64Value(42)},
+		{sema.Fix64Type, "1.23", interpreter.Fix64Value(123000000)},
+		{&sema.AddressType{}, "0x1", interpreter.NewAddressValueFromBytes([]byte{0x1})},
+	}
+
+	for operation, returnsOptional := range dynamicCastingOperations {
+
+		t.Run(operation.Symbol(), func(t *testing.T) {
+
+			for _, test := range tests {
+
+				t.Run(test.ty.String(), func(t *testing.T) {
+
+					inter := parseCheckAndInterpret(t,
+						fmt.Sprintf(
+							`
+                              let x = %[1]s %[2]s %[3]s
+                            `,
+							test.value,
+							operation.Symbol(),
+							test.ty,
+						),
+					)
+
+					expected := test.expected
+					if returnsOptional {
+						expected = interpreter.NewSomeValueNonCopying(expected)
+					}
+
+					AssertValuesEqual(
+						t,
+						inter,
+						expected,
+						inter.Globals["x"].GetValue(),
+					)
+				})
+			}
+		})
+	}
+}
+
+func TestInterpretDynamicCastingToOptional(t *testing.T) {
+
+	t.Parallel()
+
+	// The result of a successful cast to an optional type
+	// is boxed, like the result of a static cast
+
+	for operation, returnsOptional := range dynamicCastingOperations {
+
+		t.Run(operation.Symbol(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let x: AnyStruct = 42
+                      let y = x %s Int?
+                    `,
+					operation.Symbol(),
+				),
+			)
+
+			var expected interpreter.Value = interpreter.NewSomeValueNonCopying(
+				interpreter.NewIntValueFromInt64(42),
+			)
+			if returnsOptional {
+				expected = interpreter.NewSomeValueNonCopying(expected)
+			}
+
+			AssertValuesEqual(
+				t,
+				inter,
+				expected,
+				inter.Globals["y"].GetValue(),
+			)
+		})
+	}
+}
+
 func TestInterpretDynamicCastingVoid(t *testing.T) {
 
 	t.Parallel()
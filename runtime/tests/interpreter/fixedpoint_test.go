@@ -55,8 +55,14 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 	tests := map[string]interpreter.Value{
 		// Fix*
 		"Fix64": interpreter.Fix64Value(123000000),
+		"Fix128": interpreter.NewFix128ValueFromBigInt(
+			new(big.Int).Mul(big.NewInt(123), new(big.Int).Exp(big.NewInt(10), big.NewInt(22), nil)),
+		),
 		// UFix*
 		"UFix64": interpreter.UFix64Value(123000000),
+		"UFix128": interpreter.NewUFix128ValueFromBigInt(
+			new(big.Int).Mul(big.NewInt(123), new(big.Int).Exp(big.NewInt(10), big.NewInt(22), nil)),
+		),
 	}
 
 	for _, fixedPointType := range sema.AllFixedPointTypes {
@@ -112,8 +118,10 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 }
 
 var testFixedPointValues = map[string]interpreter.Value{
-	"Fix64":  interpreter.Fix64Value(50 * sema.Fix64Factor),
-	"UFix64": interpreter.UFix64Value(50 * sema.Fix64Factor),
+	"Fix64":   interpreter.Fix64Value(50 * sema.Fix64Factor),
+	"UFix64":  interpreter.UFix64Value(50 * sema.Fix64Factor),
+	"Fix128":  interpreter.NewFix128ValueWithInteger(big.NewInt(50)),
+	"UFix128": interpreter.NewUFix128ValueWithInteger(big.NewInt(50)),
 }
 
 func init() {
@@ -571,6 +579,14 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 			min: interpreter.UFix64Value(0),
 			max: interpreter.UFix64Value(math.MaxUint64),
 		},
+		sema.Fix128Type: {
+			min: interpreter.NewFix128ValueFromBigInt(sema.Fix128TypeMinBig),
+			max: interpreter.NewFix128ValueFromBigInt(sema.Fix128TypeMaxBig),
+		},
+		sema.UFix128Type: {
+			min: interpreter.NewUFix128ValueFromBigInt(sema.UFix128TypeMinBig),
+			max: interpreter.NewUFix128ValueFromBigInt(sema.UFix128TypeMaxBig),
+		},
 	}
 
 	for _, ty := range sema.AllFixedPointTypes {
@@ -592,3 +608,112 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretFix128Arithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("division", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UFix128 = 1.0 / 3.0
+          let y: Fix128 = -2.0 / 3.0
+        `)
+
+		expectedX, ok := new(big.Int).SetString("333333333333333333333333", 10)
+		require.True(t, ok)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(expectedX),
+			inter.Globals["x"].GetValue(),
+		)
+
+		expectedY, ok := new(big.Int).SetString("-666666666666666666666667", 10)
+		require.True(t, ok)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewFix128ValueFromBigInt(expectedY),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("multiplication", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UFix128 = 0.000000000001 * 0.000000000001
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(big.NewInt(1)),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+
+	t.Run("conversion from UFix64", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UFix64 = 1.5
+          let y = UFix128(x)
+          let z = UFix64(y)
+        `)
+
+		expected, ok := new(big.Int).SetString("1500000000000000000000000", 10)
+		require.True(t, ok)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUFix128ValueFromBigInt(expected),
+			inter.Globals["y"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.UFix64Value(150000000),
+			inter.Globals["z"].GetValue(),
+		)
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              let x: Fix128 = 1.0
+              let y: Fix128 = 0.0
+              return x / y
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              return UFix128.max + 1.0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+}
@@ -6709,11 +6709,19 @@ func TestInterpretEmitEventParameterTypes(t *testing.T) {
 			value: interpreter.Fix64Value(123000000),
 			ty:    sema.Fix64Type,
 		},
+		"Fix128": {
+			value: interpreter.NewFix128ValueWithInteger(big.NewInt(123)),
+			ty:    sema.Fix128Type,
+		},
 		// UFix*
 		"UFix64": {
 			value: interpreter.UFix64Value(123000000),
 			ty:    sema.UFix64Type,
 		},
+		"UFix128": {
+			value: interpreter.NewUFix128ValueWithInteger(big.NewInt(123)),
+			ty:    sema.UFix128Type,
+		},
 		// TODO:
 		//// Struct
 		//"S": {
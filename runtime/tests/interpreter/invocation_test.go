@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestInterpretFunctionInvocationCheckArgumentTypes(t *testing.T) {
@@ -41,3 +42,153 @@ func TestInterpretFunctionInvocationCheckArgumentTypes(t *testing.T) {
 
 	require.ErrorAs(t, err, &interpreter.ValueTransferTypeError{})
 }
+
+func TestInterpretFunctionInvocationDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun add(_ a: Int, _ b: Int = 1): Int {
+               return a + b
+           }
+
+           let x = add(1)
+           let y = add(1, 2)
+       `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(2),
+			inter.Globals["x"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(3),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("optional and inferred literal types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(a: UInt8 = 1, b: UInt8? = 2): UInt8? {
+               return b
+           }
+
+           let x = test()
+       `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewSomeValueNonCopying(interpreter.UInt8Value(2)),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           struct S {
+               let name: String
+
+               init(name: String = "default") {
+                   self.name = name
+               }
+           }
+
+           let x = S().name
+       `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewStringValue("default"),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+
+	t.Run("array default is not shared", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(values: [Int] = []): Int {
+               values.append(1)
+               return values.length
+           }
+
+           let x = test()
+           let y = test()
+       `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(1),
+			inter.Globals["x"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(1),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("host invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(a: Int = 42): Int {
+               return a
+           }
+       `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(42),
+			value,
+		)
+	})
+
+	t.Run("missing argument without default", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(a: Int, b: Int = 1): Int {
+               return a + b
+           }
+       `)
+
+		function := inter.Globals["test"].GetValue().(interpreter.FunctionValue)
+
+		_, err := inter.InvokeFunction(
+			function,
+			interpreter.Invocation{
+				Interpreter: inter,
+			},
+		)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ArgumentCountError{})
+	})
+}
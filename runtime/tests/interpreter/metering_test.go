@@ -422,3 +422,44 @@ func TestInterpretFunctionInvocationHandler(t *testing.T) {
 		occurrences,
 	)
 }
+
+func TestInterpretMeterComputationHandler(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := checker.ParseAndCheck(t, `
+      pub fun test() {
+          "abc".toUpper()
+          "hello".contains("lo")
+          String.join(["a", "bc"], separator: ", ")
+      }
+    `)
+	require.NoError(t, err)
+
+	var intensities []uint64
+
+	storage := interpreter.NewInMemoryStorage()
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		interpreter.WithStorage(storage),
+		interpreter.WithOnMeterComputationHandler(
+			func(_ *interpreter.Interpreter, intensity uint64) {
+				intensities = append(intensities, intensity)
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	err = inter.Interpret()
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]uint64{3, 7, 3, 4},
+		intensities,
+	)
+}
@@ -205,3 +205,244 @@ func TestInterpretStringToLower(t *testing.T) {
 		result,
 	)
 }
+
+func TestInterpretStringToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          return "Flowers".toUpper()
+      }
+	`)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.Equal(t,
+		interpreter.NewStringValue("FLOWERS"),
+		result,
+	)
+}
+
+func TestInterpretStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [String] {
+          return [
+              "  Flowers \n".trim(),
+              " \t ".trim(),
+              " \u{301}a ".trim()
+          ]
+      }
+	`)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	RequireValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.StringArrayStaticType,
+			common.Address{},
+			interpreter.NewStringValue("Flowers"),
+			interpreter.NewStringValue(""),
+			// the space is combined with the accent, so it is retained
+			interpreter.NewStringValue(" \u0301a"),
+		),
+		result,
+	)
+}
+
+func TestInterpretStringSearch(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let flowers = "Flowers \u{1F490} are beautiful"
+      let accented = "cafe\u{301}"
+
+      let contains = flowers.contains("are")
+      let containsMissing = flowers.contains("ugly")
+      let containsPartialCharacter = accented.contains("cafe")
+
+      let index = flowers.index(of: "are")
+      let indexMissing = flowers.index(of: "ugly")
+      let indexEmpty = flowers.index(of: "")
+
+      let hasPrefix = flowers.hasPrefix("Flowers")
+      let hasPrefixMissing = flowers.hasPrefix("are")
+      let hasPrefixPartialCharacter = accented.hasPrefix("cafe")
+
+      let hasSuffix = flowers.hasSuffix("beautiful")
+      let hasSuffixMissing = flowers.hasSuffix("Flowers")
+      let hasSuffixPartialCharacter = accented.hasSuffix("\u{301}")
+	`)
+
+	for name, expected := range map[string]interpreter.Value{
+		"contains":                  interpreter.BoolValue(true),
+		"containsMissing":           interpreter.BoolValue(false),
+		"containsPartialCharacter":  interpreter.BoolValue(false),
+		"index":                     interpreter.NewIntValueFromInt64(10),
+		"indexMissing":              interpreter.NewIntValueFromInt64(-1),
+		"indexEmpty":                interpreter.NewIntValueFromInt64(0),
+		"hasPrefix":                 interpreter.BoolValue(true),
+		"hasPrefixMissing":          interpreter.BoolValue(false),
+		"hasPrefixPartialCharacter": interpreter.BoolValue(false),
+		"hasSuffix":                 interpreter.BoolValue(true),
+		"hasSuffixMissing":          interpreter.BoolValue(false),
+		"hasSuffixPartialCharacter": interpreter.BoolValue(false),
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			expected,
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretStringSplit(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let split = "a, b,, c".split(separator: ", ")
+      let splitMissing = "abc".split(separator: ",")
+      let splitEmpty = "a\u{1F490}c".split(separator: "")
+      let splitPartialCharacter = "cafe\u{301}e".split(separator: "e")
+	`)
+
+	for name, expected := range map[string][]string{
+		"split":                 {"a", "b,", "c"},
+		"splitMissing":          {"abc"},
+		"splitEmpty":            {"a", "\U0001F490", "c"},
+		"splitPartialCharacter": {"cafe\u0301", ""},
+	} {
+		values := make([]interpreter.Value, len(expected))
+		for i, part := range expected {
+			values[i] = interpreter.NewStringValue(part)
+		}
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.StringArrayStaticType,
+				common.Address{},
+				values...,
+			),
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretStringReplaceAll(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let replaced = "one fish, two fish".replaceAll(of: "fish", with: "bird")
+      let replacedEmpty = "ab".replaceAll(of: "", with: "-")
+      let replacedPartialCharacter = "cafe\u{301} cafe".replaceAll(of: "cafe", with: "tea")
+	`)
+
+	for name, expected := range map[string]string{
+		"replaced":                 "one bird, two bird",
+		"replacedEmpty":            "-a-b-",
+		"replacedPartialCharacter": "cafe\u0301 tea",
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewStringValue(expected),
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretStringCharacterConversion(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let characters = "a\u{1F490}e\u{301}".toCharacters()
+      let string = String.fromCharacters(characters)
+	`)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.CharacterArrayStaticType,
+			common.Address{},
+			interpreter.NewStringValue("a"),
+			interpreter.NewStringValue("\U0001F490"),
+			interpreter.NewStringValue("e\u0301"),
+		),
+		inter.Globals["characters"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewStringValue("a\U0001F490e\u0301"),
+		inter.Globals["string"].GetValue(),
+	)
+}
+
+func TestInterpretStringFromUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let valid = String.fromUTF8([70, 108, 111, 119, 101, 114, 115, 32, 240, 159, 146, 144])
+      let invalid = String.fromUTF8([0xFF, 0xFE])
+	`)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewSomeValueNonCopying(
+			interpreter.NewStringValue("Flowers \U0001F490"),
+		),
+		inter.Globals["valid"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NilValue{},
+		inter.Globals["invalid"].GetValue(),
+	)
+}
+
+func TestInterpretStringJoin(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let joined = String.join(["one", "two", "three"], separator: ", ")
+      let joinedSingle = String.join(["one"], separator: ", ")
+      let joinedEmpty = String.join([], separator: ", ")
+	`)
+
+	for name, expected := range map[string]string{
+		"joined":       "one, two, three",
+		"joinedSingle": "one",
+		"joinedEmpty":  "",
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewStringValue(expected),
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
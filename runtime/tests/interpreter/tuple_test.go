@@ -0,0 +1,207 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2021 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretTupleExpression(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x: (UInt8, Int?) = (1, 2)
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewTupleValueNonCopying(
+			interpreter.UInt8Value(1),
+			interpreter.NewSomeValueNonCopying(
+				interpreter.NewIntValueFromInt64(2),
+			),
+		),
+		inter.Globals["x"].GetValue(),
+	)
+}
+
+func TestInterpretTupleMultipleReturnValues(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun divMod(_ a: Int, _ b: Int): (Int, Int) {
+          return (a / b, a % b)
+      }
+
+      fun test(): [Int] {
+          let (quotient, remainder) = divMod(7, 2)
+          return [quotient, remainder]
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+			common.Address{},
+			interpreter.NewIntValueFromInt64(3),
+			interpreter.NewIntValueFromInt64(1),
+		),
+		value,
+	)
+}
+
+func TestInterpretTupleVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("boxing", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun pair(): (Int, String) {
+              return (1, "2")
+          }
+
+          fun test(): Int? {
+              let (a, b): (Int?, String) = pair()
+              return a
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewSomeValueNonCopying(
+				interpreter.NewIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              var (a, b) = (1, 2)
+              a = a + 10
+              return a + b
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(13),
+			value,
+		)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun create2(): @(@R, @R) {
+              return <-(<-create R(id: 1), <-create R(id: 2))
+          }
+
+          fun test(): Int {
+              let (a, b) <- create2()
+              let sum = a.id + b.id
+              destroy a
+              destroy b
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+	})
+}
+
+func TestInterpretTupleDestroy(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      var destroyed = 0
+
+      resource R {
+          destroy() {
+              destroyed = destroyed + 1
+          }
+      }
+
+      fun test() {
+          let rs <- (<-create R(), 1, <-create R())
+          destroy rs
+      }
+    `)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewIntValueFromInt64(2),
+		inter.Globals["destroyed"].GetValue(),
+	)
+}
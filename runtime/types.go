@@ -65,6 +65,8 @@ const (
 	HashAlgorithmSHA3_256              = sema.HashAlgorithmSHA3_256
 	HashAlgorithmSHA3_384              = sema.HashAlgorithmSHA3_384
 	HashAlgorithmKMAC128_BLS_BLS12_381 = sema.HashAlgorithmKMAC128_BLS_BLS12_381
+	HashAlgorithmKECCAK_256            = sema.HashAlgorithmKECCAK_256
+	HashAlgorithmBLAKE2B_256           = sema.HashAlgorithmBLAKE2B_256
 )
 
 type AccountKey struct {
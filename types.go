@@ -20,6 +20,7 @@ package cadence
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/onflow/cadence/runtime/common"
 )
@@ -411,6 +412,26 @@ func (UFix64Type) ID() string {
 	return "UFix64"
 }
 
+// Fix128Type
+
+type Fix128Type struct{}
+
+func (Fix128Type) isType() {}
+
+func (Fix128Type) ID() string {
+	return "Fix128"
+}
+
+// UFix128Type
+
+type UFix128Type struct{}
+
+func (UFix128Type) isType() {}
+
+func (UFix128Type) ID() string {
+	return "UFix128"
+}
+
 type ArrayType interface {
 	Type
 	Element() Type
@@ -817,6 +838,22 @@ func (t RestrictedType) WithID(id string) RestrictedType {
 	return t
 }
 
+// TupleType
+
+type TupleType struct {
+	ElementTypes []Type
+}
+
+func (TupleType) isType() {}
+
+func (t TupleType) ID() string {
+	elementTypeIDs := make([]string, len(t.ElementTypes))
+	for i, elementType := range t.ElementTypes {
+		elementTypeIDs[i] = elementType.ID()
+	}
+	return fmt.Sprintf("(%s)", strings.Join(elementTypeIDs, ","))
+}
+
 // BlockType
 
 type BlockType struct{}
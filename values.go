@@ -905,6 +905,98 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Fix128
+
+type Fix128 struct {
+	Value *big.Int
+}
+
+func NewFix128(s string) (Fix128, error) {
+	v, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{v}, nil
+}
+
+func NewFix128FromBig(i *big.Int) (Fix128, error) {
+	if i.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return Fix128{}, fmt.Errorf("value exceeds min of Fix128: %s", i.String())
+	}
+	if i.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return Fix128{}, fmt.Errorf("value exceeds max of Fix128: %s", i.String())
+	}
+	return Fix128{i}, nil
+}
+
+func (Fix128) isValue() {}
+
+func (Fix128) Type() Type {
+	return Fix128Type{}
+}
+
+func (v Fix128) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v Fix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v Fix128) ToBigEndianBytes() []byte {
+	return interpreter.SignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Fix128) String() string {
+	return format.Fix128(v.Value)
+}
+
+// UFix128
+
+type UFix128 struct {
+	Value *big.Int
+}
+
+func NewUFix128(s string) (UFix128, error) {
+	v, err := fixedpoint.ParseUFix128(s)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{v}, nil
+}
+
+func NewUFix128FromBig(i *big.Int) (UFix128, error) {
+	if i.Sign() < 0 {
+		return UFix128{}, fmt.Errorf("invalid negative value for UFix128: %s", i.String())
+	}
+	if i.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return UFix128{}, fmt.Errorf("value exceeds max of UFix128: %s", i.String())
+	}
+	return UFix128{i}, nil
+}
+
+func (UFix128) isValue() {}
+
+func (UFix128) Type() Type {
+	return UFix128Type{}
+}
+
+func (v UFix128) ToGoValue() interface{} {
+	return v.Big()
+}
+
+func (v UFix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v UFix128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v UFix128) String() string {
+	return format.UFix128(v.Value)
+}
+
 // Array
 
 type Array struct {
@@ -945,6 +1037,46 @@ func (v Array) String() string {
 	return format.Array(values)
 }
 
+// Tuple
+
+type Tuple struct {
+	TupleType TupleType
+	Elements  []Value
+}
+
+func NewTuple(elements []Value) Tuple {
+	return Tuple{Elements: elements}
+}
+
+func (Tuple) isValue() {}
+
+func (v Tuple) Type() Type {
+	return v.TupleType
+}
+
+func (v Tuple) WithType(tupleType TupleType) Tuple {
+	v.TupleType = tupleType
+	return v
+}
+
+func (v Tuple) ToGoValue() interface{} {
+	ret := make([]interface{}, len(v.Elements))
+
+	for i, e := range v.Elements {
+		ret[i] = e.ToGoValue()
+	}
+
+	return ret
+}
+
+func (v Tuple) String() string {
+	elements := make([]string, len(v.Elements))
+	for i, element := range v.Elements {
+		elements[i] = element.String()
+	}
+	return format.Tuple(elements)
+}
+
 // Dictionary
 
 type Dictionary struct {
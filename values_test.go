@@ -42,6 +42,8 @@ func TestStringer(t *testing.T) {
 
 	ufix64, _ := NewUFix64("64.01")
 	fix64, _ := NewFix64("-32.11")
+	ufix128, _ := NewUFix128("128.01")
+	fix128, _ := NewFix128("-64.11")
 
 	stringerTests := map[string]testCase{
 		"UInt": {
@@ -124,6 +126,14 @@ func TestStringer(t *testing.T) {
 			value:    fix64,
 			expected: "-32.11000000",
 		},
+		"UFix128": {
+			value:    ufix128,
+			expected: "128.010000000000000000000000",
+		},
+		"Fix128": {
+			value:    fix128,
+			expected: "-64.110000000000000000000000",
+		},
 		"Void": {
 			value:    NewVoid(),
 			expected: "()",
@@ -434,12 +444,25 @@ func TestToBigEndianBytes(t *testing.T) {
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 			Fix64(-1_00000000): {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			Fix128{big.NewInt(0)}:    {0},
+			Fix128{big.NewInt(42)}:   {42},
+			Fix128{big.NewInt(128)}:  {0, 128},
+			Fix128{big.NewInt(-1)}:   {255},
+			Fix128{big.NewInt(-200)}: {255, 56},
+		},
 		// UFix*
 		"UFix64": {
 			Fix64(0):           {0, 0, 0, 0, 0, 0, 0, 0},
 			Fix64(42_00000000): {0, 0, 0, 0, 250, 86, 234, 0},
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			UFix128{big.NewInt(0)}:   {0},
+			UFix128{big.NewInt(42)}:  {42},
+			UFix128{big.NewInt(128)}: {128},
+			UFix128{big.NewInt(200)}: {200},
+		},
 	}
 
 	// Ensure the test cases are complete